@@ -25,8 +25,9 @@ const (
 var log = logger.GetOrCreate("api/gin")
 
 const (
-	eventsGroupID = "events"
-	hubGroupID    = "hub"
+	eventsGroupID  = "events"
+	hubGroupID     = "hub"
+	watcherGroupID = "watcher"
 )
 
 // ArgsWebServerHandler holds the arguments needed to create a web server handler
@@ -163,6 +164,18 @@ func (w *webServer) createGroups() error {
 		groupsMap[hubGroupID] = hubHandler
 	}
 
+	enabledStreams, err := common.NewEnabledStreams(w.configs.MainConfig.General.EnabledStreams)
+	if err != nil {
+		return err
+	}
+	if enabledStreams.IsEnabled(common.TxCompleted) {
+		watcherGroup, err := groups.NewWatcherGroup(w.facade)
+		if err != nil {
+			return err
+		}
+		groupsMap[watcherGroupID] = watcherGroup
+	}
+
 	w.groups = groupsMap
 
 	return nil
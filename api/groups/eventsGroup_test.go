@@ -286,8 +286,9 @@ func TestEventsGroup_RevertEvents(t *testing.T) {
 			},
 		}
 		args.Facade = &mocks.FacadeStub{
-			HandleRevertEventsCalled: func(events data.RevertBlock) {
+			HandleRevertEventsCalled: func(events data.RevertBlock) error {
 				assert.Equal(t, revertBlockEvents, events)
+				return nil
 			},
 		}
 
@@ -354,9 +355,10 @@ func TestEventsGroup_FinalizedEvents(t *testing.T) {
 			},
 		}
 		args.Facade = &mocks.FacadeStub{
-			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) error {
 				wasCalled = true
 				assert.Equal(t, finalizedBlockEvents, events)
+				return nil
 			},
 		}
 
@@ -11,8 +11,8 @@ import (
 // EventsFacadeHandler defines the behavior of a facade handler needed for events group
 type EventsFacadeHandler interface {
 	HandlePushEvents(events data.ArgsSaveBlockData) error
-	HandleRevertEvents(revertBlock data.RevertBlock)
-	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	HandleRevertEvents(revertBlock data.RevertBlock) error
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error
 	GetConnectorUserAndPass() (string, string)
 	IsInterfaceNil() bool
 }
@@ -23,6 +23,12 @@ type HubFacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// WatcherFacadeHandler defines the behavior of a facade handler needed for watcher group
+type WatcherFacadeHandler interface {
+	WatchTxs(txHashes []string) error
+	IsInterfaceNil() bool
+}
+
 // EmptyBlockCreatorContainer defines the behavior of a empty block creator container
 type EmptyBlockCreatorContainer interface {
 	Add(headerType core.HeaderType, creator block.EmptyBlockCreator) error
@@ -13,6 +13,7 @@ import (
 const (
 	metricsPath           = "/metrics"
 	prometheusMetricsPath = "/prometheus-metrics"
+	shardsPath            = "/shards"
 )
 
 type statusGroup struct {
@@ -42,6 +43,11 @@ func NewStatusGroup(facade shared.FacadeHandler) (*statusGroup, error) {
 			Handler: sg.getPrometheusMetrics,
 			Method:  http.MethodGet,
 		},
+		{
+			Path:    shardsPath,
+			Handler: sg.getShardsStatus,
+			Method:  http.MethodGet,
+		},
 	}
 	sg.endpoints = endpoints
 
@@ -62,6 +68,13 @@ func (sg *statusGroup) getPrometheusMetrics(c *gin.Context) {
 	c.String(http.StatusOK, metricsResults)
 }
 
+// getShardsStatus will expose the liveness status of the shards observers
+func (sg *statusGroup) getShardsStatus(c *gin.Context) {
+	shardsStatus := sg.facade.GetShardsStatus()
+
+	shared.JSONResponse(c, http.StatusOK, gin.H{"shards": shardsStatus}, "")
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sg *statusGroup) IsInterfaceNil() bool {
 	return sg == nil
@@ -26,6 +26,13 @@ type statusMetricsResponse struct {
 	Error string `json:"error"`
 }
 
+type shardsStatusResponse struct {
+	Data struct {
+		Shards []data.ShardStatus `json:"shards"`
+	}
+	Error string `json:"error"`
+}
+
 func TestNewStatusGroup(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +112,48 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
 
+func TestGetShardsStatus_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := []data.ShardStatus{
+		{
+			ShardID:               0,
+			LastNonce:             10,
+			LastBlockHash:         "hash1",
+			LastHeaderTimestamp:   1234,
+			LastReceivedTimestamp: 1236,
+			SinceLastBlockInSec:   2,
+			LagInMs:               2000,
+		},
+		{
+			ShardID:             1,
+			LastNonce:           8,
+			Stalled:             true,
+			SinceLastBlockInSec: 120,
+		},
+	}
+	facade := &mocks.FacadeStub{
+		GetShardsStatusCalled: func() []data.ShardStatus {
+			return expectedStatus
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.Nil(t, err)
+
+	ws := startWebServer(statusGroup, statusPath, getStatusRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/status/shards", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp shardsStatusResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedStatus, apiResp.Data.Shards)
+}
+
 func TestStatusGroup_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -122,6 +171,7 @@ func getStatusRoutesConfig() config.APIRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/metrics", Open: true},
 					{Name: "/prometheus-metrics", Open: true},
+					{Name: "/shards", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,76 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+)
+
+const (
+	watchTxsEndpoint = "/txs"
+)
+
+// watchTxsRequest defines the request body for watching transactions
+type watchTxsRequest struct {
+	TxHashes []string `json:"txHashes"`
+}
+
+type watcherGroup struct {
+	*baseGroup
+	facade WatcherFacadeHandler
+}
+
+// NewWatcherGroup registers handlers for the /watcher group
+func NewWatcherGroup(facade WatcherFacadeHandler) (*watcherGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for watcher group", errors.ErrNilFacadeHandler)
+	}
+
+	wg := &watcherGroup{
+		facade:    facade,
+		baseGroup: newBaseGroup(),
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    watchTxsEndpoint,
+			Handler: wg.watchTxs,
+			Method:  http.MethodPost,
+		},
+	}
+	wg.endpoints = endpoints
+
+	return wg, nil
+}
+
+// watchTxs registers the provided transactions hashes, a tx completed event being
+// published for each of them once completed
+func (wg *watcherGroup) watchTxs(c *gin.Context) {
+	var request watchTxsRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+	if len(request.TxHashes) == 0 {
+		shared.JSONResponse(c, http.StatusBadRequest, nil, errEmptyTxHashes.Error())
+		return
+	}
+
+	err = wg.facade.WatchTxs(request.TxHashes)
+	if err != nil {
+		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	shared.JSONResponse(c, http.StatusOK, nil, "")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wg *watcherGroup) IsInterfaceNil() bool {
+	return wg == nil
+}
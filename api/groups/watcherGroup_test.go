@@ -0,0 +1,170 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/groups"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watcherPath = "/watcher"
+
+type watcherResponse struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+}
+
+func TestNewWatcherGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		wg, err := groups.NewWatcherGroup(nil)
+
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.True(t, check.IfNil(wg))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wg, err := groups.NewWatcherGroup(&mocks.FacadeStub{})
+
+		assert.NotNil(t, wg)
+		assert.Nil(t, err)
+	})
+}
+
+func TestWatchTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request body should error", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			WatchTxsCalled: func(txHashes []string) error {
+				wasCalled = true
+				return nil
+			},
+		}
+
+		watcherGroup, err := groups.NewWatcherGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(watcherGroup, watcherPath, getWatcherRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/watcher/txs", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.False(t, wasCalled)
+	})
+
+	t.Run("empty tx hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			WatchTxsCalled: func(txHashes []string) error {
+				wasCalled = true
+				return nil
+			},
+		}
+
+		watcherGroup, err := groups.NewWatcherGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(watcherGroup, watcherPath, getWatcherRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/watcher/txs", bytes.NewBuffer([]byte(`{"txHashes":[]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.False(t, wasCalled)
+	})
+
+	t.Run("facade error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mocks.FacadeStub{
+			WatchTxsCalled: func(txHashes []string) error {
+				return expectedErr
+			},
+		}
+
+		watcherGroup, err := groups.NewWatcherGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(watcherGroup, watcherPath, getWatcherRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/watcher/txs", bytes.NewBuffer([]byte(`{"txHashes":["aa"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		var apiResp watcherResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, expectedErr.Error(), apiResp.Error)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txHashes := []string{"aa", "bb"}
+		var watchedTxHashes []string
+		facade := &mocks.FacadeStub{
+			WatchTxsCalled: func(txHashes []string) error {
+				watchedTxHashes = txHashes
+				return nil
+			},
+		}
+
+		watcherGroup, err := groups.NewWatcherGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(watcherGroup, watcherPath, getWatcherRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/watcher/txs", bytes.NewBuffer([]byte(`{"txHashes":["aa","bb"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, txHashes, watchedTxHashes)
+	})
+}
+
+func TestWatcherGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	wg, _ := groups.NewWatcherGroup(nil)
+	assert.True(t, wg.IsInterfaceNil())
+
+	wg, _ = groups.NewWatcherGroup(&mocks.FacadeStub{})
+	assert.False(t, wg.IsInterfaceNil())
+}
+
+func getWatcherRoutesConfig() config.APIRoutesConfig {
+	return config.APIRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"watcher": {
+				Routes: []config.RouteConfig{
+					{Name: "/txs", Open: true},
+				},
+			},
+		},
+	}
+}
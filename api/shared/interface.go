@@ -25,12 +25,17 @@ type GroupHandler interface {
 // FacadeHandler defines the behavior of a notifier base facade handler
 type FacadeHandler interface {
 	HandlePushEvents(events data.ArgsSaveBlockData) error
-	HandleRevertEvents(revertBlock data.RevertBlock)
-	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	HandleRevertEvents(revertBlock data.RevertBlock) error
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error
+	HandleRoundsInfo(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRating(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error
+	WatchTxs(txHashes []string) error
 	GetConnectorUserAndPass() (string, string)
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	GetMetrics() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheus() string
+	GetShardsStatus() []data.ShardStatus
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,431 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+var log = logger.GetOrCreate("backfill")
+
+const (
+	backfillBlockMetricID   = "Backfill-block"
+	backfillFailureMetricID = "Backfill-failure"
+
+	// the shard routes are specific to the proxy REST API, which serves all the shards,
+	// while a node API only serves its own shard, without the shard in path
+	networkConfigPath = "/network/config"
+	networkStatusPath = "/network/status/%d"
+	blockByNoncePath  = "/block/%d/by-nonce/%d?withTxs=true&withLogs=true"
+
+	leadershipWaitTimeout = time.Second * 5
+	leadershipCheckPeriod = time.Millisecond * 100
+)
+
+// ArgsBackfiller defines the arguments needed for backfiller creation
+type ArgsBackfiller struct {
+	Config               config.BackfillConfig
+	LeaderElector        LeaderElector
+	PubKeyConverter      core.PubkeyConverter
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+type apiResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type networkConfigResponse struct {
+	Config struct {
+		NumShards uint32 `json:"erd_num_shards_without_meta"`
+	} `json:"config"`
+}
+
+type networkStatusResponse struct {
+	Status struct {
+		Nonce uint64 `json:"erd_nonce"`
+	} `json:"status"`
+}
+
+type blockResponse struct {
+	Block *api.Block `json:"block"`
+}
+
+// backfiller fetches, on startup, the blocks produced while the notifier was down from a
+// proxy REST API, and it pushes them through the events handler, concurrently with the live
+// blocks. The nonce of the last published block of each shard is persisted in a local state
+// file. While a shard is backfilled, the published live nonces of that shard are deferred, so
+// that the state does not skip the missed blocks if the notifier stops during the backfill.
+type backfiller struct {
+	url               string
+	maxBlocksPerShard uint64
+	httpClient        *http.Client
+	leaderElector     LeaderElector
+	leadershipTimeout time.Duration
+	metricsHandler    common.StatusMetricsHandler
+	converter         *blockConverter
+	state             *noncesState
+	startNonces       map[uint32]uint64
+	startShardIDs     []uint32
+
+	mutBackfilling    sync.Mutex
+	backfillingShards map[uint32]uint64
+	deferredNonces    map[uint32]uint64
+	closed            bool
+	wg                sync.WaitGroup
+	ctx               context.Context
+	cancel            context.CancelFunc
+}
+
+// NewBackfiller creates a new backfiller instance
+func NewBackfiller(args ArgsBackfiller) (*backfiller, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := newNoncesState(args.Config.StateFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	startNonces := state.getNonces()
+	backfillingShards := make(map[uint32]uint64, len(startNonces))
+	for shardID := range startNonces {
+		backfillingShards[shardID] = 0
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &backfiller{
+		url:               strings.TrimSuffix(args.Config.URL, "/"),
+		maxBlocksPerShard: uint64(args.Config.MaxBlocksPerShard),
+		httpClient: &http.Client{
+			Timeout: time.Duration(args.Config.RequestTimeoutInSec) * time.Second,
+		},
+		leaderElector:     args.LeaderElector,
+		leadershipTimeout: leadershipWaitTimeout,
+		metricsHandler:    args.StatusMetricsHandler,
+		converter: &blockConverter{
+			pubKeyConverter: args.PubKeyConverter,
+		},
+		state:             state,
+		startNonces:       startNonces,
+		startShardIDs:     state.getShardIDs(),
+		backfillingShards: backfillingShards,
+		deferredNonces:    make(map[uint32]uint64),
+		ctx:               ctx,
+		cancel:            cancel,
+	}, nil
+}
+
+func checkArgs(args ArgsBackfiller) error {
+	if check.IfNil(args.LeaderElector) {
+		return ErrNilLeaderElector
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if len(args.Config.URL) == 0 {
+		return ErrEmptyURL
+	}
+	if len(args.Config.StateFilePath) == 0 {
+		return ErrEmptyStateFilePath
+	}
+	if args.Config.MaxBlocksPerShard == 0 {
+		return fmt.Errorf("%w for MaxBlocksPerShard", ErrInvalidValue)
+	}
+	if args.Config.RequestTimeoutInSec == 0 {
+		return fmt.Errorf("%w for RequestTimeoutInSec", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// BlockPublished records the provided nonce as published for its shard. It is called only
+// for the published blocks, so the blocks skipped by a follower instance, or still buffered
+// by the blocks ordering, are not recorded. The nonces above the backfilled range of a shard
+// are recorded once the backfill of that shard ends.
+func (b *backfiller) BlockPublished(shardID uint32, nonce uint64) {
+	b.mutBackfilling.Lock()
+	targetNonce, isBackfilling := b.backfillingShards[shardID]
+	if isBackfilling && nonce > targetNonce {
+		if nonce > b.deferredNonces[shardID] {
+			b.deferredNonces[shardID] = nonce
+		}
+		b.mutBackfilling.Unlock()
+		return
+	}
+	b.mutBackfilling.Unlock()
+
+	b.setNonce(shardID, nonce)
+}
+
+func (b *backfiller) setNonce(shardID uint32, nonce uint64) {
+	err := b.state.setNonce(shardID, nonce)
+	if err != nil {
+		log.Warn("backfiller: could not save state", "error", err)
+	}
+}
+
+// Backfill fetches and handles the blocks produced after the last published nonce, loaded on
+// startup, of each known shard. It can run concurrently with the live processing, the blocks
+// handled by both being filtered by the duplicates check. The backfill is skipped if the
+// instance is not the leader, since a follower does not publish the blocks. A failed backfill
+// is counted in the failure metric, while a backfill interrupted by Close is not an error.
+func (b *backfiller) Backfill(eventsHandler common.SaveBlockEventsHandler) error {
+	if check.IfNil(eventsHandler) {
+		return ErrNilEventsHandler
+	}
+
+	b.mutBackfilling.Lock()
+	if b.closed {
+		b.mutBackfilling.Unlock()
+		return nil
+	}
+	b.wg.Add(1)
+	b.mutBackfilling.Unlock()
+	defer b.wg.Done()
+
+	err := b.backfill(eventsHandler)
+	if b.ctx.Err() != nil {
+		log.Info("backfiller: backfill interrupted")
+		return nil
+	}
+
+	b.endBackfilling()
+	if err != nil {
+		b.metricsHandler.AddRequest(backfillFailureMetricID, 0)
+	}
+
+	return err
+}
+
+func (b *backfiller) backfill(eventsHandler common.SaveBlockEventsHandler) error {
+	if len(b.startShardIDs) == 0 {
+		log.Info("backfiller: no previous state, nothing to backfill")
+		return nil
+	}
+
+	if !b.waitForLeadership() {
+		log.Info("backfiller: not the leader instance, skipping backfill")
+		return nil
+	}
+
+	configResponse := &networkConfigResponse{}
+	err := b.get(networkConfigPath, configResponse)
+	if err != nil {
+		return err
+	}
+	numberOfShards := configResponse.Config.NumShards
+
+	for _, shardID := range b.startShardIDs {
+		err = b.backfillShard(eventsHandler, shardID, b.startNonces[shardID], numberOfShards)
+		if err != nil {
+			return err
+		}
+
+		b.endShardBackfilling(shardID)
+	}
+
+	return nil
+}
+
+// waitForLeadership waits, for a limited time, for the leader election to complete
+func (b *backfiller) waitForLeadership() bool {
+	timeout := time.After(b.leadershipTimeout)
+	for !b.leaderElector.IsLeader() {
+		select {
+		case <-timeout:
+			return false
+		case <-b.ctx.Done():
+			return false
+		case <-time.After(leadershipCheckPeriod):
+		}
+	}
+
+	return true
+}
+
+func (b *backfiller) setBackfillTarget(shardID uint32, targetNonce uint64) {
+	b.mutBackfilling.Lock()
+	defer b.mutBackfilling.Unlock()
+
+	_, isBackfilling := b.backfillingShards[shardID]
+	if isBackfilling {
+		b.backfillingShards[shardID] = targetNonce
+	}
+}
+
+// endShardBackfilling stops deferring the published nonces of the provided shard, and it
+// records the highest deferred one
+func (b *backfiller) endShardBackfilling(shardID uint32) {
+	b.mutBackfilling.Lock()
+	deferredNonce, hasDeferredNonce := b.deferredNonces[shardID]
+	delete(b.backfillingShards, shardID)
+	delete(b.deferredNonces, shardID)
+	b.mutBackfilling.Unlock()
+
+	if hasDeferredNonce {
+		b.setNonce(shardID, deferredNonce)
+	}
+}
+
+// endBackfilling stops deferring the published nonces of all the shards
+func (b *backfiller) endBackfilling() {
+	b.mutBackfilling.Lock()
+	shardIDs := make([]uint32, 0, len(b.backfillingShards))
+	for shardID := range b.backfillingShards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	b.mutBackfilling.Unlock()
+
+	for _, shardID := range shardIDs {
+		b.endShardBackfilling(shardID)
+	}
+}
+
+func (b *backfiller) backfillShard(
+	eventsHandler common.SaveBlockEventsHandler,
+	shardID uint32,
+	lastNonce uint64,
+	numberOfShards uint32,
+) error {
+	statusResponse := &networkStatusResponse{}
+	err := b.get(fmt.Sprintf(networkStatusPath, shardID), statusResponse)
+	if err != nil {
+		return err
+	}
+
+	currentNonce := statusResponse.Status.Nonce
+	b.setBackfillTarget(shardID, currentNonce)
+	if currentNonce <= lastNonce {
+		return nil
+	}
+
+	startNonce := lastNonce + 1
+	if currentNonce-lastNonce > b.maxBlocksPerShard {
+		startNonce = currentNonce - b.maxBlocksPerShard + 1
+		log.Warn("backfiller: too many missed blocks, only the most recent ones will be backfilled",
+			"shard", shardID,
+			"last published nonce", lastNonce,
+			"current nonce", currentNonce,
+			"skipped blocks", startNonce-lastNonce-1,
+		)
+	}
+
+	log.Info("backfiller: backfilling shard", "shard", shardID, "from nonce", startNonce, "to nonce", currentNonce)
+
+	for nonce := startNonce; nonce <= currentNonce; nonce++ {
+		if b.ctx.Err() != nil {
+			return b.ctx.Err()
+		}
+		if !b.leaderElector.IsLeader() {
+			return ErrLeadershipLost
+		}
+
+		err = b.backfillBlock(eventsHandler, shardID, nonce, numberOfShards)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillBlock handles the block with the provided nonce. Its nonce is recorded by the
+// events handler, once the block is published
+func (b *backfiller) backfillBlock(
+	eventsHandler common.SaveBlockEventsHandler,
+	shardID uint32,
+	nonce uint64,
+	numberOfShards uint32,
+) error {
+	t := time.Now()
+
+	response := &blockResponse{}
+	err := b.get(fmt.Sprintf(blockByNoncePath, shardID, nonce), response)
+	if err != nil {
+		return err
+	}
+
+	blockData, err := b.converter.convert(response.Block, numberOfShards)
+	if err != nil {
+		return err
+	}
+
+	err = eventsHandler.HandleSaveBlockEvents(*blockData)
+	if err != nil {
+		return err
+	}
+
+	b.metricsHandler.AddRequest(backfillBlockMetricID, time.Since(t))
+
+	return nil
+}
+
+func (b *backfiller) get(path string, value interface{}) error {
+	req, err := http.NewRequestWithContext(b.ctx, http.MethodGet, b.url+path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	response := &apiResponse{}
+	errUnmarshal := json.Unmarshal(body, response)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: path %s, status code %d, error %s", ErrAPIRequestFailed, path, resp.StatusCode, response.Error)
+	}
+	if errUnmarshal != nil {
+		return errUnmarshal
+	}
+
+	return json.Unmarshal(response.Data, value)
+}
+
+// Close stops the backfill in progress, waits for it to return, and closes the idle
+// connections of the http client
+func (b *backfiller) Close() error {
+	b.mutBackfilling.Lock()
+	b.closed = true
+	b.mutBackfilling.Unlock()
+
+	b.cancel()
+	b.wg.Wait()
+
+	b.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *backfiller) IsInterfaceNil() bool {
+	return b == nil
+}
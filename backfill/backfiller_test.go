@@ -0,0 +1,614 @@
+package backfill_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/backfill"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNodeAPI serves the network config, network status and block by nonce proxy routes
+type fakeNodeAPI struct {
+	mut            sync.Mutex
+	currentNonces  map[uint32]uint64
+	failingShard   uint32
+	requestedPaths []string
+}
+
+func newFakeNodeAPI(currentNonces map[uint32]uint64) *fakeNodeAPI {
+	return &fakeNodeAPI{
+		currentNonces: currentNonces,
+		failingShard:  uint32(100),
+	}
+}
+
+func (api *fakeNodeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mut.Lock()
+	defer api.mut.Unlock()
+
+	api.requestedPaths = append(api.requestedPaths, r.URL.Path)
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/network/config":
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"config": map[string]interface{}{
+				"erd_num_shards_without_meta": 3,
+			},
+		})
+	case len(parts) == 3 && parts[0] == "network" && parts[1] == "status":
+		shardID, _ := strconv.ParseUint(parts[2], 10, 32)
+		if uint32(shardID) == api.failingShard {
+			writeAPIResponse(w, http.StatusInternalServerError, nil)
+			return
+		}
+
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"status": map[string]interface{}{
+				"erd_nonce": api.currentNonces[uint32(shardID)],
+			},
+		})
+	case len(parts) == 4 && parts[0] == "block" && parts[2] == "by-nonce":
+		if r.URL.Query().Get("withTxs") != "true" || r.URL.Query().Get("withLogs") != "true" {
+			writeAPIResponse(w, http.StatusBadRequest, nil)
+			return
+		}
+
+		shardID, _ := strconv.ParseUint(parts[1], 10, 32)
+		nonce, _ := strconv.ParseUint(parts[3], 10, 64)
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"block": createAPIBlock(uint32(shardID), nonce),
+		})
+	default:
+		writeAPIResponse(w, http.StatusNotFound, nil)
+	}
+}
+
+func (api *fakeNodeAPI) getRequestedPaths() []string {
+	api.mut.Lock()
+	defer api.mut.Unlock()
+
+	return append([]string{}, api.requestedPaths...)
+}
+
+func writeAPIResponse(w http.ResponseWriter, statusCode int, responseData interface{}) {
+	response := map[string]interface{}{
+		"data":  responseData,
+		"error": "",
+		"code":  "successful",
+	}
+	if statusCode != http.StatusOK {
+		response["error"] = "request failed"
+		response["code"] = "internal_issue"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func createMockBackfillerArgs(t *testing.T, url string) backfill.ArgsBackfiller {
+	return backfill.ArgsBackfiller{
+		Config: config.BackfillConfig{
+			Enabled:             true,
+			URL:                 url,
+			StateFilePath:       filepath.Join(t.TempDir(), "state.json"),
+			MaxBlocksPerShard:   100,
+			RequestTimeoutInSec: 5,
+		},
+		LeaderElector:        &mocks.LeaderElectorStub{},
+		PubKeyConverter:      &mocks.PubkeyConverterMock{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+// createPublishingEventsHandler returns an events handler which records the handled blocks
+// as published, as the events handler does when publishing a block
+func createPublishingEventsHandler(b common.BlocksBackfiller, handler func(allEvents data.ArgsSaveBlockData) error) *mocks.EventsHandlerStub {
+	return &mocks.EventsHandlerStub{
+		HandleSaveBlockEventsCalled: func(allEvents data.ArgsSaveBlockData) error {
+			err := handler(allEvents)
+			if err != nil {
+				return err
+			}
+
+			b.BlockPublished(allEvents.Header.GetShardID(), allEvents.Header.GetNonce())
+			return nil
+		},
+	}
+}
+
+func writeState(t *testing.T, filePath string, lastNonces map[uint32]uint64) {
+	stateBytes, err := json.Marshal(lastNonces)
+	require.Nil(t, err)
+
+	err = os.WriteFile(filePath, stateBytes, 0644)
+	require.Nil(t, err)
+}
+
+func readState(t *testing.T, filePath string) map[uint32]uint64 {
+	stateBytes, err := os.ReadFile(filePath)
+	require.Nil(t, err)
+
+	lastNonces := make(map[uint32]uint64)
+	err = json.Unmarshal(stateBytes, &lastNonces)
+	require.Nil(t, err)
+
+	return lastNonces
+}
+
+func TestNewBackfiller(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil leader elector", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.LeaderElector = nil
+
+		b, err := backfill.NewBackfiller(args)
+		require.Equal(t, backfill.ErrNilLeaderElector, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("nil pubkey converter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.PubKeyConverter = nil
+
+		b, err := backfill.NewBackfiller(args)
+		require.Equal(t, backfill.ErrNilPubKeyConverter, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.StatusMetricsHandler = nil
+
+		b, err := backfill.NewBackfiller(args)
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("empty url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "")
+
+		b, err := backfill.NewBackfiller(args)
+		require.Equal(t, backfill.ErrEmptyURL, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("empty state file path", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.Config.StateFilePath = ""
+
+		b, err := backfill.NewBackfiller(args)
+		require.Equal(t, backfill.ErrEmptyStateFilePath, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("invalid max blocks per shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.Config.MaxBlocksPerShard = 0
+
+		b, err := backfill.NewBackfiller(args)
+		require.True(t, errors.Is(err, backfill.ErrInvalidValue))
+		require.Nil(t, b)
+	})
+
+	t.Run("invalid request timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		args.Config.RequestTimeoutInSec = 0
+
+		b, err := backfill.NewBackfiller(args)
+		require.True(t, errors.Is(err, backfill.ErrInvalidValue))
+		require.Nil(t, b)
+	})
+
+	t.Run("corrupted state file", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBackfillerArgs(t, "http://localhost")
+		err := os.WriteFile(args.Config.StateFilePath, []byte("not a json"), 0644)
+		require.Nil(t, err)
+
+		b, err := backfill.NewBackfiller(args)
+		require.NotNil(t, err)
+		require.Nil(t, b)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := backfill.NewBackfiller(createMockBackfillerArgs(t, "http://localhost"))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(b))
+		require.Nil(t, b.Close())
+	})
+}
+
+func TestBackfiller_BlockPublished(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBackfillerArgs(t, "http://localhost")
+	b, _ := backfill.NewBackfiller(args)
+
+	b.BlockPublished(0, 10)
+	b.BlockPublished(core.MetachainShardId, 7)
+	b.BlockPublished(0, 9)
+
+	expectedState := map[uint32]uint64{
+		0:                     10,
+		core.MetachainShardId: 7,
+	}
+	require.Equal(t, expectedState, readState(t, args.Config.StateFilePath))
+}
+
+func TestBackfiller_Backfill(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no previous state, should not request blocks", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		eventsHandler := &mocks.EventsHandlerStub{
+			HandleSaveBlockEventsCalled: func(allEvents data.ArgsSaveBlockData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		b, _ := backfill.NewBackfiller(args)
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Empty(t, nodeAPI.getRequestedPaths())
+	})
+
+	t.Run("nil events handler, should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := backfill.NewBackfiller(createMockBackfillerArgs(t, "http://localhost"))
+		err := b.Backfill(nil)
+		require.Equal(t, backfill.ErrNilEventsHandler, err)
+	})
+
+	t.Run("not the leader, should skip the backfill", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				return false
+			},
+		}
+		eventsHandler := &mocks.EventsHandlerStub{
+			HandleSaveBlockEventsCalled: func(allEvents data.ArgsSaveBlockData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		b.SetLeadershipTimeout(time.Millisecond * 200)
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Empty(t, nodeAPI.getRequestedPaths())
+		require.Equal(t, map[uint32]uint64{0: 10}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("should wait for the leadership", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 12})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		numLeaderChecks := 0
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				numLeaderChecks++
+				return numLeaderChecks > 2
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		handledNonces := make([]uint64, 0)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			handledNonces = append(handledNonces, allEvents.Header.GetNonce())
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{11, 12}, handledNonces)
+		require.Equal(t, map[uint32]uint64{0: 12}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("leadership lost, should stop", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		isLeader := true
+		args := createMockBackfillerArgs(t, server.URL)
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				return isLeader
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			isLeader = false
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Equal(t, backfill.ErrLeadershipLost, err)
+		require.Equal(t, map[uint32]uint64{0: 11}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("handled but not published blocks, should not advance the state", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 13})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		numHandled := 0
+		args := createMockBackfillerArgs(t, server.URL)
+		eventsHandler := &mocks.EventsHandlerStub{
+			HandleSaveBlockEventsCalled: func(allEvents data.ArgsSaveBlockData) error {
+				numHandled++
+				return nil
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Equal(t, 3, numHandled)
+		require.Equal(t, map[uint32]uint64{0: 10}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("should handle the missed blocks in order", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{
+			0:                     13,
+			1:                     5,
+			core.MetachainShardId: 8,
+		})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		handledBlocks := make([]string, 0)
+		numMetrics := 0
+		args := createMockBackfillerArgs(t, server.URL+"/")
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, _ time.Duration) {
+				require.Equal(t, "Backfill-block", path)
+				numMetrics++
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{
+			0:                     10,
+			1:                     5,
+			core.MetachainShardId: 7,
+		})
+
+		b, _ := backfill.NewBackfiller(args)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			require.Equal(t, uint32(3), allEvents.NumberOfShards)
+			handledBlocks = append(handledBlocks, fmt.Sprintf("%d-%d", allEvents.Header.GetShardID(), allEvents.Header.GetNonce()))
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+
+		expectedBlocks := []string{
+			"0-11",
+			"0-12",
+			"0-13",
+			fmt.Sprintf("%d-8", core.MetachainShardId),
+		}
+		require.Equal(t, expectedBlocks, handledBlocks)
+		require.Equal(t, 4, numMetrics)
+
+		expectedState := map[uint32]uint64{
+			0:                     13,
+			1:                     5,
+			core.MetachainShardId: 8,
+		}
+		require.Equal(t, expectedState, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("too many missed blocks, should handle only the most recent ones", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		handledNonces := make([]uint64, 0)
+		args := createMockBackfillerArgs(t, server.URL)
+		args.Config.MaxBlocksPerShard = 3
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			handledNonces = append(handledNonces, allEvents.Header.GetNonce())
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+
+		require.Equal(t, []uint64{18, 19, 20}, handledNonces)
+		require.Equal(t, map[uint32]uint64{0: 20}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("api request failed, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		nodeAPI.failingShard = 0
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		failureMetrics := 0
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, _ time.Duration) {
+				require.Equal(t, "Backfill-failure", path)
+				failureMetrics++
+			},
+		}
+
+		b, _ := backfill.NewBackfiller(args)
+		err := b.Backfill(&mocks.EventsHandlerStub{})
+		require.True(t, errors.Is(err, backfill.ErrAPIRequestFailed))
+		require.Equal(t, map[uint32]uint64{0: 10}, readState(t, args.Config.StateFilePath))
+		require.Equal(t, 1, failureMetrics)
+	})
+
+	t.Run("live blocks published while backfilling, should record their nonces after the backfill", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 12, 1: 5})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10, 1: 3})
+
+		b, _ := backfill.NewBackfiller(args)
+		b.BlockPublished(1, 8)
+		b.BlockPublished(2, 4)
+		require.Equal(t, map[uint32]uint64{0: 10, 1: 3, 2: 4}, readState(t, args.Config.StateFilePath))
+
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			if allEvents.Header.GetShardID() == 0 && allEvents.Header.GetNonce() == 11 {
+				b.BlockPublished(0, 30)
+				require.Equal(t, uint64(10), readState(t, args.Config.StateFilePath)[0])
+			}
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]uint64{0: 30, 1: 8, 2: 4}, readState(t, args.Config.StateFilePath))
+	})
+
+	t.Run("closed while backfilling, should stop and keep the backfilled nonce", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		args := createMockBackfillerArgs(t, server.URL)
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, _ time.Duration) {
+				require.NotEqual(t, "Backfill-failure", path)
+			},
+		}
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		closed := make(chan struct{})
+		handledNonces := make([]uint64, 0)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			handledNonces = append(handledNonces, allEvents.Header.GetNonce())
+			if allEvents.Header.GetNonce() == 11 {
+				b.BlockPublished(0, 30)
+				go func() {
+					_ = b.Close()
+					close(closed)
+				}()
+				time.Sleep(time.Millisecond * 100)
+			}
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		<-closed
+		require.Equal(t, []uint64{11}, handledNonces)
+		require.Equal(t, map[uint32]uint64{0: 11}, readState(t, args.Config.StateFilePath))
+
+		err = b.Backfill(eventsHandler)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{11}, handledNonces)
+	})
+
+	t.Run("handle error, should stop and keep the last published nonce", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAPI := newFakeNodeAPI(map[uint32]uint64{0: 20})
+		server := httptest.NewServer(nodeAPI)
+		defer server.Close()
+
+		expectedErr := errors.New("expected error")
+		args := createMockBackfillerArgs(t, server.URL)
+		writeState(t, args.Config.StateFilePath, map[uint32]uint64{0: 10})
+
+		b, _ := backfill.NewBackfiller(args)
+		eventsHandler := createPublishingEventsHandler(b, func(allEvents data.ArgsSaveBlockData) error {
+			if allEvents.Header.GetNonce() == 12 {
+				return expectedErr
+			}
+			return nil
+		})
+
+		err := b.Backfill(eventsHandler)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, map[uint32]uint64{0: 11}, readState(t, args.Config.StateFilePath))
+	})
+}
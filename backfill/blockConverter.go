@@ -0,0 +1,261 @@
+package backfill
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// blockConverter converts the blocks returned by the node API, fetched with transactions
+// and logs, into the save block data received from the observers. The epoch start data
+// and the altered accounts are not available through the API, so they are not set.
+type blockConverter struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+func (bc *blockConverter) convert(apiBlock *api.Block, numberOfShards uint32) (*data.ArgsSaveBlockData, error) {
+	if apiBlock == nil {
+		return nil, ErrNilAPIBlock
+	}
+
+	headerHash, err := hex.DecodeString(apiBlock.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := createHeader(apiBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &outport.TransactionPool{
+		Transactions:         make(map[string]*outport.TxInfo),
+		SmartContractResults: make(map[string]*outport.SCRInfo),
+		Rewards:              make(map[string]*outport.RewardInfo),
+		InvalidTxs:           make(map[string]*outport.TxInfo),
+		Logs:                 make([]*outport.LogData, 0),
+	}
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(apiBlock.MiniBlocks)),
+	}
+
+	executionOrder := uint32(0)
+	for _, apiMiniBlock := range apiBlock.MiniBlocks {
+		if apiMiniBlock == nil {
+			continue
+		}
+
+		miniBlock := &block.MiniBlock{
+			TxHashes:        make([][]byte, 0, len(apiMiniBlock.Transactions)),
+			SenderShardID:   apiMiniBlock.SourceShard,
+			ReceiverShardID: apiMiniBlock.DestinationShard,
+			Type:            block.Type(block.Type_value[apiMiniBlock.Type]),
+		}
+
+		for _, apiTx := range apiMiniBlock.Transactions {
+			if apiTx == nil {
+				continue
+			}
+
+			txHash, errDecode := hex.DecodeString(apiTx.Hash)
+			if errDecode != nil {
+				return nil, errDecode
+			}
+			miniBlock.TxHashes = append(miniBlock.TxHashes, txHash)
+
+			bc.addTransaction(pool, apiTx, executionOrder)
+			bc.addLogs(pool, apiTx)
+			executionOrder++
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return &data.ArgsSaveBlockData{
+		HeaderHash:       headerHash,
+		Body:             body,
+		Header:           header,
+		TransactionsPool: pool,
+		NumberOfShards:   numberOfShards,
+	}, nil
+}
+
+func createHeader(apiBlock *api.Block) (nodeData.HeaderHandler, error) {
+	prevHash, err := hex.DecodeString(apiBlock.PrevBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiBlock.Shard == core.MetachainShardId {
+		return &block.MetaBlock{
+			Nonce:     apiBlock.Nonce,
+			Round:     apiBlock.Round,
+			Epoch:     apiBlock.Epoch,
+			TimeStamp: uint64(apiBlock.Timestamp),
+			PrevHash:  prevHash,
+			TxCount:   apiBlock.NumTxs,
+		}, nil
+	}
+
+	return &block.Header{
+		Nonce:     apiBlock.Nonce,
+		Round:     apiBlock.Round,
+		Epoch:     apiBlock.Epoch,
+		ShardID:   apiBlock.Shard,
+		TimeStamp: uint64(apiBlock.Timestamp),
+		PrevHash:  prevHash,
+		TxCount:   apiBlock.NumTxs,
+	}, nil
+}
+
+func (bc *blockConverter) addTransaction(pool *outport.TransactionPool, apiTx *transaction.ApiTransactionResult, executionOrder uint32) {
+	switch transaction.TxType(apiTx.Type) {
+	case transaction.TxTypeNormal:
+		pool.Transactions[apiTx.Hash] = &outport.TxInfo{
+			Transaction:    bc.createTransaction(apiTx),
+			FeeInfo:        createFeeInfo(apiTx),
+			ExecutionOrder: executionOrder,
+		}
+	case transaction.TxTypeInvalid:
+		pool.InvalidTxs[apiTx.Hash] = &outport.TxInfo{
+			Transaction:    bc.createTransaction(apiTx),
+			FeeInfo:        createFeeInfo(apiTx),
+			ExecutionOrder: executionOrder,
+		}
+	case transaction.TxTypeUnsigned:
+		pool.SmartContractResults[apiTx.Hash] = &outport.SCRInfo{
+			SmartContractResult: bc.createSmartContractResult(apiTx),
+			FeeInfo:             createFeeInfo(apiTx),
+			ExecutionOrder:      executionOrder,
+		}
+	case transaction.TxTypeReward:
+		pool.Rewards[apiTx.Hash] = &outport.RewardInfo{
+			Reward: &rewardTx.RewardTx{
+				Round:   apiTx.Round,
+				Epoch:   apiTx.Epoch,
+				Value:   toBigInt(apiTx.Value),
+				RcvAddr: bc.decodeAddress(apiTx.Receiver),
+			},
+			ExecutionOrder: executionOrder,
+		}
+	default:
+		log.Debug("blockConverter: unknown transaction type", "type", apiTx.Type, "tx hash", apiTx.Hash)
+	}
+}
+
+func (bc *blockConverter) createTransaction(apiTx *transaction.ApiTransactionResult) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:             apiTx.Nonce,
+		Value:             toBigInt(apiTx.Value),
+		RcvAddr:           bc.decodeAddress(apiTx.Receiver),
+		RcvUserName:       apiTx.ReceiverUsername,
+		SndAddr:           bc.decodeAddress(apiTx.Sender),
+		SndUserName:       apiTx.SenderUsername,
+		GasPrice:          apiTx.GasPrice,
+		GasLimit:          apiTx.GasLimit,
+		Data:              apiTx.Data,
+		ChainID:           []byte(apiTx.ChainID),
+		Version:           apiTx.Version,
+		Signature:         decodeHex(apiTx.Signature),
+		Options:           apiTx.Options,
+		GuardianAddr:      bc.decodeAddress(apiTx.GuardianAddr),
+		GuardianSignature: decodeHex(apiTx.GuardianSignature),
+	}
+}
+
+func (bc *blockConverter) createSmartContractResult(apiTx *transaction.ApiTransactionResult) *smartContractResult.SmartContractResult {
+	return &smartContractResult.SmartContractResult{
+		Nonce:          apiTx.Nonce,
+		Value:          toBigInt(apiTx.Value),
+		RcvAddr:        bc.decodeAddress(apiTx.Receiver),
+		SndAddr:        bc.decodeAddress(apiTx.Sender),
+		RelayerAddr:    bc.decodeAddress(apiTx.RelayerAddress),
+		RelayedValue:   toBigInt(apiTx.RelayedValue),
+		Code:           []byte(apiTx.Code),
+		Data:           apiTx.Data,
+		PrevTxHash:     decodeHex(apiTx.PreviousTransactionHash),
+		OriginalTxHash: decodeHex(apiTx.OriginalTransactionHash),
+		GasLimit:       apiTx.GasLimit,
+		GasPrice:       apiTx.GasPrice,
+		CodeMetadata:   apiTx.CodeMetadata,
+		ReturnMessage:  []byte(apiTx.ReturnMessage),
+		OriginalSender: bc.decodeAddress(apiTx.OriginalSender),
+	}
+}
+
+func (bc *blockConverter) addLogs(pool *outport.TransactionPool, apiTx *transaction.ApiTransactionResult) {
+	if apiTx.Logs == nil {
+		return
+	}
+
+	events := make([]*transaction.Event, 0, len(apiTx.Logs.Events))
+	for _, apiEvent := range apiTx.Logs.Events {
+		if apiEvent == nil {
+			continue
+		}
+
+		events = append(events, &transaction.Event{
+			Address:    bc.decodeAddress(apiEvent.Address),
+			Identifier: []byte(apiEvent.Identifier),
+			Topics:     apiEvent.Topics,
+			Data:       apiEvent.Data,
+		})
+	}
+
+	pool.Logs = append(pool.Logs, &outport.LogData{
+		TxHash: apiTx.Hash,
+		Log: &transaction.Log{
+			Address: bc.decodeAddress(apiTx.Logs.Address),
+			Events:  events,
+		},
+	})
+}
+
+func (bc *blockConverter) decodeAddress(address string) []byte {
+	if len(address) == 0 {
+		return nil
+	}
+
+	decoded, err := bc.pubKeyConverter.Decode(address)
+	if err != nil {
+		log.Debug("blockConverter: could not decode address", "address", address, "error", err)
+		return nil
+	}
+
+	return decoded
+}
+
+func createFeeInfo(apiTx *transaction.ApiTransactionResult) *outport.FeeInfo {
+	return &outport.FeeInfo{
+		GasUsed:        apiTx.GasUsed,
+		Fee:            toBigInt(apiTx.Fee),
+		InitialPaidFee: toBigInt(apiTx.InitiallyPaidFee),
+	}
+}
+
+func toBigInt(value string) *big.Int {
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+
+	return bigValue
+}
+
+func decodeHex(value string) []byte {
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+
+	return decoded
+}
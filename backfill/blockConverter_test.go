@@ -0,0 +1,206 @@
+package backfill_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/backfill"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderAddress   = "aa01"
+	receiverAddress = "bb02"
+)
+
+func createAPIBlock(shardID uint32, nonce uint64) *api.Block {
+	txHash := hex.EncodeToString([]byte{byte(nonce), 1})
+	scrHash := hex.EncodeToString([]byte{byte(nonce), 2})
+
+	return &api.Block{
+		Nonce:         nonce,
+		Round:         nonce + 100,
+		Epoch:         2,
+		Shard:         shardID,
+		Hash:          hex.EncodeToString([]byte{byte(nonce)}),
+		PrevBlockHash: hex.EncodeToString([]byte{byte(nonce - 1)}),
+		NumTxs:        2,
+		Timestamp:     time.Duration(1000 + nonce),
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Type:             block.TxBlock.String(),
+				SourceShard:      shardID,
+				DestinationShard: shardID,
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Type:     string(transaction.TxTypeNormal),
+						Hash:     txHash,
+						Nonce:    nonce,
+						Value:    "1000",
+						Sender:   senderAddress,
+						Receiver: receiverAddress,
+						GasPrice: 10,
+						GasLimit: 100,
+						Data:     []byte("transfer"),
+						Fee:      "50",
+						Logs: &transaction.ApiLogs{
+							Address: receiverAddress,
+							Events: []*transaction.Events{
+								{
+									Address:    receiverAddress,
+									Identifier: core.BuiltInFunctionESDTTransfer,
+									Topics:     [][]byte{[]byte("token")},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Type:             block.SmartContractResultBlock.String(),
+				SourceShard:      shardID,
+				DestinationShard: shardID,
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Type:                    string(transaction.TxTypeUnsigned),
+						Hash:                    scrHash,
+						Value:                   "20",
+						Sender:                  receiverAddress,
+						Receiver:                senderAddress,
+						PreviousTransactionHash: txHash,
+						OriginalTransactionHash: txHash,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConvertBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block", func(t *testing.T) {
+		t.Parallel()
+
+		blockData, err := backfill.ConvertBlock(&mocks.PubkeyConverterMock{}, nil, 3)
+		require.Equal(t, backfill.ErrNilAPIBlock, err)
+		require.Nil(t, blockData)
+	})
+
+	t.Run("invalid block hash", func(t *testing.T) {
+		t.Parallel()
+
+		apiBlock := createAPIBlock(0, 10)
+		apiBlock.Hash = "invalid hash"
+
+		blockData, err := backfill.ConvertBlock(&mocks.PubkeyConverterMock{}, apiBlock, 3)
+		require.NotNil(t, err)
+		require.Nil(t, blockData)
+	})
+
+	t.Run("shard block", func(t *testing.T) {
+		t.Parallel()
+
+		apiBlock := createAPIBlock(1, 10)
+		blockData, err := backfill.ConvertBlock(&mocks.PubkeyConverterMock{}, apiBlock, 3)
+		require.Nil(t, err)
+
+		require.Equal(t, []byte{10}, blockData.HeaderHash)
+		require.Equal(t, uint32(3), blockData.NumberOfShards)
+
+		header, ok := blockData.Header.(*block.Header)
+		require.True(t, ok)
+		require.Equal(t, uint32(1), header.GetShardID())
+		require.Equal(t, uint64(10), header.GetNonce())
+		require.Equal(t, uint64(110), header.GetRound())
+		require.Equal(t, uint32(2), header.GetEpoch())
+		require.Equal(t, uint64(1010), header.GetTimeStamp())
+		require.Equal(t, []byte{9}, header.GetPrevHash())
+
+		body, ok := blockData.Body.(*block.Body)
+		require.True(t, ok)
+		require.Equal(t, 2, len(body.MiniBlocks))
+		require.Equal(t, block.TxBlock, body.MiniBlocks[0].Type)
+		require.Equal(t, [][]byte{{10, 1}}, body.MiniBlocks[0].TxHashes)
+		require.Equal(t, block.SmartContractResultBlock, body.MiniBlocks[1].Type)
+
+		txHash := hex.EncodeToString([]byte{10, 1})
+		scrHash := hex.EncodeToString([]byte{10, 2})
+		pool := blockData.TransactionsPool
+
+		require.Equal(t, 1, len(pool.Transactions))
+		txInfo := pool.Transactions[txHash]
+		require.Equal(t, uint32(0), txInfo.ExecutionOrder)
+		require.Equal(t, big.NewInt(1000), txInfo.Transaction.GetValue())
+		require.Equal(t, []byte{0xaa, 0x01}, txInfo.Transaction.GetSndAddr())
+		require.Equal(t, []byte{0xbb, 0x02}, txInfo.Transaction.GetRcvAddr())
+		require.Equal(t, []byte("transfer"), txInfo.Transaction.GetData())
+		require.Equal(t, big.NewInt(50), txInfo.FeeInfo.GetFee())
+
+		require.Equal(t, 1, len(pool.SmartContractResults))
+		scrInfo := pool.SmartContractResults[scrHash]
+		require.Equal(t, uint32(1), scrInfo.ExecutionOrder)
+		require.Equal(t, big.NewInt(20), scrInfo.SmartContractResult.GetValue())
+		require.Equal(t, []byte{10, 1}, scrInfo.SmartContractResult.GetOriginalTxHash())
+
+		expectedLogs := []*outport.LogData{
+			{
+				TxHash: txHash,
+				Log: &transaction.Log{
+					Address: []byte{0xbb, 0x02},
+					Events: []*transaction.Event{
+						{
+							Address:    []byte{0xbb, 0x02},
+							Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+							Topics:     [][]byte{[]byte("token")},
+						},
+					},
+				},
+			},
+		}
+		require.Equal(t, expectedLogs, pool.Logs)
+	})
+
+	t.Run("metachain block", func(t *testing.T) {
+		t.Parallel()
+
+		apiBlock := createAPIBlock(core.MetachainShardId, 10)
+		apiBlock.MiniBlocks = []*api.MiniBlock{
+			{
+				Type:             block.RewardsBlock.String(),
+				SourceShard:      core.MetachainShardId,
+				DestinationShard: 0,
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Type:     string(transaction.TxTypeReward),
+						Hash:     "aa",
+						Round:    110,
+						Epoch:    2,
+						Value:    "300",
+						Receiver: receiverAddress,
+					},
+				},
+			},
+		}
+
+		blockData, err := backfill.ConvertBlock(&mocks.PubkeyConverterMock{}, apiBlock, 3)
+		require.Nil(t, err)
+
+		_, ok := blockData.Header.(*block.MetaBlock)
+		require.True(t, ok)
+		require.Equal(t, core.MetachainShardId, blockData.Header.GetShardID())
+
+		rewardInfo := blockData.TransactionsPool.Rewards["aa"]
+		require.Equal(t, big.NewInt(300), rewardInfo.Reward.GetValue())
+		require.Equal(t, []byte{0xbb, 0x02}, rewardInfo.Reward.GetRcvAddr())
+		require.Equal(t, uint64(110), rewardInfo.Reward.GetRound())
+	})
+}
@@ -0,0 +1,30 @@
+package backfill
+
+import "errors"
+
+// ErrNilEventsHandler signals that a nil events handler has been provided
+var ErrNilEventsHandler = errors.New("nil events handler")
+
+// ErrNilLeaderElector signals that a nil leader elector has been provided
+var ErrNilLeaderElector = errors.New("nil leader elector")
+
+// ErrLeadershipLost signals that the leadership has been lost while backfilling
+var ErrLeadershipLost = errors.New("leadership lost while backfilling")
+
+// ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pubkey converter")
+
+// ErrEmptyURL signals that an empty url has been provided
+var ErrEmptyURL = errors.New("empty url")
+
+// ErrEmptyStateFilePath signals that an empty state file path has been provided
+var ErrEmptyStateFilePath = errors.New("empty state file path")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrAPIRequestFailed signals that a request to the node API failed
+var ErrAPIRequestFailed = errors.New("node api request failed")
+
+// ErrNilAPIBlock signals that a nil block has been received from the node API
+var ErrNilAPIBlock = errors.New("nil api block")
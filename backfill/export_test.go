@@ -0,0 +1,23 @@
+package backfill
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// SetLeadershipTimeout -
+func (b *backfiller) SetLeadershipTimeout(timeout time.Duration) {
+	b.leadershipTimeout = timeout
+}
+
+// ConvertBlock -
+func ConvertBlock(pubKeyConverter core.PubkeyConverter, apiBlock *api.Block, numberOfShards uint32) (*data.ArgsSaveBlockData, error) {
+	converter := &blockConverter{
+		pubKeyConverter: pubKeyConverter,
+	}
+
+	return converter.convert(apiBlock, numberOfShards)
+}
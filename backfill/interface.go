@@ -0,0 +1,8 @@
+package backfill
+
+// LeaderElector defines the behaviour of the leader election component. Only the leader
+// instance backfills the missed blocks, since the followers do not publish them
+type LeaderElector interface {
+	IsLeader() bool
+	IsInterfaceNil() bool
+}
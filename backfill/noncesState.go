@@ -0,0 +1,114 @@
+package backfill
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+)
+
+const stateFilePerm = 0644
+
+// noncesState holds the last processed block nonce of each shard, persisted in a local file,
+// so that the blocks produced while the notifier was down can be fetched on the next start
+type noncesState struct {
+	mut       sync.Mutex
+	filePath  string
+	lastNonce map[uint32]uint64
+}
+
+func newNoncesState(filePath string) (*noncesState, error) {
+	state := &noncesState{
+		filePath:  filePath,
+		lastNonce: make(map[uint32]uint64),
+	}
+
+	err := state.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (ns *noncesState) load() error {
+	stateBytes, err := os.ReadFile(ns.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(stateBytes, &ns.lastNonce)
+	if err != nil {
+		return err
+	}
+
+	log.Info("loaded backfill state", "num shards", len(ns.lastNonce))
+
+	return nil
+}
+
+// setNonce updates the last published nonce of the provided shard, if it is higher than
+// the current one, and persists the state
+func (ns *noncesState) setNonce(shardID uint32, nonce uint64) error {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	lastNonce, exists := ns.lastNonce[shardID]
+	if exists && nonce <= lastNonce {
+		return nil
+	}
+
+	ns.lastNonce[shardID] = nonce
+
+	return ns.save()
+}
+
+// getNonces returns a copy of the last published nonces
+func (ns *noncesState) getNonces() map[uint32]uint64 {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	nonces := make(map[uint32]uint64, len(ns.lastNonce))
+	for shardID, nonce := range ns.lastNonce {
+		nonces[shardID] = nonce
+	}
+
+	return nonces
+}
+
+// getShardIDs returns the shards with a processed nonce, sorted
+func (ns *noncesState) getShardIDs() []uint32 {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	shardIDs := make([]uint32, 0, len(ns.lastNonce))
+	for shardID := range ns.lastNonce {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+// save writes the state to a temporary file first, so that the state file is not
+// corrupted if the process stops while writing
+func (ns *noncesState) save() error {
+	stateBytes, err := json.Marshal(ns.lastNonce)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := ns.filePath + ".tmp"
+	err = os.WriteFile(tmpFilePath, stateBytes, stateFilePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, ns.filePath)
+}
@@ -0,0 +1,55 @@
+package cloudevents
+
+import "encoding/json"
+
+const (
+	// SpecVersion defines the CloudEvents specification version
+	SpecVersion = "1.0"
+
+	// StructuredContentType defines the content type of a message in structured content mode
+	StructuredContentType = "application/cloudevents+json"
+
+	// StructuredMode defines the mode in which the event attributes and data are wrapped in a json envelope
+	StructuredMode = "structured"
+
+	// BinaryMode defines the mode in which the event attributes are set as transport headers,
+	// the message body holding only the event data
+	BinaryMode = "binary"
+)
+
+const (
+	// SpecVersionAttribute defines the name of the specversion context attribute
+	SpecVersionAttribute = "specversion"
+
+	// IDAttribute defines the name of the id context attribute
+	IDAttribute = "id"
+
+	// SourceAttribute defines the name of the source context attribute
+	SourceAttribute = "source"
+
+	// TypeAttribute defines the name of the type context attribute
+	TypeAttribute = "type"
+
+	// TimeAttribute defines the name of the time context attribute
+	TimeAttribute = "time"
+)
+
+// Envelope defines a CloudEvents 1.0 event in json format
+type Envelope struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      []byte          `json:"data_base64,omitempty"`
+}
+
+// EventInfo holds the block related info used to fill the event context attributes
+type EventInfo struct {
+	EventType  string
+	Hash       string
+	ShardID    uint32
+	HasShardID bool
+}
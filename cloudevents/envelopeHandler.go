@@ -0,0 +1,130 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+// ArgsEnvelopeHandler defines the arguments needed for envelope handler creation
+type ArgsEnvelopeHandler struct {
+	Config     config.CloudEventsConfig
+	Marshaller marshal.Marshalizer
+}
+
+type envelopeHandler struct {
+	mode            string
+	source          string
+	typePrefix      string
+	dataContentType string
+}
+
+// NewEnvelopeHandler creates a new component which wraps the published messages in
+// CloudEvents envelopes. The provided marshaller is the one used for the event data.
+func NewEnvelopeHandler(args ArgsEnvelopeHandler) (*envelopeHandler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dataContentType, _ := common.GetContentTypeAndEncoding(args.Marshaller)
+
+	return &envelopeHandler{
+		mode:            args.Config.Mode,
+		source:          args.Config.Source,
+		typePrefix:      args.Config.TypePrefix,
+		dataContentType: dataContentType,
+	}, nil
+}
+
+func checkArgs(args ArgsEnvelopeHandler) error {
+	if check.IfNil(args.Marshaller) {
+		return common.ErrNilMarshaller
+	}
+	if args.Config.Mode != StructuredMode && args.Config.Mode != BinaryMode {
+		return fmt.Errorf("%w: %s", ErrInvalidMode, args.Config.Mode)
+	}
+	if len(args.Config.Source) == 0 {
+		return ErrEmptySource
+	}
+	if len(args.Config.TypePrefix) == 0 {
+		return ErrEmptyTypePrefix
+	}
+
+	return nil
+}
+
+// CreateStructuredEnvelope returns the json envelope holding the event attributes and the
+// provided data. Json data is embedded as it is, while any other format is base64 encoded.
+func (eh *envelopeHandler) CreateStructuredEnvelope(info EventInfo, payload []byte) ([]byte, error) {
+	envelope := &Envelope{
+		SpecVersion:     SpecVersion,
+		ID:              eh.getID(info),
+		Source:          eh.getSource(info),
+		Type:            eh.getType(info),
+		Time:            getTime(),
+		DataContentType: eh.dataContentType,
+	}
+
+	if eh.dataContentType == common.JSONContentType {
+		envelope.Data = payload
+	} else {
+		envelope.DataBase64 = payload
+	}
+
+	return json.Marshal(envelope)
+}
+
+// CreateBinaryAttributes returns the event context attributes, to be set as transport
+// headers. The data content type attribute is carried by the transport content type.
+func (eh *envelopeHandler) CreateBinaryAttributes(info EventInfo) map[string]string {
+	return map[string]string{
+		SpecVersionAttribute: SpecVersion,
+		IDAttribute:          eh.getID(info),
+		SourceAttribute:      eh.getSource(info),
+		TypeAttribute:        eh.getType(info),
+		TimeAttribute:        getTime(),
+	}
+}
+
+// the same block hash is used for multiple event types, so the event type is part
+// of the id in order to keep the source and id pair unique
+func (eh *envelopeHandler) getID(info EventInfo) string {
+	return common.GetMessageID(info.Hash, info.EventType)
+}
+
+func (eh *envelopeHandler) getSource(info EventInfo) string {
+	if !info.HasShardID {
+		return eh.source
+	}
+
+	return fmt.Sprintf("%s/shard/%d", eh.source, info.ShardID)
+}
+
+func (eh *envelopeHandler) getType(info EventInfo) string {
+	return fmt.Sprintf("%s.%s", eh.typePrefix, info.EventType)
+}
+
+func getTime() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// IsEnabled returns true
+func (eh *envelopeHandler) IsEnabled() bool {
+	return true
+}
+
+// IsBinaryMode returns true if the event attributes should be set as transport headers
+func (eh *envelopeHandler) IsBinaryMode() bool {
+	return eh.mode == BinaryMode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eh *envelopeHandler) IsInterfaceNil() bool {
+	return eh == nil
+}
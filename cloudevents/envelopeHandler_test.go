@@ -0,0 +1,163 @@
+package cloudevents_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/mock"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEnvelopeHandler() cloudevents.ArgsEnvelopeHandler {
+	return cloudevents.ArgsEnvelopeHandler{
+		Config: config.CloudEventsConfig{
+			Enabled:    true,
+			Mode:       cloudevents.StructuredMode,
+			Source:     "multiversx/testnet",
+			TypePrefix: "com.multiversx.notifier",
+		},
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+}
+
+func TestNewEnvelopeHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEnvelopeHandler()
+		args.Marshaller = nil
+
+		eh, err := cloudevents.NewEnvelopeHandler(args)
+		require.True(t, check.IfNil(eh))
+		require.Equal(t, common.ErrNilMarshaller, err)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEnvelopeHandler()
+		args.Config.Mode = "invalid"
+
+		eh, err := cloudevents.NewEnvelopeHandler(args)
+		require.True(t, check.IfNil(eh))
+		require.True(t, errors.Is(err, cloudevents.ErrInvalidMode))
+	})
+
+	t.Run("empty source", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEnvelopeHandler()
+		args.Config.Source = ""
+
+		eh, err := cloudevents.NewEnvelopeHandler(args)
+		require.True(t, check.IfNil(eh))
+		require.Equal(t, cloudevents.ErrEmptySource, err)
+	})
+
+	t.Run("empty type prefix", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEnvelopeHandler()
+		args.Config.TypePrefix = ""
+
+		eh, err := cloudevents.NewEnvelopeHandler(args)
+		require.True(t, check.IfNil(eh))
+		require.Equal(t, cloudevents.ErrEmptyTypePrefix, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eh, err := cloudevents.NewEnvelopeHandler(createMockArgsEnvelopeHandler())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(eh))
+		require.True(t, eh.IsEnabled())
+		require.False(t, eh.IsBinaryMode())
+	})
+}
+
+func TestEnvelopeHandler_CreateStructuredEnvelope(t *testing.T) {
+	t.Parallel()
+
+	info := cloudevents.EventInfo{
+		EventType:  common.PushLogsAndEvents,
+		Hash:       "hash1",
+		ShardID:    1,
+		HasShardID: true,
+	}
+
+	t.Run("json data should be embedded", func(t *testing.T) {
+		t.Parallel()
+
+		eh, _ := cloudevents.NewEnvelopeHandler(createMockArgsEnvelopeHandler())
+
+		payload := []byte(`{"hash":"hash1"}`)
+		envelopeBytes, err := eh.CreateStructuredEnvelope(info, payload)
+		require.Nil(t, err)
+
+		envelope := &cloudevents.Envelope{}
+		err = json.Unmarshal(envelopeBytes, envelope)
+		require.Nil(t, err)
+
+		require.Equal(t, "1.0", envelope.SpecVersion)
+		require.Equal(t, "hash1_all_events", envelope.ID)
+		require.Equal(t, "multiversx/testnet/shard/1", envelope.Source)
+		require.Equal(t, "com.multiversx.notifier.all_events", envelope.Type)
+		require.Equal(t, "application/json", envelope.DataContentType)
+		require.Equal(t, json.RawMessage(payload), envelope.Data)
+		require.Nil(t, envelope.DataBase64)
+
+		_, err = time.Parse(time.RFC3339Nano, envelope.Time)
+		require.Nil(t, err)
+	})
+
+	t.Run("binary data should be base64 encoded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEnvelopeHandler()
+		args.Marshaller = &mock.MarshalizerMock{}
+		eh, _ := cloudevents.NewEnvelopeHandler(args)
+
+		payload := []byte{0, 1, 2}
+		envelopeBytes, err := eh.CreateStructuredEnvelope(info, payload)
+		require.Nil(t, err)
+
+		envelopeMap := make(map[string]interface{})
+		err = json.Unmarshal(envelopeBytes, &envelopeMap)
+		require.Nil(t, err)
+
+		require.Equal(t, "application/octet-stream", envelopeMap["datacontenttype"])
+		require.Equal(t, base64.StdEncoding.EncodeToString(payload), envelopeMap["data_base64"])
+		require.NotContains(t, envelopeMap, "data")
+	})
+}
+
+func TestEnvelopeHandler_CreateBinaryAttributes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEnvelopeHandler()
+	args.Config.Mode = cloudevents.BinaryMode
+	eh, _ := cloudevents.NewEnvelopeHandler(args)
+	require.True(t, eh.IsBinaryMode())
+
+	attributes := eh.CreateBinaryAttributes(cloudevents.EventInfo{
+		EventType: common.FinalizedBlockEvents,
+		Hash:      "hash1",
+	})
+
+	require.Equal(t, "1.0", attributes[cloudevents.SpecVersionAttribute])
+	require.Equal(t, "hash1_finalized_events", attributes[cloudevents.IDAttribute])
+	require.Equal(t, "multiversx/testnet", attributes[cloudevents.SourceAttribute])
+	require.Equal(t, "com.multiversx.notifier.finalized_events", attributes[cloudevents.TypeAttribute])
+	require.NotEmpty(t, attributes[cloudevents.TimeAttribute])
+}
@@ -0,0 +1,12 @@
+package cloudevents
+
+import "errors"
+
+// ErrInvalidMode signals that an invalid CloudEvents content mode has been provided
+var ErrInvalidMode = errors.New("invalid CloudEvents content mode")
+
+// ErrEmptySource signals that an empty CloudEvents source has been provided
+var ErrEmptySource = errors.New("empty CloudEvents source")
+
+// ErrEmptyTypePrefix signals that an empty CloudEvents type prefix has been provided
+var ErrEmptyTypePrefix = errors.New("empty CloudEvents type prefix")
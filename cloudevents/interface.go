@@ -0,0 +1,11 @@
+package cloudevents
+
+// EnvelopeHandler defines the behaviour of a component which wraps the outgoing
+// messages in CloudEvents envelopes
+type EnvelopeHandler interface {
+	CreateStructuredEnvelope(info EventInfo, payload []byte) ([]byte, error)
+	CreateBinaryAttributes(info EventInfo) map[string]string
+	IsEnabled() bool
+	IsBinaryMode() bool
+	IsInterfaceNil() bool
+}
@@ -14,6 +14,27 @@ const (
 
 	// RedisSentinelConnType specifies a redis connection to a setup with sentinel
 	RedisSentinelConnType string = "sentinel"
+
+	// RedisClusterConnType specifies a redis connection to a setup with cluster mode enabled
+	RedisClusterConnType string = "cluster"
+)
+
+const (
+	// RedisLockerType specifies a lock service backed by redis, shared by multiple notifier instances
+	RedisLockerType string = "redis"
+
+	// InMemoryLockerType specifies an in memory lock service, for a single notifier instance
+	InMemoryLockerType string = "in-memory"
+)
+
+const (
+	// FailOpenPolicy specifies that the events are published without checking duplicates
+	// when the lock service is unreachable, risking duplicated events
+	FailOpenPolicy string = "fail-open"
+
+	// FailClosedPolicy specifies that the events are rejected when the lock service is
+	// unreachable, so that the observer retries to push them
+	FailClosedPolicy string = "fail-closed"
 )
 
 const (
@@ -34,6 +55,48 @@ const (
 
 	// BlockScrs defines the subscription event type for block scrs
 	BlockScrs string = "block_scrs"
+
+	// BlockRewards defines the subscription event type for block rewards
+	BlockRewards string = "block_rewards"
+
+	// BlockReceipts defines the subscription event type for block receipts
+	BlockReceipts string = "block_receipts"
+
+	// BlockInvalidTxs defines the subscription event type for block invalid txs
+	BlockInvalidTxs string = "block_invalid_txs"
+
+	// AlteredAccounts defines the subscription event type for altered accounts
+	AlteredAccounts string = "altered_accounts"
+
+	// BlockHeaders defines the subscription event type for block headers
+	BlockHeaders string = "block_headers"
+
+	// RoundsInfo defines the subscription event type for rounds info
+	RoundsInfo string = "rounds_info"
+
+	// ValidatorsRating defines the subscription event type for validators rating
+	ValidatorsRating string = "validators_rating"
+
+	// ValidatorsPubKeys defines the subscription event type for validators public keys
+	ValidatorsPubKeys string = "validators_pubkeys"
+
+	// EpochStart defines the subscription event type for epoch start
+	EpochStart string = "epoch_start"
+
+	// TxCompleted defines the subscription event type for transaction completed
+	TxCompleted string = "tx_completed"
+
+	// FailedExecutions defines the subscription event type for failed executions
+	FailedExecutions string = "failed_executions"
+
+	// GapDetected defines the subscription event type for nonce gap detected
+	GapDetected string = "gap_detected"
+
+	// RetractedEvents defines the subscription event type for retracted events
+	RetractedEvents string = "retracted_events"
+
+	// ShardStalled defines the subscription event type for stalled shard
+	ShardStalled string = "shard_stalled"
 )
 
 const (
@@ -51,3 +114,16 @@ const (
 	// PayloadV1 defines first payload implementation with versioning
 	PayloadV1 uint32 = 1
 )
+
+const (
+	// PublishedPayloadVersion defines the version of the payload structures published to subscribers
+	PublishedPayloadVersion uint32 = 1
+)
+
+const (
+	// TxStatusSuccess signals that a watched transaction was executed successfully
+	TxStatusSuccess string = "success"
+
+	// TxStatusFail signals that a watched transaction, or one of its smart contract results, failed
+	TxStatusFail string = "fail"
+)
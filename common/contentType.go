@@ -0,0 +1,30 @@
+package common
+
+import "github.com/multiversx/mx-chain-core-go/marshal"
+
+const (
+	// JSONContentType defines the content type of json marshalled payloads
+	JSONContentType = "application/json"
+
+	// ProtobufContentType defines the content type of protobuf marshalled payloads
+	ProtobufContentType = "application/x-protobuf"
+
+	// BinaryContentType defines the content type of payloads marshalled in an unknown format
+	BinaryContentType = "application/octet-stream"
+
+	// UTF8ContentEncoding defines the utf-8 content encoding
+	UTF8ContentEncoding = "utf-8"
+)
+
+// GetContentTypeAndEncoding returns the content type and encoding of the payloads
+// marshalled with the provided marshaller
+func GetContentTypeAndEncoding(marshaller marshal.Marshalizer) (string, string) {
+	switch marshaller.(type) {
+	case *marshal.JsonMarshalizer:
+		return JSONContentType, UTF8ContentEncoding
+	case *marshal.GogoProtoMarshalizer:
+		return ProtobufContentType, ""
+	default:
+		return BinaryContentType, ""
+	}
+}
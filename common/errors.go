@@ -17,6 +17,12 @@ var ErrInvalidDispatchType = errors.New("invalid dispatch type")
 // ErrInvalidRedisConnType signals that an invalid redis connection type has been provided
 var ErrInvalidRedisConnType = errors.New("invalid redis connection type")
 
+// ErrInvalidLockerType signals that an invalid locker type has been provided
+var ErrInvalidLockerType = errors.New("invalid locker type")
+
+// ErrInvalidFailurePolicy signals that an invalid locker failure policy has been provided
+var ErrInvalidFailurePolicy = errors.New("invalid locker failure policy")
+
 // ErrReceivedEmptyEvents signals that empty events have been received
 var ErrReceivedEmptyEvents = errors.New("received empty events")
 
@@ -37,3 +43,9 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrLoopAlreadyStarted signals that a loop has already been started
 var ErrLoopAlreadyStarted = errors.New("loop already started")
+
+// ErrInvalidStreamName signals that an invalid output stream name has been provided
+var ErrInvalidStreamName = errors.New("invalid stream name")
+
+// ErrDuplicatedConnectorName signals that the same name was configured for several connectors
+var ErrDuplicatedConnectorName = errors.New("duplicated connector name")
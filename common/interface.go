@@ -9,7 +9,50 @@ import (
 // StatusMetricsHandler defines the behavior of a component that handles status metrics
 type StatusMetricsHandler interface {
 	AddRequest(path string, duration time.Duration)
+	SetGauge(path string, value uint64)
 	GetAll() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheus() string
 	IsInterfaceNil() bool
 }
+
+// TxCompletionWatcher defines the behaviour of a component which watches the registered
+// transactions and reports them once they are completed
+type TxCompletionWatcher interface {
+	WatchTxs(txHashes []string) error
+	ProcessBlock(blockData *data.InterceptorBlockData) []data.TxCompleted
+	ProcessFinalizedBlock(blockHash string) []data.TxCompleted
+	IsInterfaceNil() bool
+}
+
+// ShardsMonitor defines the behaviour of a component which monitors the liveness of the
+// observers, based on the blocks received for each shard
+type ShardsMonitor interface {
+	BlockReceived(blockData *data.ArgsSaveBlockData)
+	GetShardsStatus() []data.ShardStatus
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// SaveBlockEventsHandler defines the behaviour of a component which handles the saved blocks
+type SaveBlockEventsHandler interface {
+	HandleSaveBlockEvents(allEvents data.ArgsSaveBlockData) error
+	IsInterfaceNil() bool
+}
+
+// BlocksBackfiller defines the behaviour of a component which keeps track of the published
+// blocks and handles, on startup, the blocks missed while the notifier was down
+type BlocksBackfiller interface {
+	BlockPublished(shardID uint32, nonce uint64)
+	Backfill(eventsHandler SaveBlockEventsHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// DeliveryTracker defines the behaviour of a component that is notified when the
+// published messages are delivered, in order to commit the related locker keys
+type DeliveryTracker interface {
+	TrackDelivery(key string, messageIDs []string)
+	ConfirmDelivery(messageID string)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"math"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// ComputeShardID returns the shard of the provided address, matching the multi shard
+// coordinator from the node. If the number of shards is not known, false is returned.
+func ComputeShardID(address []byte, numberOfShards uint32) (uint32, bool) {
+	if numberOfShards == 0 || len(address) == 0 {
+		return 0, false
+	}
+
+	bytesNeeded := 4
+	switch {
+	case numberOfShards <= 1<<8:
+		bytesNeeded = 1
+	case numberOfShards <= 1<<16:
+		bytesNeeded = 2
+	case numberOfShards <= 1<<24:
+		bytesNeeded = 3
+	}
+
+	startingIndex := 0
+	if len(address) > bytesNeeded {
+		startingIndex = len(address) - bytesNeeded
+	}
+	buffNeeded := address[startingIndex:]
+
+	if core.IsSmartContractOnMetachain(buffNeeded, address) {
+		return core.MetachainShardId, true
+	}
+	if numberOfShards == 1 {
+		return 0, true
+	}
+
+	addr := uint32(0)
+	for _, b := range buffNeeded {
+		addr = addr<<8 + uint32(b)
+	}
+
+	n := uint32(math.Ceil(math.Log2(float64(numberOfShards))))
+	maskHigh := uint32(1)<<n - 1
+	maskLow := uint32(1)<<(n-1) - 1
+
+	shardID := addr & maskHigh
+	if shardID > numberOfShards-1 {
+		shardID = addr & maskLow
+	}
+
+	return shardID, true
+}
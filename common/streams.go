@@ -0,0 +1,80 @@
+package common
+
+import "fmt"
+
+// DefaultStreams defines the output streams enabled when no stream is explicitly configured
+var DefaultStreams = []string{
+	PushLogsAndEvents,
+	RevertBlockEvents,
+	FinalizedBlockEvents,
+	BlockTxs,
+	BlockScrs,
+	BlockEvents,
+}
+
+// AllStreams defines all the output streams that can be enabled, in the order they are set up
+var AllStreams = []string{
+	PushLogsAndEvents,
+	RevertBlockEvents,
+	FinalizedBlockEvents,
+	BlockTxs,
+	BlockScrs,
+	BlockEvents,
+	BlockRewards,
+	BlockReceipts,
+	BlockInvalidTxs,
+	AlteredAccounts,
+	BlockHeaders,
+	RoundsInfo,
+	ValidatorsRating,
+	ValidatorsPubKeys,
+	EpochStart,
+	TxCompleted,
+	FailedExecutions,
+	GapDetected,
+	RetractedEvents,
+	ShardStalled,
+}
+
+// EnabledStreams holds the output streams which are enabled
+type EnabledStreams map[string]struct{}
+
+// NewEnabledStreams creates the set of enabled output streams. If no stream is provided,
+// the default streams are enabled
+func NewEnabledStreams(streams []string) (EnabledStreams, error) {
+	if len(streams) == 0 {
+		streams = DefaultStreams
+	}
+
+	enabledStreams := make(EnabledStreams)
+	for _, stream := range streams {
+		if !IsKnownStream(stream) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidStreamName, stream)
+		}
+		enabledStreams[stream] = struct{}{}
+	}
+
+	return enabledStreams, nil
+}
+
+// IsKnownStream returns true if the provided stream is one of the output streams
+func IsKnownStream(stream string) bool {
+	for _, knownStream := range AllStreams {
+		if knownStream == stream {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEnabled returns true if the provided output stream is enabled
+func (es EnabledStreams) IsEnabled(stream string) bool {
+	_, ok := es[stream]
+	return ok
+}
+
+// GetMessageID returns the identifier of the message published on an output stream for a block
+func GetMessageID(hash string, stream string) string {
+	return fmt.Sprintf("%s_%s", hash, stream)
+}
@@ -11,11 +11,22 @@ type Configs struct {
 
 // MainConfig defines the config setup based on main config file
 type MainConfig struct {
-	General            GeneralConfig
-	WebSocketConnector WebSocketConfig
-	ConnectorApi       ConnectorApiConfig
-	Redis              RedisConfig
-	RabbitMQ           RabbitMQConfig
+	General             GeneralConfig
+	WebSocketConnector  WebSocketConfig
+	WebSocketConnectors []WebSocketConfig
+	ConnectorApi        ConnectorApiConfig
+	Redis               RedisConfig
+	RabbitMQ            RabbitMQConfig
+	InMemoryLocker      InMemoryLockerConfig
+	LockerRetryPolicy   LockerRetryPolicyConfig
+	LeaderElection      LeaderElectionConfig
+	CloudEvents         CloudEventsConfig
+	EventsDecoder       EventsDecoderConfig
+	TxCompletionWatcher TxCompletionWatcherConfig
+	BlocksOrdering      BlocksOrderingConfig
+	RetractedEvents     RetractedEventsConfig
+	ShardsMonitor       ShardsMonitorConfig
+	Backfill            BackfillConfig
 }
 
 // GeneralConfig maps the general config section
@@ -23,6 +34,9 @@ type GeneralConfig struct {
 	ExternalMarshaller MarshallerConfig
 	AddressConverter   AddressConverterConfig
 	CheckDuplicates    bool
+	LockerType         string
+	EnabledStreams     []string
+	EnrichEvents       bool
 }
 
 // MarshallerConfig maps the marshaller configuration
@@ -64,22 +78,123 @@ type RouteConfig struct {
 
 // RedisConfig maps the redis configuration
 type RedisConfig struct {
-	Url            string
-	MasterName     string
-	SentinelUrl    string
-	ConnectionType string
-	TTL            uint32
+	Url                 string
+	MasterName          string
+	SentinelUrl         string
+	SentinelUrls        []string
+	SentinelPassword    string
+	ClusterAddresses    []string
+	Username            string
+	Password            string
+	ConnectionType      string
+	TTL                 uint32
+	ReservationTTLInSec uint32
+	TLS                 RedisTLSConfig
+}
+
+// RedisTLSConfig maps the redis TLS configuration
+type RedisTLSConfig struct {
+	Enabled            bool
+	InsecureSkipVerify bool
+	ServerName         string
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+}
+
+// LockerRetryPolicyConfig maps the policy applied when the lock service is unreachable
+type LockerRetryPolicyConfig struct {
+	MaxRetryDurationInMs           uint32
+	FailurePolicy                  string
+	CircuitBreakerThreshold        uint32
+	CircuitBreakerOpenDurationInMs uint32
+}
+
+// BlocksOrderingConfig maps the per shard blocks ordering configuration
+type BlocksOrderingConfig struct {
+	Enabled           bool
+	MaxBufferedBlocks uint32
+	GapTimeoutInMs    uint32
+}
+
+// RetractedEventsConfig maps the retracted events configuration
+type RetractedEventsConfig struct {
+	MaxCachedBlocks uint32
+}
+
+// ShardsMonitorConfig maps the shards liveness monitoring configuration
+type ShardsMonitorConfig struct {
+	Enabled            bool
+	StallTimeoutInSec  uint32
+	CheckIntervalInSec uint32
+}
+
+// BackfillConfig maps the configuration for backfilling the missed blocks on startup
+type BackfillConfig struct {
+	Enabled             bool
+	URL                 string
+	StateFilePath       string
+	MaxBlocksPerShard   uint32
+	RequestTimeoutInSec uint32
+}
+
+// LeaderElectionConfig maps the leader election configuration
+type LeaderElectionConfig struct {
+	Enabled                   bool
+	InstanceID                string
+	LeaseKey                  string
+	LeaseTTLInMs              uint32
+	RenewIntervalInMs         uint32
+	MaxFollowerBufferedEvents uint32
+}
+
+// TxCompletionWatcherConfig maps the transactions completion watcher configuration
+type TxCompletionWatcherConfig struct {
+	MaxWatchedTxs    uint32
+	WatchExpiryInSec uint32
+}
+
+// InMemoryLockerConfig maps the in memory locker configuration
+type InMemoryLockerConfig struct {
+	Capacity            uint32
+	TTLInMinutes        uint32
+	ReservationTTLInSec uint32
+	SnapshotFilePath    string
 }
 
 // RabbitMQConfig maps the rabbitMQ configuration
 type RabbitMQConfig struct {
-	Url                     string
-	EventsExchange          RabbitMQExchangeConfig
-	RevertEventsExchange    RabbitMQExchangeConfig
-	FinalizedEventsExchange RabbitMQExchangeConfig
-	BlockTxsExchange        RabbitMQExchangeConfig
-	BlockScrsExchange       RabbitMQExchangeConfig
-	BlockEventsExchange     RabbitMQExchangeConfig
+	Url                       string
+	MaxInFlightMessages       uint32
+	PublishTimeoutInSec       uint32
+	Outbox                    RabbitMQOutboxConfig
+	EventsExchange            RabbitMQExchangeConfig
+	RevertEventsExchange      RabbitMQExchangeConfig
+	FinalizedEventsExchange   RabbitMQExchangeConfig
+	BlockTxsExchange          RabbitMQExchangeConfig
+	BlockScrsExchange         RabbitMQExchangeConfig
+	BlockEventsExchange       RabbitMQExchangeConfig
+	BlockRewardsExchange      RabbitMQExchangeConfig
+	BlockReceiptsExchange     RabbitMQExchangeConfig
+	BlockInvalidTxsExchange   RabbitMQExchangeConfig
+	AlteredAccountsExchange   RabbitMQExchangeConfig
+	BlockHeadersExchange      RabbitMQExchangeConfig
+	RoundsInfoExchange        RabbitMQExchangeConfig
+	ValidatorsRatingExchange  RabbitMQExchangeConfig
+	ValidatorsPubKeysExchange RabbitMQExchangeConfig
+	EpochStartExchange        RabbitMQExchangeConfig
+	TxCompletedExchange       RabbitMQExchangeConfig
+	FailedExecutionsExchange  RabbitMQExchangeConfig
+	GapDetectedExchange       RabbitMQExchangeConfig
+	RetractedEventsExchange   RabbitMQExchangeConfig
+	ShardStalledExchange      RabbitMQExchangeConfig
+}
+
+// RabbitMQOutboxConfig holds the configuration for the local outbox used when the broker is unavailable
+type RabbitMQOutboxConfig struct {
+	Enabled     bool
+	Path        string
+	MaxSizeInMB uint32
 }
 
 // RabbitMQExchangeConfig holds the configuration for a rabbitMQ exchange
@@ -88,8 +203,29 @@ type RabbitMQExchangeConfig struct {
 	Type string
 }
 
+// CloudEventsConfig holds the configuration for the CloudEvents envelope of the outgoing messages
+type CloudEventsConfig struct {
+	Enabled    bool
+	Mode       string
+	Source     string
+	TypePrefix string
+}
+
+// EventsDecoderConfig holds the configuration for decoding the raw topics of the events
+type EventsDecoderConfig struct {
+	DecodeESDTEvents bool
+	ContractABIs     []ContractABIConfig
+}
+
+// ContractABIConfig maps a smart contract address to its ABI file
+type ContractABIConfig struct {
+	Address     string
+	ABIFilePath string
+}
+
 // WebSocketConfig holds the configuration for websocket observer interaction config
 type WebSocketConfig struct {
+	Name                       string
 	Enabled                    bool
 	URL                        string
 	Mode                       string
@@ -22,14 +22,24 @@ type SaveBlockData struct {
 
 // InterceptorBlockData holds the block data needed for processing
 type InterceptorBlockData struct {
-	Hash          string
-	Body          nodeData.BodyHandler
-	Header        nodeData.HeaderHandler
-	Txs           map[string]*transaction.Transaction
-	TxsWithOrder  map[string]*outport.TxInfo
-	Scrs          map[string]*smartContractResult.SmartContractResult
-	ScrsWithOrder map[string]*outport.SCRInfo
-	LogEvents     []Event
+	Hash                   string
+	Body                   nodeData.BodyHandler
+	Header                 nodeData.HeaderHandler
+	Txs                    map[string]*transaction.Transaction
+	TxsWithOrder           map[string]*outport.TxInfo
+	Scrs                   map[string]*smartContractResult.SmartContractResult
+	ScrsWithOrder          map[string]*outport.SCRInfo
+	Rewards                map[string]*rewardTx.RewardTx
+	Receipts               map[string]*receipt.Receipt
+	InvalidTxs             map[string]*outport.TxInfo
+	AlteredAccounts        map[string]*alteredAccount.AlteredAccount
+	SignersIndexes         []uint64
+	NotarizedHeadersHashes []string
+	HeaderGasConsumption   *outport.HeaderGasConsumption
+	NumberOfShards         uint32
+	LogEvents              []Event
+	EnrichedLogEvents      []Event
+	FailedExecutions       []FailedExecution
 }
 
 // ArgsSaveBlockData holds the block data that will be received on push events
@@ -3,6 +3,7 @@ package data
 import (
 	"encoding/json"
 
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
@@ -18,17 +19,51 @@ type WebSocketEvent struct {
 
 // Event holds event data
 type Event struct {
-	Address    string   `json:"address"`
-	Identifier string   `json:"identifier"`
-	Topics     [][]byte `json:"topics"`
-	Data       []byte   `json:"data"`
-	TxHash     string   `json:"txHash"`
+	Address    string        `json:"address"`
+	Identifier string        `json:"identifier"`
+	Topics     [][]byte      `json:"topics"`
+	Data       []byte        `json:"data"`
+	TxHash     string        `json:"txHash"`
+	Decoded    *DecodedEvent `json:"decoded,omitempty"`
+	Context    *EventContext `json:"context,omitempty"`
+}
+
+// EventContext holds the transaction, or the smart contract result, which generated the event.
+// The log index is the position of the event within the log of its transaction, or smart contract result.
+type EventContext struct {
+	Sender         string `json:"sender"`
+	Receiver       string `json:"receiver"`
+	Value          string `json:"value"`
+	Function       string `json:"function"`
+	OriginalTxHash string `json:"originalTxHash"`
+	BlockNonce     uint64 `json:"blockNonce"`
+	LogIndex       int    `json:"logIndex"`
+	ExecutionOrder uint32 `json:"executionOrder"`
+}
+
+// DecodedEvent holds the structured fields decoded from the raw topics of a recognised event.
+// The ESDT events are decoded into transfers, while the smart contract events are decoded into
+// named fields, based on the registered ABIs.
+type DecodedEvent struct {
+	Sender    string                 `json:"sender,omitempty"`
+	Receiver  string                 `json:"receiver,omitempty"`
+	Transfers []DecodedTransfer      `json:"transfers,omitempty"`
+	Name      string                 `json:"name,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// DecodedTransfer holds the token fields decoded from an ESDT event
+type DecodedTransfer struct {
+	Token  string `json:"token"`
+	Nonce  uint64 `json:"nonce"`
+	Amount string `json:"amount"`
 }
 
 // BlockEvents holds events data for a block
 type BlockEvents struct {
 	Hash      string  `json:"hash"`
 	ShardID   uint32  `json:"shardId"`
+	Nonce     uint64  `json:"nonce"`
 	TimeStamp uint64  `json:"timestamp"`
 	Events    []Event `json:"events"`
 }
@@ -48,22 +83,199 @@ type FinalizedBlock struct {
 	Hash string `json:"hash"`
 }
 
+// RetractedEvents holds the events, transactions and smart contract results previously
+// published for a reverted block
+type RetractedEvents struct {
+	Hash      string                                              `json:"hash"`
+	ShardID   uint32                                              `json:"shardId"`
+	Nonce     uint64                                              `json:"nonce"`
+	Round     uint64                                              `json:"round"`
+	Epoch     uint32                                              `json:"epoch"`
+	TimeStamp uint64                                              `json:"timestamp"`
+	Events    []Event                                             `json:"events"`
+	Txs       map[string]*transaction.Transaction                 `json:"txs,omitempty"`
+	Scrs      map[string]*smartContractResult.SmartContractResult `json:"scrs,omitempty"`
+}
+
 // BlockTxs holds the block transactions
 type BlockTxs struct {
-	Hash string                              `json:"hash"`
-	Txs  map[string]*transaction.Transaction `json:"txs"`
+	Hash    string                              `json:"hash"`
+	ShardID uint32                              `json:"shardID"`
+	Nonce   uint64                              `json:"nonce"`
+	Txs     map[string]*transaction.Transaction `json:"txs"`
 }
 
 // BlockScrs holds the block smart contract results
 type BlockScrs struct {
-	Hash string                                              `json:"hash"`
-	Scrs map[string]*smartContractResult.SmartContractResult `json:"scrs"`
+	Hash    string                                              `json:"hash"`
+	ShardID uint32                                              `json:"shardID"`
+	Nonce   uint64                                              `json:"nonce"`
+	Scrs    map[string]*smartContractResult.SmartContractResult `json:"scrs"`
+}
+
+// BlockRewards holds the block reward transactions
+type BlockRewards struct {
+	Hash    string                        `json:"hash"`
+	ShardID uint32                        `json:"shardID"`
+	Nonce   uint64                        `json:"nonce"`
+	Rewards map[string]*rewardTx.RewardTx `json:"rewards"`
+}
+
+// BlockReceipts holds the block receipts
+type BlockReceipts struct {
+	Hash     string                      `json:"hash"`
+	ShardID  uint32                      `json:"shardID"`
+	Nonce    uint64                      `json:"nonce"`
+	Receipts map[string]*receipt.Receipt `json:"receipts"`
+}
+
+// BlockInvalidTxs holds the block invalid transactions, together with their fees
+type BlockInvalidTxs struct {
+	Hash       string                     `json:"hash"`
+	ShardID    uint32                     `json:"shardID"`
+	Nonce      uint64                     `json:"nonce"`
+	InvalidTxs map[string]*outport.TxInfo `json:"invalidTxs"`
+}
+
+// BlockAlteredAccounts holds the accounts altered in a block, with their updated balances,
+// nonces and token balances
+type BlockAlteredAccounts struct {
+	Hash            string                                    `json:"hash"`
+	ShardID         uint32                                    `json:"shardID"`
+	Nonce           uint64                                    `json:"nonce"`
+	AlteredAccounts map[string]*alteredAccount.AlteredAccount `json:"alteredAccounts"`
+}
+
+// BlockHeader holds the block header metadata
+type BlockHeader struct {
+	Hash                   string                        `json:"hash"`
+	ShardID                uint32                        `json:"shardID"`
+	Nonce                  uint64                        `json:"nonce"`
+	Round                  uint64                        `json:"round"`
+	Epoch                  uint32                        `json:"epoch"`
+	PrevHash               string                        `json:"prevHash"`
+	TimeStamp              uint64                        `json:"timestamp"`
+	TxCount                uint32                        `json:"txCount"`
+	SignersIndexes         []uint64                      `json:"signersIndexes"`
+	GasConsumption         *outport.HeaderGasConsumption `json:"gasConsumption,omitempty"`
+	NotarizedHeadersHashes []string                      `json:"notarizedHeadersHashes,omitempty"`
+}
+
+// RoundsInfo holds the info about the rounds of a shard, such as the signers and
+// whether a block was proposed
+type RoundsInfo struct {
+	ID         string               `json:"id"`
+	ShardID    uint32               `json:"shardID"`
+	RoundsInfo []*outport.RoundInfo `json:"roundsInfo"`
+}
+
+// ValidatorsRating holds the validators rating of a shard for an epoch
+type ValidatorsRating struct {
+	ID                   string                         `json:"id"`
+	ShardID              uint32                         `json:"shardID"`
+	Epoch                uint32                         `json:"epoch"`
+	ValidatorsRatingInfo []*outport.ValidatorRatingInfo `json:"validatorsRatingInfo"`
+}
+
+// ValidatorsPubKeys holds the hex encoded validators public keys, grouped by shard, for an epoch
+type ValidatorsPubKeys struct {
+	ID                string              `json:"id"`
+	ShardID           uint32              `json:"shardID"`
+	Epoch             uint32              `json:"epoch"`
+	ValidatorsPubKeys map[uint32][]string `json:"validatorsPubKeys"`
+}
+
+// EpochStart holds the data of an epoch start block
+type EpochStart struct {
+	Hash      string               `json:"hash"`
+	ShardID   uint32               `json:"shardID"`
+	Epoch     uint32               `json:"epoch"`
+	Round     uint64               `json:"round"`
+	Nonce     uint64               `json:"nonce"`
+	TimeStamp uint64               `json:"timestamp"`
+	Economics *EpochStartEconomics `json:"economics,omitempty"`
+}
+
+// EpochStartEconomics holds the epoch start economics data, set only for metachain blocks
+type EpochStartEconomics struct {
+	TotalSupply                      string `json:"totalSupply"`
+	TotalToDistribute                string `json:"totalToDistribute"`
+	TotalNewlyMinted                 string `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string `json:"rewardsForProtocolSustainability"`
+	NodePrice                        string `json:"nodePrice"`
+	PrevEpochStartRound              uint64 `json:"prevEpochStartRound"`
+	PrevEpochStartHash               string `json:"prevEpochStartHash"`
+}
+
+// TxCompleted holds the completion status of a watched transaction, emitted after the
+// transaction and all its smart contract results were executed in finalized blocks
+type TxCompleted struct {
+	TxHash      string   `json:"txHash"`
+	Status      string   `json:"status"`
+	Reason      string   `json:"reason,omitempty"`
+	BlockHashes []string `json:"blockHashes"`
+}
+
+// FailedExecution holds a failed transaction or smart contract result, built from the
+// signalError and internalVMErrors log events
+type FailedExecution struct {
+	TxHash         string `json:"txHash"`
+	OriginalTxHash string `json:"originalTxHash"`
+	Sender         string `json:"sender"`
+	Receiver       string `json:"receiver"`
+	Function       string `json:"function"`
+	Identifier     string `json:"identifier"`
+	Error          string `json:"error"`
+}
+
+// BlockFailedExecutions holds the failed executions of a block
+type BlockFailedExecutions struct {
+	Hash             string            `json:"hash"`
+	ShardID          uint32            `json:"shardID"`
+	Nonce            uint64            `json:"nonce"`
+	TimeStamp        uint64            `json:"timestamp"`
+	FailedExecutions []FailedExecution `json:"failedExecutions"`
+}
+
+// GapDetected holds a nonce gap detected in the blocks received for a shard. The blocks
+// between the last nonce and the next nonce were not received before the gap timeout.
+type GapDetected struct {
+	ID            string `json:"id"`
+	ShardID       uint32 `json:"shardID"`
+	LastNonce     uint64 `json:"lastNonce"`
+	NextNonce     uint64 `json:"nextNonce"`
+	MissingNonces uint64 `json:"missingNonces"`
+}
+
+// ShardStalled holds the details of a shard from which no block was received within the stall timeout
+type ShardStalled struct {
+	ID                    string `json:"id"`
+	ShardID               uint32 `json:"shardId"`
+	LastNonce             uint64 `json:"lastNonce"`
+	LastBlockHash         string `json:"lastBlockHash"`
+	LastReceivedTimestamp int64  `json:"lastReceivedTimestamp"`
+	StalledForInSec       uint64 `json:"stalledForInSec"`
+}
+
+// ShardStatus holds the liveness status of a shard, based on the blocks received from its observer.
+// The lag is the difference between the receive time and the header timestamp.
+type ShardStatus struct {
+	ShardID               uint32 `json:"shardId"`
+	LastNonce             uint64 `json:"lastNonce"`
+	LastBlockHash         string `json:"lastBlockHash"`
+	LastHeaderTimestamp   uint64 `json:"lastHeaderTimestamp"`
+	LastReceivedTimestamp int64  `json:"lastReceivedTimestamp"`
+	SinceLastBlockInSec   uint64 `json:"sinceLastBlockInSec"`
+	LagInMs               uint64 `json:"lagInMs"`
+	Stalled               bool   `json:"stalled"`
 }
 
 // BlockEventsWithOrder holds the block transactions with order
 type BlockEventsWithOrder struct {
 	Hash      string                      `json:"hash"`
 	ShardID   uint32                      `json:"shardID"`
+	Nonce     uint64                      `json:"nonce"`
 	TimeStamp uint64                      `json:"timestamp"`
 	Txs       map[string]*outport.TxInfo  `json:"txs"`
 	Scrs      map[string]*outport.SCRInfo `json:"scrs"`
@@ -14,6 +14,7 @@ type SubscriptionEntry struct {
 	Address    string   `json:"address"`
 	Identifier string   `json:"identifier"`
 	Topics     []string `json:"topics"`
+	TxHashes   []string `json:"txHashes"`
 }
 
 // Subscription holds subscription data
@@ -21,6 +22,7 @@ type Subscription struct {
 	Address      string
 	Identifier   string
 	Topics       []string
+	TxHashes     []string
 	MatchLevel   string
 	EventType    string
 	DispatcherID uuid.UUID
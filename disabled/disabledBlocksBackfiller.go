@@ -0,0 +1,26 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/common"
+
+// BlocksBackfiller defines a disabled blocks backfiller component, used when the
+// backfill of the missed blocks is not enabled
+type BlocksBackfiller struct{}
+
+// BlockPublished does nothing
+func (bb *BlocksBackfiller) BlockPublished(_ uint32, _ uint64) {
+}
+
+// Backfill returns nil
+func (bb *BlocksBackfiller) Backfill(_ common.SaveBlockEventsHandler) error {
+	return nil
+}
+
+// Close returns nil
+func (bb *BlocksBackfiller) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bb *BlocksBackfiller) IsInterfaceNil() bool {
+	return bb == nil
+}
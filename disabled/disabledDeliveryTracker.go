@@ -0,0 +1,22 @@
+package disabled
+
+// DeliveryTracker defines a disabled delivery tracker component, used when duplicates are not checked
+type DeliveryTracker struct{}
+
+// TrackDelivery does nothing
+func (ddt *DeliveryTracker) TrackDelivery(_ string, _ []string) {
+}
+
+// ConfirmDelivery does nothing
+func (ddt *DeliveryTracker) ConfirmDelivery(_ string) {
+}
+
+// Close returns nil
+func (ddt *DeliveryTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ddt *DeliveryTracker) IsInterfaceNil() bool {
+	return ddt == nil
+}
@@ -0,0 +1,31 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/cloudevents"
+
+// EnvelopeHandler defines a disabled CloudEvents envelope handler, the messages being sent unwrapped
+type EnvelopeHandler struct{}
+
+// CreateStructuredEnvelope returns the provided payload
+func (deh *EnvelopeHandler) CreateStructuredEnvelope(_ cloudevents.EventInfo, payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+// CreateBinaryAttributes returns an empty map
+func (deh *EnvelopeHandler) CreateBinaryAttributes(_ cloudevents.EventInfo) map[string]string {
+	return make(map[string]string)
+}
+
+// IsEnabled returns false
+func (deh *EnvelopeHandler) IsEnabled() bool {
+	return false
+}
+
+// IsBinaryMode returns false
+func (deh *EnvelopeHandler) IsBinaryMode() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (deh *EnvelopeHandler) IsInterfaceNil() bool {
+	return deh == nil
+}
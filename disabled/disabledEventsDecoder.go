@@ -0,0 +1,16 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// EventsDecoder defines a disabled events decoder, the events being sent with raw topics only
+type EventsDecoder struct{}
+
+// DecodeEvent returns nil
+func (ded *EventsDecoder) DecodeEvent(_ data.Event) *data.DecodedEvent {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ded *EventsDecoder) IsInterfaceNil() bool {
+	return ded == nil
+}
@@ -33,6 +33,62 @@ func (h *Hub) PublishScrs(blockScrs data.BlockScrs) {
 func (h *Hub) PublishBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder) {
 }
 
+// PublishRewards does nothing
+func (h *Hub) PublishRewards(blockRewards data.BlockRewards) {
+}
+
+// PublishReceipts does nothing
+func (h *Hub) PublishReceipts(blockReceipts data.BlockReceipts) {
+}
+
+// PublishInvalidTxs does nothing
+func (h *Hub) PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+}
+
+// PublishAlteredAccounts does nothing
+func (h *Hub) PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+}
+
+// PublishBlockHeader does nothing
+func (h *Hub) PublishBlockHeader(blockHeader data.BlockHeader) {
+}
+
+// PublishRoundsInfo does nothing
+func (h *Hub) PublishRoundsInfo(roundsInfo data.RoundsInfo) {
+}
+
+// PublishValidatorsRating does nothing
+func (h *Hub) PublishValidatorsRating(validatorsRating data.ValidatorsRating) {
+}
+
+// PublishValidatorsPubKeys does nothing
+func (h *Hub) PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) {
+}
+
+// PublishEpochStart does nothing
+func (h *Hub) PublishEpochStart(epochStart data.EpochStart) {
+}
+
+// PublishTxCompleted does nothing
+func (h *Hub) PublishTxCompleted(txCompleted data.TxCompleted) {
+}
+
+// PublishFailedExecutions does nothing
+func (h *Hub) PublishFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+}
+
+// PublishGapDetected does nothing
+func (h *Hub) PublishGapDetected(gapDetected data.GapDetected) {
+}
+
+// PublishRetractedEvents does nothing
+func (h *Hub) PublishRetractedEvents(retractedEvents data.RetractedEvents) {
+}
+
+// PublishShardStalled does nothing
+func (h *Hub) PublishShardStalled(shardStalled data.ShardStalled) {
+}
+
 // RegisterEvent does nothing
 func (h *Hub) RegisterEvent(_ dispatcher.EventDispatcher) {
 }
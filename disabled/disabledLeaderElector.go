@@ -0,0 +1,25 @@
+package disabled
+
+// LeaderElector defines a disabled leader elector component, used when every
+// notifier instance publishes the received events
+type LeaderElector struct{}
+
+// IsLeader returns true
+func (dle *LeaderElector) IsLeader() bool {
+	return true
+}
+
+// FencingToken returns 0, since there are no leadership terms
+func (dle *LeaderElector) FencingToken() uint64 {
+	return 0
+}
+
+// Close returns nil
+func (dle *LeaderElector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dle *LeaderElector) IsInterfaceNil() bool {
+	return dle == nil
+}
@@ -0,0 +1,42 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+)
+
+var errOutboxDisabled = errors.New("outbox is disabled")
+
+// Outbox defines a disabled outbox component, which does not persist any message
+type Outbox struct{}
+
+// Append returns an error, since the message can not be persisted
+func (do *Outbox) Append(_ rabbitmq.OutboxMessage) error {
+	return errOutboxDisabled
+}
+
+// Peek returns rabbitmq.ErrEmptyOutbox
+func (do *Outbox) Peek() (*rabbitmq.OutboxMessage, error) {
+	return nil, rabbitmq.ErrEmptyOutbox
+}
+
+// Remove returns rabbitmq.ErrEmptyOutbox
+func (do *Outbox) Remove() error {
+	return rabbitmq.ErrEmptyOutbox
+}
+
+// Len returns 0
+func (do *Outbox) Len() int {
+	return 0
+}
+
+// Close returns nil
+func (do *Outbox) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (do *Outbox) IsInterfaceNil() bool {
+	return do == nil
+}
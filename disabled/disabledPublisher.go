@@ -35,6 +35,62 @@ func (dp *Publisher) BroadcastScrs(_ data.BlockScrs) {
 func (dp *Publisher) BroadcastBlockEventsWithOrder(_ data.BlockEventsWithOrder) {
 }
 
+// BroadcastRewards does nothing
+func (dp *Publisher) BroadcastRewards(_ data.BlockRewards) {
+}
+
+// BroadcastReceipts does nothing
+func (dp *Publisher) BroadcastReceipts(_ data.BlockReceipts) {
+}
+
+// BroadcastInvalidTxs does nothing
+func (dp *Publisher) BroadcastInvalidTxs(_ data.BlockInvalidTxs) {
+}
+
+// BroadcastAlteredAccounts does nothing
+func (dp *Publisher) BroadcastAlteredAccounts(_ data.BlockAlteredAccounts) {
+}
+
+// BroadcastBlockHeader does nothing
+func (dp *Publisher) BroadcastBlockHeader(_ data.BlockHeader) {
+}
+
+// BroadcastRoundsInfo does nothing
+func (dp *Publisher) BroadcastRoundsInfo(_ data.RoundsInfo) {
+}
+
+// BroadcastValidatorsRating does nothing
+func (dp *Publisher) BroadcastValidatorsRating(_ data.ValidatorsRating) {
+}
+
+// BroadcastValidatorsPubKeys does nothing
+func (dp *Publisher) BroadcastValidatorsPubKeys(_ data.ValidatorsPubKeys) {
+}
+
+// BroadcastEpochStart does nothing
+func (dp *Publisher) BroadcastEpochStart(_ data.EpochStart) {
+}
+
+// BroadcastTxCompleted does nothing
+func (dp *Publisher) BroadcastTxCompleted(_ data.TxCompleted) {
+}
+
+// BroadcastFailedExecutions does nothing
+func (dp *Publisher) BroadcastFailedExecutions(_ data.BlockFailedExecutions) {
+}
+
+// BroadcastGapDetected does nothing
+func (dp *Publisher) BroadcastGapDetected(_ data.GapDetected) {
+}
+
+// BroadcastRetractedEvents does nothing
+func (dp *Publisher) BroadcastRetractedEvents(_ data.RetractedEvents) {
+}
+
+// BroadcastShardStalled does nothing
+func (dp *Publisher) BroadcastShardStalled(_ data.ShardStalled) {
+}
+
 // Close returns nil
 func (dp *Publisher) Close() error {
 	return nil
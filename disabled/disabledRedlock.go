@@ -10,16 +10,26 @@ func NewDisabledRedlockWrapper() *disabledRedlockWrapper {
 	return &disabledRedlockWrapper{}
 }
 
-// IsEventProcessed returns true and nil
-func (drw *disabledRedlockWrapper) IsEventProcessed(_ context.Context, _ string) (bool, error) {
+// ReserveEvent returns true and nil
+func (drw *disabledRedlockWrapper) ReserveEvent(_ context.Context, _ string) (bool, error) {
 	return true, nil
 }
 
+// CommitEvent returns nil
+func (drw *disabledRedlockWrapper) CommitEvent(_ context.Context, _ string) error {
+	return nil
+}
+
 // HasConnection returns true
 func (drw *disabledRedlockWrapper) HasConnection(_ context.Context) bool {
 	return true
 }
 
+// Close returns nil
+func (drw *disabledRedlockWrapper) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (drw *disabledRedlockWrapper) IsInterfaceNil() bool {
 	return drw == nil
@@ -0,0 +1,26 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// ShardsMonitor defines a disabled shards monitor component, used when the shards
+// liveness monitoring is not enabled
+type ShardsMonitor struct{}
+
+// BlockReceived does nothing
+func (sm *ShardsMonitor) BlockReceived(_ *data.ArgsSaveBlockData) {
+}
+
+// GetShardsStatus returns an empty list
+func (sm *ShardsMonitor) GetShardsStatus() []data.ShardStatus {
+	return make([]data.ShardStatus, 0)
+}
+
+// Close returns nil
+func (sm *ShardsMonitor) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *ShardsMonitor) IsInterfaceNil() bool {
+	return sm == nil
+}
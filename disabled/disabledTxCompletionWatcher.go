@@ -0,0 +1,27 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// TxCompletionWatcher defines a disabled tx completion watcher component, used when
+// the tx completed stream is not enabled
+type TxCompletionWatcher struct{}
+
+// WatchTxs returns nil
+func (tw *TxCompletionWatcher) WatchTxs(_ []string) error {
+	return nil
+}
+
+// ProcessBlock returns nil
+func (tw *TxCompletionWatcher) ProcessBlock(_ *data.InterceptorBlockData) []data.TxCompleted {
+	return nil
+}
+
+// ProcessFinalizedBlock returns nil
+func (tw *TxCompletionWatcher) ProcessFinalizedBlock(_ string) []data.TxCompleted {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tw *TxCompletionWatcher) IsInterfaceNil() bool {
+	return tw == nil
+}
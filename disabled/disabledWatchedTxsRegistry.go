@@ -0,0 +1,27 @@
+package disabled
+
+import "time"
+
+// WatchedTxsRegistry defines a disabled watched transactions registry, used when the watched
+// transactions are not shared between multiple notifier instances
+type WatchedTxsRegistry struct{}
+
+// AddTxs returns nil
+func (wr *WatchedTxsRegistry) AddTxs(_ []string, _ time.Time) error {
+	return nil
+}
+
+// GetTxs returns nil
+func (wr *WatchedTxsRegistry) GetTxs() (map[string]time.Time, error) {
+	return nil, nil
+}
+
+// RemoveTxs returns nil
+func (wr *WatchedTxsRegistry) RemoveTxs(_ []string) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wr *WatchedTxsRegistry) IsInterfaceNil() bool {
+	return wr == nil
+}
@@ -1,10 +1,12 @@
 package hub
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
@@ -16,15 +18,19 @@ var log = logger.GetOrCreate("hub")
 
 // ArgsCommonHub defines the arguments needed for common hub creation
 type ArgsCommonHub struct {
-	Filter             filters.EventFilter
-	SubscriptionMapper dispatcher.SubscriptionMapperHandler
+	Filter              filters.EventFilter
+	SubscriptionMapper  dispatcher.SubscriptionMapperHandler
+	DeliveryTracker     common.DeliveryTracker
+	TxCompletionWatcher common.TxCompletionWatcher
 }
 
 type commonHub struct {
-	filter             filters.EventFilter
-	subscriptionMapper dispatcher.SubscriptionMapperHandler
-	mutDispatchers     sync.RWMutex
-	dispatchers        map[uuid.UUID]dispatcher.EventDispatcher
+	filter              filters.EventFilter
+	subscriptionMapper  dispatcher.SubscriptionMapperHandler
+	deliveryTracker     common.DeliveryTracker
+	txCompletionWatcher common.TxCompletionWatcher
+	mutDispatchers      sync.RWMutex
+	dispatchers         map[uuid.UUID]dispatcher.EventDispatcher
 }
 
 // NewCommonHub creates a new commonHub instance
@@ -35,10 +41,12 @@ func NewCommonHub(args ArgsCommonHub) (*commonHub, error) {
 	}
 
 	return &commonHub{
-		mutDispatchers:     sync.RWMutex{},
-		filter:             args.Filter,
-		subscriptionMapper: args.SubscriptionMapper,
-		dispatchers:        make(map[uuid.UUID]dispatcher.EventDispatcher),
+		mutDispatchers:      sync.RWMutex{},
+		filter:              args.Filter,
+		subscriptionMapper:  args.SubscriptionMapper,
+		deliveryTracker:     args.DeliveryTracker,
+		txCompletionWatcher: args.TxCompletionWatcher,
+		dispatchers:         make(map[uuid.UUID]dispatcher.EventDispatcher),
 	}, nil
 }
 
@@ -49,15 +57,38 @@ func checkArgs(args ArgsCommonHub) error {
 	if check.IfNil(args.SubscriptionMapper) {
 		return ErrNilSubscriptionMapper
 	}
+	if check.IfNil(args.DeliveryTracker) {
+		return ErrNilDeliveryTracker
+	}
+	if check.IfNil(args.TxCompletionWatcher) {
+		return ErrNilTxCompletionWatcher
+	}
 
 	return nil
 }
 
 // Subscribe is used by a dispatcher to send a dispatcher.SubscribeEvent
 func (ch *commonHub) Subscribe(event data.SubscribeEvent) {
+	ch.watchSubscribedTxs(event)
 	ch.subscriptionMapper.MatchSubscribeEvent(event)
 }
 
+func (ch *commonHub) watchSubscribedTxs(event data.SubscribeEvent) {
+	for _, entry := range event.SubscriptionEntries {
+		if entry.EventType != common.TxCompleted || len(entry.TxHashes) == 0 {
+			continue
+		}
+
+		err := ch.txCompletionWatcher.WatchTxs(entry.TxHashes)
+		if err != nil {
+			log.Warn("could not watch transactions",
+				"dispatcherID", event.DispatcherID,
+				"error", err,
+			)
+		}
+	}
+}
+
 // RegisterEvent will send event to a receive-only channel used to register dispatchers
 func (ch *commonHub) RegisterEvent(event dispatcher.EventDispatcher) {
 	ch.registerDispatcher(event)
@@ -75,6 +106,14 @@ func (ch *commonHub) Publish(blockEvents data.BlockEvents) {
 	for _, sub := range subscriptions[common.PushLogsAndEvents] {
 		ch.handlePushBlockEvents(blockEvents, sub)
 	}
+
+	ch.confirmDelivery(blockEvents.Hash, common.PushLogsAndEvents)
+}
+
+// confirmDelivery notifies that the message was handed to the dispatchers, since
+// there is no delivery confirmation for websocket messages
+func (ch *commonHub) confirmDelivery(hash string, stream string) {
+	ch.deliveryTracker.ConfirmDelivery(common.GetMessageID(hash, stream))
 }
 
 func (ch *commonHub) handlePushBlockEvents(blockEvents data.BlockEvents, subscription data.Subscription) {
@@ -85,10 +124,18 @@ func (ch *commonHub) handlePushBlockEvents(blockEvents data.BlockEvents, subscri
 		}
 	}
 
+	filteredBlockEvents := data.BlockEvents{
+		Hash:      blockEvents.Hash,
+		ShardID:   blockEvents.ShardID,
+		Nonce:     blockEvents.Nonce,
+		TimeStamp: blockEvents.TimeStamp,
+		Events:    events,
+	}
+
 	ch.mutDispatchers.RLock()
 	d, ok := ch.dispatchers[subscription.DispatcherID]
 	if ok {
-		d.PushEvents(events)
+		d.PushEvents(filteredBlockEvents)
 	}
 	ch.mutDispatchers.RUnlock()
 }
@@ -104,12 +151,14 @@ func (ch *commonHub) PublishRevert(revertBlock data.RevertBlock) {
 	}
 
 	ch.mutDispatchers.RLock()
-	defer ch.mutDispatchers.RUnlock()
 	for id, event := range dispatchersMap {
 		if d, ok := ch.dispatchers[id]; ok {
 			d.RevertEvent(event)
 		}
 	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(revertBlock.Hash, common.RevertBlockEvents)
 }
 
 // PublishFinalized will publish finalized event to dispatcher
@@ -123,12 +172,14 @@ func (ch *commonHub) PublishFinalized(finalizedBlock data.FinalizedBlock) {
 	}
 
 	ch.mutDispatchers.RLock()
-	defer ch.mutDispatchers.RUnlock()
 	for id, event := range dispatchersMap {
 		if d, ok := ch.dispatchers[id]; ok {
 			d.FinalizedEvent(event)
 		}
 	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(finalizedBlock.Hash, common.FinalizedBlockEvents)
 }
 
 // PublishTxs will publish txs event to dispatcher
@@ -142,12 +193,14 @@ func (ch *commonHub) PublishTxs(blockTxs data.BlockTxs) {
 	}
 
 	ch.mutDispatchers.RLock()
-	defer ch.mutDispatchers.RUnlock()
 	for id, event := range dispatchersMap {
 		if d, ok := ch.dispatchers[id]; ok {
 			d.TxsEvent(event)
 		}
 	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockTxs.Hash, common.BlockTxs)
 }
 
 // PublishBlockEventsWithOrder will publish block events with order to dispatcher
@@ -161,12 +214,14 @@ func (ch *commonHub) PublishBlockEventsWithOrder(blockTxs data.BlockEventsWithOr
 	}
 
 	ch.mutDispatchers.RLock()
-	defer ch.mutDispatchers.RUnlock()
 	for id, event := range dispatchersMap {
 		if d, ok := ch.dispatchers[id]; ok {
 			d.BlockEvents(event)
 		}
 	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockTxs.Hash, common.BlockEvents)
 }
 
 // PublishScrs will publish scrs events to dispatcher
@@ -180,12 +235,385 @@ func (ch *commonHub) PublishScrs(blockScrs data.BlockScrs) {
 	}
 
 	ch.mutDispatchers.RLock()
-	defer ch.mutDispatchers.RUnlock()
 	for id, event := range dispatchersMap {
 		if d, ok := ch.dispatchers[id]; ok {
 			d.ScrsEvent(event)
 		}
 	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockScrs.Hash, common.BlockScrs)
+}
+
+// PublishRewards will publish block rewards event to dispatcher
+func (ch *commonHub) PublishRewards(blockRewards data.BlockRewards) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockRewards)
+
+	for _, subscription := range subscriptions[common.BlockRewards] {
+		dispatchersMap[subscription.DispatcherID] = blockRewards
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.RewardsEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockRewards.Hash, common.BlockRewards)
+}
+
+// PublishReceipts will publish block receipts event to dispatcher
+func (ch *commonHub) PublishReceipts(blockReceipts data.BlockReceipts) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockReceipts)
+
+	for _, subscription := range subscriptions[common.BlockReceipts] {
+		dispatchersMap[subscription.DispatcherID] = blockReceipts
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.ReceiptsEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockReceipts.Hash, common.BlockReceipts)
+}
+
+// PublishInvalidTxs will publish block invalid txs event to dispatcher
+func (ch *commonHub) PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockInvalidTxs)
+
+	for _, subscription := range subscriptions[common.BlockInvalidTxs] {
+		dispatchersMap[subscription.DispatcherID] = blockInvalidTxs
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.InvalidTxsEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockInvalidTxs.Hash, common.BlockInvalidTxs)
+}
+
+// PublishAlteredAccounts will publish altered accounts event to dispatcher. Subscriptions which
+// specify an address receive only the changes of the subscribed accounts
+func (ch *commonHub) PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]map[string]*alteredAccount.AlteredAccount)
+
+	for _, subscription := range subscriptions[common.AlteredAccounts] {
+		accounts, ok := dispatchersMap[subscription.DispatcherID]
+		if !ok {
+			accounts = make(map[string]*alteredAccount.AlteredAccount)
+			dispatchersMap[subscription.DispatcherID] = accounts
+		}
+
+		for key, account := range alteredAccounts.AlteredAccounts {
+			if matchAlteredAccount(subscription, account) {
+				accounts[key] = account
+			}
+		}
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, accounts := range dispatchersMap {
+		// dispatchers subscribed only to accounts not altered in this block are skipped
+		if len(accounts) == 0 && len(alteredAccounts.AlteredAccounts) > 0 {
+			continue
+		}
+
+		if d, ok := ch.dispatchers[id]; ok {
+			d.AlteredAccountsEvent(data.BlockAlteredAccounts{
+				Hash:            alteredAccounts.Hash,
+				ShardID:         alteredAccounts.ShardID,
+				Nonce:           alteredAccounts.Nonce,
+				AlteredAccounts: accounts,
+			})
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(alteredAccounts.Hash, common.AlteredAccounts)
+}
+
+func matchAlteredAccount(subscription data.Subscription, account *alteredAccount.AlteredAccount) bool {
+	if account == nil {
+		return false
+	}
+	if subscription.Address == "" {
+		return true
+	}
+
+	return account.Address == subscription.Address
+}
+
+// PublishBlockHeader will publish block headers event to dispatcher
+func (ch *commonHub) PublishBlockHeader(blockHeader data.BlockHeader) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockHeader)
+
+	for _, subscription := range subscriptions[common.BlockHeaders] {
+		dispatchersMap[subscription.DispatcherID] = blockHeader
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.BlockHeaderEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(blockHeader.Hash, common.BlockHeaders)
+}
+
+// PublishRoundsInfo will publish rounds info event to dispatcher
+func (ch *commonHub) PublishRoundsInfo(roundsInfo data.RoundsInfo) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.RoundsInfo)
+
+	for _, subscription := range subscriptions[common.RoundsInfo] {
+		dispatchersMap[subscription.DispatcherID] = roundsInfo
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.RoundsInfoEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(roundsInfo.ID, common.RoundsInfo)
+}
+
+// PublishValidatorsRating will publish validators rating event to dispatcher
+func (ch *commonHub) PublishValidatorsRating(validatorsRating data.ValidatorsRating) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.ValidatorsRating)
+
+	for _, subscription := range subscriptions[common.ValidatorsRating] {
+		dispatchersMap[subscription.DispatcherID] = validatorsRating
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.ValidatorsRatingEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(validatorsRating.ID, common.ValidatorsRating)
+}
+
+// PublishValidatorsPubKeys will publish validators public keys event to dispatcher
+func (ch *commonHub) PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.ValidatorsPubKeys)
+
+	for _, subscription := range subscriptions[common.ValidatorsPubKeys] {
+		dispatchersMap[subscription.DispatcherID] = validatorsPubKeys
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.ValidatorsPubKeysEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(validatorsPubKeys.ID, common.ValidatorsPubKeys)
+}
+
+// PublishEpochStart will publish epoch start event to dispatcher
+func (ch *commonHub) PublishEpochStart(epochStart data.EpochStart) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.EpochStart)
+
+	for _, subscription := range subscriptions[common.EpochStart] {
+		dispatchersMap[subscription.DispatcherID] = epochStart
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.EpochStartEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(epochStart.Hash, common.EpochStart)
+}
+
+// PublishTxCompleted will publish transaction completed event to dispatcher
+func (ch *commonHub) PublishTxCompleted(txCompleted data.TxCompleted) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.TxCompleted)
+
+	for _, subscription := range subscriptions[common.TxCompleted] {
+		if matchTxHash(subscription, txCompleted.TxHash) {
+			dispatchersMap[subscription.DispatcherID] = txCompleted
+		}
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.TxCompletedEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(txCompleted.TxHash, common.TxCompleted)
+}
+
+func matchTxHash(subscription data.Subscription, txHash string) bool {
+	if len(subscription.TxHashes) == 0 {
+		return true
+	}
+
+	for _, subscribedTxHash := range subscription.TxHashes {
+		if strings.EqualFold(subscribedTxHash, txHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PublishFailedExecutions will publish failed executions event to dispatcher
+func (ch *commonHub) PublishFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID][]data.FailedExecution)
+
+	for _, subscription := range subscriptions[common.FailedExecutions] {
+		executions, ok := dispatchersMap[subscription.DispatcherID]
+		if !ok {
+			executions = make([]data.FailedExecution, 0)
+		}
+
+		for _, failedExecution := range failedExecutions.FailedExecutions {
+			if matchFailedExecution(subscription, failedExecution) {
+				executions = append(executions, failedExecution)
+			}
+		}
+		dispatchersMap[subscription.DispatcherID] = executions
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, executions := range dispatchersMap {
+		// dispatchers subscribed only to addresses without failures in this block are skipped
+		if len(executions) == 0 && len(failedExecutions.FailedExecutions) > 0 {
+			continue
+		}
+
+		if d, ok := ch.dispatchers[id]; ok {
+			d.FailedExecutionsEvent(data.BlockFailedExecutions{
+				Hash:             failedExecutions.Hash,
+				ShardID:          failedExecutions.ShardID,
+				Nonce:            failedExecutions.Nonce,
+				TimeStamp:        failedExecutions.TimeStamp,
+				FailedExecutions: executions,
+			})
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(failedExecutions.Hash, common.FailedExecutions)
+}
+
+func matchFailedExecution(subscription data.Subscription, failedExecution data.FailedExecution) bool {
+	if subscription.Address == "" {
+		return true
+	}
+
+	return failedExecution.Sender == subscription.Address || failedExecution.Receiver == subscription.Address
+}
+
+// PublishGapDetected will publish nonce gap detected event to dispatcher
+func (ch *commonHub) PublishGapDetected(gapDetected data.GapDetected) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.GapDetected)
+
+	for _, subscription := range subscriptions[common.GapDetected] {
+		dispatchersMap[subscription.DispatcherID] = gapDetected
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.GapDetectedEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(gapDetected.ID, common.GapDetected)
+}
+
+// PublishRetractedEvents will publish retracted events to dispatcher
+func (ch *commonHub) PublishRetractedEvents(retractedEvents data.RetractedEvents) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.RetractedEvents)
+
+	for _, subscription := range subscriptions[common.RetractedEvents] {
+		dispatchersMap[subscription.DispatcherID] = retractedEvents
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.RetractedEventsEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(retractedEvents.Hash, common.RetractedEvents)
+}
+
+// PublishShardStalled will publish stalled shard event to dispatcher
+func (ch *commonHub) PublishShardStalled(shardStalled data.ShardStalled) {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.ShardStalled)
+
+	for _, subscription := range subscriptions[common.ShardStalled] {
+		dispatchersMap[subscription.DispatcherID] = shardStalled
+	}
+
+	ch.mutDispatchers.RLock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.ShardStalledEvent(event)
+		}
+	}
+	ch.mutDispatchers.RUnlock()
+
+	ch.confirmDelivery(shardStalled.ID, common.ShardStalled)
 }
 
 func (ch *commonHub) registerDispatcher(d dispatcher.EventDispatcher) {
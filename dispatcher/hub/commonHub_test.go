@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
@@ -16,8 +17,10 @@ import (
 
 func createMockCommonHubArgs() ArgsCommonHub {
 	return ArgsCommonHub{
-		Filter:             filters.NewDefaultFilter(),
-		SubscriptionMapper: dispatcher.NewSubscriptionMapper(),
+		Filter:              filters.NewDefaultFilter(),
+		SubscriptionMapper:  dispatcher.NewSubscriptionMapper(),
+		DeliveryTracker:     &mocks.DeliveryTrackerStub{},
+		TxCompletionWatcher: &mocks.TxCompletionWatcherStub{},
 	}
 }
 
@@ -46,6 +49,28 @@ func TestNewCommonHub(t *testing.T) {
 		assert.Equal(t, ErrNilSubscriptionMapper, err)
 	})
 
+	t.Run("nil delivery tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		args.DeliveryTracker = nil
+
+		hub, err := NewCommonHub(args)
+		require.Nil(t, hub)
+		assert.Equal(t, ErrNilDeliveryTracker, err)
+	})
+
+	t.Run("nil tx completion watcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		args.TxCompletionWatcher = nil
+
+		hub, err := NewCommonHub(args)
+		require.Nil(t, hub)
+		assert.Equal(t, ErrNilTxCompletionWatcher, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -122,6 +147,37 @@ func TestCommonHub_HandleBroadcastDispatcherReceivesEvents(t *testing.T) {
 	require.True(t, len(consumer.CollectedEvents()) == len(blockEvents.Events))
 }
 
+func TestCommonHub_ConfirmDelivery(t *testing.T) {
+	t.Parallel()
+
+	confirmedMessages := make([]string, 0)
+	args := createMockCommonHubArgs()
+	args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+		ConfirmDeliveryCalled: func(messageID string) {
+			confirmedMessages = append(confirmedMessages, messageID)
+		},
+	}
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	hub.Publish(data.BlockEvents{Hash: "hash1"})
+	hub.PublishRevert(data.RevertBlock{Hash: "hash1"})
+	hub.PublishFinalized(data.FinalizedBlock{Hash: "hash1"})
+	hub.PublishTxs(data.BlockTxs{Hash: "hash1"})
+	hub.PublishScrs(data.BlockScrs{Hash: "hash1"})
+	hub.PublishBlockEventsWithOrder(data.BlockEventsWithOrder{Hash: "hash1"})
+
+	expectedMessages := []string{
+		common.GetMessageID("hash1", common.PushLogsAndEvents),
+		common.GetMessageID("hash1", common.RevertBlockEvents),
+		common.GetMessageID("hash1", common.FinalizedBlockEvents),
+		common.GetMessageID("hash1", common.BlockTxs),
+		common.GetMessageID("hash1", common.BlockScrs),
+		common.GetMessageID("hash1", common.BlockEvents),
+	}
+	require.Equal(t, expectedMessages, confirmedMessages)
+}
+
 func TestCommonHub_HandleBroadcastMultipleDispatchers(t *testing.T) {
 	t.Parallel()
 
@@ -281,6 +337,299 @@ func TestCommonHub_HandleTxsBroadcast(t *testing.T) {
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
 }
 
+func TestCommonHub_HandleRewardsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.NoError(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		RewardsEventCalled: func(event data.BlockRewards) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.BlockRewards,
+			},
+		},
+	})
+
+	blockEvents := data.BlockRewards{
+		Hash: "hash1",
+	}
+
+	hub.PublishRewards(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleAlteredAccountsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	firstAccount := &alteredAccount.AlteredAccount{
+		Address: "erd1first",
+		Nonce:   1,
+		Balance: "100",
+	}
+	secondAccount := &alteredAccount.AlteredAccount{
+		Address: "erd1second",
+		Nonce:   2,
+		Balance: "200",
+	}
+
+	t.Run("should dispatch all accounts without address filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		var receivedEvent data.BlockAlteredAccounts
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				receivedEvent = event
+			},
+		})
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.AlteredAccounts,
+				},
+			},
+		})
+
+		blockEvents := data.BlockAlteredAccounts{
+			Hash:  "hash1",
+			Nonce: 10,
+			AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+				firstAccount.Address:  firstAccount,
+				secondAccount.Address: secondAccount,
+			},
+		}
+
+		hub.PublishAlteredAccounts(blockEvents)
+
+		require.Equal(t, blockEvents, receivedEvent)
+	})
+
+	t.Run("should dispatch only the subscribed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		receivedEvents := make([]data.BlockAlteredAccounts, 0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.AlteredAccounts,
+					Address:   firstAccount.Address,
+				},
+			},
+		})
+
+		hub.PublishAlteredAccounts(data.BlockAlteredAccounts{
+			Hash: "hash1",
+			AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+				firstAccount.Address:  firstAccount,
+				secondAccount.Address: secondAccount,
+			},
+		})
+		hub.PublishAlteredAccounts(data.BlockAlteredAccounts{
+			Hash: "hash2",
+			AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+				secondAccount.Address: secondAccount,
+			},
+		})
+
+		expectedEvents := []data.BlockAlteredAccounts{
+			{
+				Hash: "hash1",
+				AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+					firstAccount.Address: firstAccount,
+				},
+			},
+		}
+		require.Equal(t, expectedEvents, receivedEvents)
+	})
+}
+
+func TestCommonHub_HandleTxCompletedBroadcast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should watch the subscribed txs", func(t *testing.T) {
+		t.Parallel()
+
+		watchedTxHashes := make([]string, 0)
+		args := createMockCommonHubArgs()
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			WatchTxsCalled: func(txHashes []string) error {
+				watchedTxHashes = append(watchedTxHashes, txHashes...)
+				return nil
+			},
+		}
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxCompleted,
+					TxHashes:  []string{"txHash1", "txHash2"},
+				},
+				{
+					EventType: common.BlockTxs,
+					TxHashes:  []string{"txHash3"},
+				},
+			},
+		})
+
+		require.Equal(t, []string{"txHash1", "txHash2"}, watchedTxHashes)
+	})
+
+	t.Run("should dispatch only the subscribed txs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		receivedEvents := make([]data.TxCompleted, 0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxCompletedEventCalled: func(event data.TxCompleted) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxCompleted,
+					TxHashes:  []string{"AABB"},
+				},
+			},
+		})
+
+		firstTx := data.TxCompleted{
+			TxHash: "aabb",
+			Status: common.TxStatusSuccess,
+		}
+		hub.PublishTxCompleted(firstTx)
+		hub.PublishTxCompleted(data.TxCompleted{
+			TxHash: "ccdd",
+			Status: common.TxStatusSuccess,
+		})
+
+		require.Equal(t, []data.TxCompleted{firstTx}, receivedEvents)
+	})
+}
+
+func TestCommonHub_HandleFailedExecutionsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	firstExecution := data.FailedExecution{
+		TxHash:   "txHash1",
+		Sender:   "erd1first",
+		Receiver: "erd1contract",
+		Error:    "user error",
+	}
+	secondExecution := data.FailedExecution{
+		TxHash:   "txHash2",
+		Sender:   "erd1second",
+		Receiver: "erd1other",
+		Error:    "out of gas",
+	}
+
+	t.Run("should dispatch all failed executions without address filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		var receivedEvent data.BlockFailedExecutions
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			FailedExecutionsEventCalled: func(event data.BlockFailedExecutions) {
+				receivedEvent = event
+			},
+		})
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.FailedExecutions,
+				},
+			},
+		})
+
+		blockEvents := data.BlockFailedExecutions{
+			Hash:             "hash1",
+			Nonce:            10,
+			FailedExecutions: []data.FailedExecution{firstExecution, secondExecution},
+		}
+
+		hub.PublishFailedExecutions(blockEvents)
+
+		require.Equal(t, blockEvents, receivedEvent)
+	})
+
+	t.Run("should dispatch only the failed executions of the subscribed address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.NoError(t, err)
+
+		receivedEvents := make([]data.BlockFailedExecutions, 0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			FailedExecutionsEventCalled: func(event data.BlockFailedExecutions) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.FailedExecutions,
+					Address:   "erd1contract",
+				},
+			},
+		})
+
+		hub.PublishFailedExecutions(data.BlockFailedExecutions{
+			Hash:             "hash1",
+			FailedExecutions: []data.FailedExecution{firstExecution, secondExecution},
+		})
+		hub.PublishFailedExecutions(data.BlockFailedExecutions{
+			Hash:             "hash2",
+			FailedExecutions: []data.FailedExecution{secondExecution},
+		})
+
+		expectedEvents := []data.BlockFailedExecutions{
+			{
+				Hash:             "hash1",
+				FailedExecutions: []data.FailedExecution{firstExecution},
+			},
+		}
+		require.Equal(t, expectedEvents, receivedEvents)
+	})
+}
+
 func TestCommonHub_HandleBlockEventsBroadcast(t *testing.T) {
 	t.Parallel()
 
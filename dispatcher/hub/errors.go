@@ -7,3 +7,9 @@ var ErrNilEventFilter = errors.New("nil events filter")
 
 // ErrNilSubscriptionMapper signals that a nil subscription mapper has been provided
 var ErrNilSubscriptionMapper = errors.New("nil subscription mapper")
+
+// ErrNilDeliveryTracker signals that a nil delivery tracker has been provided
+var ErrNilDeliveryTracker = errors.New("nil delivery tracker")
+
+// ErrNilTxCompletionWatcher signals that a nil tx completion watcher has been provided
+var ErrNilTxCompletionWatcher = errors.New("nil tx completion watcher")
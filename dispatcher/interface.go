@@ -13,12 +13,26 @@ import (
 // EventDispatcher defines the behaviour of a event dispatcher component
 type EventDispatcher interface {
 	GetID() uuid.UUID
-	PushEvents(events []data.Event)
+	PushEvents(events data.BlockEvents)
 	RevertEvent(event data.RevertBlock)
 	FinalizedEvent(event data.FinalizedBlock)
 	TxsEvent(event data.BlockTxs)
 	BlockEvents(event data.BlockEventsWithOrder)
 	ScrsEvent(event data.BlockScrs)
+	RewardsEvent(event data.BlockRewards)
+	ReceiptsEvent(event data.BlockReceipts)
+	InvalidTxsEvent(event data.BlockInvalidTxs)
+	AlteredAccountsEvent(event data.BlockAlteredAccounts)
+	BlockHeaderEvent(event data.BlockHeader)
+	RoundsInfoEvent(event data.RoundsInfo)
+	ValidatorsRatingEvent(event data.ValidatorsRating)
+	ValidatorsPubKeysEvent(event data.ValidatorsPubKeys)
+	EpochStartEvent(event data.EpochStart)
+	TxCompletedEvent(event data.TxCompleted)
+	FailedExecutionsEvent(event data.BlockFailedExecutions)
+	GapDetectedEvent(event data.GapDetected)
+	RetractedEventsEvent(event data.RetractedEvents)
+	ShardStalledEvent(event data.ShardStalled)
 }
 
 // Hub defines the behaviour of a component which should be able to receive events
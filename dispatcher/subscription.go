@@ -70,6 +70,7 @@ func (sm *SubscriptionMapper) MatchSubscribeEvent(event data.SubscribeEvent) {
 			Address:      subEntry.Address,
 			Identifier:   subEntry.Identifier,
 			Topics:       subEntry.Topics,
+			TxHashes:     subEntry.TxHashes,
 			DispatcherID: event.DispatcherID,
 			MatchLevel:   matchLevel,
 			EventType:    eventType,
@@ -141,11 +142,7 @@ func (sm *SubscriptionMapper) appendSubscription(sub data.Subscription) {
 }
 
 func getEventType(subEntry data.SubscriptionEntry) string {
-	if subEntry.EventType == common.FinalizedBlockEvents ||
-		subEntry.EventType == common.RevertBlockEvents ||
-		subEntry.EventType == common.BlockTxs ||
-		subEntry.EventType == common.BlockScrs ||
-		subEntry.EventType == common.BlockEvents {
+	if common.IsKnownStream(subEntry.EventType) {
 		return subEntry.EventType
 	}
 
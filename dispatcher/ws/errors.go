@@ -10,3 +10,6 @@ var ErrNilWSUpgrader = errors.New("nil websocket upgrader")
 
 // ErrNilWSConn signals that a nil websocket connection has been provided
 var ErrNilWSConn = errors.New("nil ws connection")
+
+// ErrNilEnvelopeHandler signals that a nil envelope handler has been provided
+var ErrNilEnvelopeHandler = errors.New("nil envelope handler")
@@ -8,9 +8,10 @@ type ArgsWSDispatcher struct {
 // NewTestWSDispatcher -
 func NewTestWSDispatcher(args ArgsWSDispatcher) (*websocketDispatcher, error) {
 	wsArgs := argsWebSocketDispatcher{
-		Dispatcher: args.Dispatcher,
-		Conn:       args.Conn,
-		Marshaller: args.Marshaller,
+		Dispatcher:      args.Dispatcher,
+		Conn:            args.Conn,
+		Marshaller:      args.Marshaller,
+		EnvelopeHandler: args.EnvelopeHandler,
 	}
 
 	return newWebSocketDispatcher(wsArgs)
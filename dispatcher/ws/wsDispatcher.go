@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
@@ -32,18 +33,20 @@ var (
 
 // argsWebSocketDispatcher defines the arguments needed for ws dispatcher
 type argsWebSocketDispatcher struct {
-	Dispatcher dispatcher.Dispatcher
-	Conn       dispatcher.WSConnection
-	Marshaller marshal.Marshalizer
+	Dispatcher      dispatcher.Dispatcher
+	Conn            dispatcher.WSConnection
+	Marshaller      marshal.Marshalizer
+	EnvelopeHandler cloudevents.EnvelopeHandler
 }
 
 type websocketDispatcher struct {
-	id         uuid.UUID
-	wg         sync.WaitGroup
-	send       chan []byte
-	conn       dispatcher.WSConnection
-	dispatcher dispatcher.Dispatcher
-	marshaller marshal.Marshalizer
+	id              uuid.UUID
+	wg              sync.WaitGroup
+	send            chan []byte
+	conn            dispatcher.WSConnection
+	dispatcher      dispatcher.Dispatcher
+	marshaller      marshal.Marshalizer
+	envelopeHandler cloudevents.EnvelopeHandler
 }
 
 // newWebSocketDispatcher createa a new ws dispatcher instance
@@ -57,13 +60,17 @@ func newWebSocketDispatcher(args argsWebSocketDispatcher) (*websocketDispatcher,
 	if check.IfNil(args.Marshaller) {
 		return nil, common.ErrNilMarshaller
 	}
+	if check.IfNil(args.EnvelopeHandler) {
+		return nil, ErrNilEnvelopeHandler
+	}
 
 	return &websocketDispatcher{
-		id:         uuid.New(),
-		send:       make(chan []byte, 256),
-		conn:       args.Conn,
-		dispatcher: args.Dispatcher,
-		marshaller: args.Marshaller,
+		id:              uuid.New(),
+		send:            make(chan []byte, 256),
+		conn:            args.Conn,
+		dispatcher:      args.Dispatcher,
+		marshaller:      args.Marshaller,
+		envelopeHandler: args.EnvelopeHandler,
 	}, nil
 }
 
@@ -72,119 +79,314 @@ func (wd *websocketDispatcher) GetID() uuid.UUID {
 	return wd.id
 }
 
-// PushEvents receives an events slice and processes it before pushing to socket
-func (wd *websocketDispatcher) PushEvents(events []data.Event) {
-	eventBytes, err := wd.marshaller.Marshal(events)
+// PushEvents receives block events and processes them before pushing to socket
+func (wd *websocketDispatcher) PushEvents(events data.BlockEvents) {
+	eventBytes, err := wd.marshaller.Marshal(events.Events)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wsEvent := &data.WebSocketEvent{
-		Type: common.PushLogsAndEvents,
-		Data: eventBytes,
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.PushLogsAndEvents,
+		Hash:       events.Hash,
+		ShardID:    events.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// RevertEvent receives a reverted block event and process it before pushing to socket
+func (wd *websocketDispatcher) RevertEvent(event data.RevertBlock) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.RevertBlockEvents,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// FinalizedEvent receives a finalized block event and process it before pushing to socket
+func (wd *websocketDispatcher) FinalizedEvent(event data.FinalizedBlock) {
+	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.FinalizedBlockEvents,
+		Hash:      event.Hash,
+	}, eventBytes)
 }
 
-// RevertEvent receives a reverted block event and process it before pushing to socket
-func (wd *websocketDispatcher) RevertEvent(event data.RevertBlock) {
+// TxsEvent receives a block txs event and process it before pushing to socket
+func (wd *websocketDispatcher) TxsEvent(event data.BlockTxs) {
 	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
-	wsEvent := &data.WebSocketEvent{
-		Type: common.RevertBlockEvents,
-		Data: eventBytes,
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.BlockTxs,
+		Hash:      event.Hash,
+	}, eventBytes)
+}
+
+// BlockEvents receives block events with data and processes it before pushing to socket
+func (wd *websocketDispatcher) BlockEvents(event data.BlockEventsWithOrder) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.BlockEvents,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// ScrsEvent receives a block scrs event and process it before pushing to socket
+func (wd *websocketDispatcher) ScrsEvent(event data.BlockScrs) {
+	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.BlockScrs,
+		Hash:      event.Hash,
+	}, eventBytes)
 }
 
-// FinalizedEvent receives a finalized block event and process it before pushing to socket
-func (wd *websocketDispatcher) FinalizedEvent(event data.FinalizedBlock) {
+// RewardsEvent receives a block rewards event and process it before pushing to socket
+func (wd *websocketDispatcher) RewardsEvent(event data.BlockRewards) {
 	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
-	wsEvent := &data.WebSocketEvent{
-		Type: common.FinalizedBlockEvents,
-		Data: eventBytes,
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.BlockRewards,
+		Hash:      event.Hash,
+	}, eventBytes)
+}
+
+// ReceiptsEvent receives a block receipts event and process it before pushing to socket
+func (wd *websocketDispatcher) ReceiptsEvent(event data.BlockReceipts) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.BlockReceipts,
+		Hash:      event.Hash,
+	}, eventBytes)
+}
+
+// InvalidTxsEvent receives a block invalid txs event and process it before pushing to socket
+func (wd *websocketDispatcher) InvalidTxsEvent(event data.BlockInvalidTxs) {
+	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.BlockInvalidTxs,
+		Hash:      event.Hash,
+	}, eventBytes)
 }
 
-// TxsEvent receives a block txs event and process it before pushing to socket
-func (wd *websocketDispatcher) TxsEvent(event data.BlockTxs) {
+// AlteredAccountsEvent receives a altered accounts event and process it before pushing to socket
+func (wd *websocketDispatcher) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
 	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
-	wsEvent := &data.WebSocketEvent{
-		Type: common.BlockTxs,
-		Data: eventBytes,
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.AlteredAccounts,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// BlockHeaderEvent receives a block headers event and process it before pushing to socket
+func (wd *websocketDispatcher) BlockHeaderEvent(event data.BlockHeader) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.BlockHeaders,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// RoundsInfoEvent receives a rounds info event and process it before pushing to socket
+func (wd *websocketDispatcher) RoundsInfoEvent(event data.RoundsInfo) {
+	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.RoundsInfo,
+		Hash:       event.ID,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
 }
 
-// BlockEvents receives block events with data and processes it before pushing to socket
-func (wd *websocketDispatcher) BlockEvents(event data.BlockEventsWithOrder) {
+// ValidatorsRatingEvent receives a validators rating event and process it before pushing to socket
+func (wd *websocketDispatcher) ValidatorsRatingEvent(event data.ValidatorsRating) {
 	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
-	wsEvent := &data.WebSocketEvent{
-		Type: common.BlockEvents,
-		Data: eventBytes,
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.ValidatorsRating,
+		Hash:       event.ID,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// ValidatorsPubKeysEvent receives a validators public keys event and process it before pushing to socket
+func (wd *websocketDispatcher) ValidatorsPubKeysEvent(event data.ValidatorsPubKeys) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.ValidatorsPubKeys,
+		Hash:       event.ID,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// EpochStartEvent receives a epoch start event and process it before pushing to socket
+func (wd *websocketDispatcher) EpochStartEvent(event data.EpochStart) {
+	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.EpochStart,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
 }
 
-// ScrsEvent receives a block scrs event and process it before pushing to socket
-func (wd *websocketDispatcher) ScrsEvent(event data.BlockScrs) {
+// TxCompletedEvent receives a transaction completed event and process it before pushing to socket
+func (wd *websocketDispatcher) TxCompletedEvent(event data.TxCompleted) {
 	eventBytes, err := wd.marshaller.Marshal(event)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
 	}
-	wsEvent := &data.WebSocketEvent{
-		Type: common.BlockScrs,
-		Data: eventBytes,
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType: common.TxCompleted,
+		Hash:      event.TxHash,
+	}, eventBytes)
+}
+
+// FailedExecutionsEvent receives a failed executions event and process it before pushing to socket
+func (wd *websocketDispatcher) FailedExecutionsEvent(event data.BlockFailedExecutions) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
 	}
-	wsEventBytes, err := wd.marshaller.Marshal(wsEvent)
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.FailedExecutions,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// GapDetectedEvent receives a nonce gap detected event and process it before pushing to socket
+func (wd *websocketDispatcher) GapDetectedEvent(event data.GapDetected) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.GapDetected,
+		Hash:       event.ID,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// RetractedEventsEvent receives retracted events and process them before pushing to socket
+func (wd *websocketDispatcher) RetractedEventsEvent(event data.RetractedEvents) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.RetractedEvents,
+		Hash:       event.Hash,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+// ShardStalledEvent receives a stalled shard event and process it before pushing to socket
+func (wd *websocketDispatcher) ShardStalledEvent(event data.ShardStalled) {
+	eventBytes, err := wd.marshaller.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendEvent(cloudevents.EventInfo{
+		EventType:  common.ShardStalled,
+		Hash:       event.ID,
+		ShardID:    event.ShardID,
+		HasShardID: true,
+	}, eventBytes)
+}
+
+func (wd *websocketDispatcher) sendEvent(info cloudevents.EventInfo, eventBytes []byte) {
+	wsEventBytes, err := wd.createWSMessage(info, eventBytes)
 	if err != nil {
 		log.Error("failure marshalling events", "err", err.Error())
 		return
@@ -193,6 +395,21 @@ func (wd *websocketDispatcher) ScrsEvent(event data.BlockScrs) {
 	wd.send <- wsEventBytes
 }
 
+// createWSMessage wraps the event in a CloudEvents envelope, if enabled. The websocket
+// messages always use the structured content mode, since they have no transport headers.
+func (wd *websocketDispatcher) createWSMessage(info cloudevents.EventInfo, eventBytes []byte) ([]byte, error) {
+	if wd.envelopeHandler.IsEnabled() {
+		return wd.envelopeHandler.CreateStructuredEnvelope(info, eventBytes)
+	}
+
+	wsEvent := &data.WebSocketEvent{
+		Type: info.EventType,
+		Data: eventBytes,
+	}
+
+	return wd.marshaller.Marshal(wsEvent)
+}
+
 // writePump listens on the send-channel and pushes data on the socket stream
 func (wd *websocketDispatcher) writePump() {
 	ticker := time.NewTicker(pingPeriod)
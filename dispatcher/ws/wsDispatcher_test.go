@@ -9,8 +9,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/mock"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +40,7 @@ func createMockWSDispatcherArgs() ws.ArgsWSDispatcher {
 	args.Dispatcher = &mocks.HubStub{}
 	args.Conn = &mocks.WSConnStub{}
 	args.Marshaller = &mock.MarshalizerMock{}
+	args.EnvelopeHandler = &disabled.EnvelopeHandler{}
 	return args
 }
 
@@ -75,6 +80,17 @@ func TestNewWebSocketDispatcher(t *testing.T) {
 		assert.Equal(t, common.ErrNilMarshaller, err)
 	})
 
+	t.Run("nil envelope handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWSDispatcherArgs()
+		args.EnvelopeHandler = nil
+
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, wd)
+		assert.Equal(t, ws.ErrNilEnvelopeHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -109,9 +125,12 @@ func TestWritePump(t *testing.T) {
 	wd, err := ws.NewTestWSDispatcher(args)
 	require.Nil(t, err)
 
-	events := []data.Event{
-		{
-			Address: "addr1",
+	events := data.BlockEvents{
+		Hash: "hash1",
+		Events: []data.Event{
+			{
+				Address: "addr1",
+			},
 		},
 	}
 
@@ -167,7 +186,10 @@ func TestPushEvents(t *testing.T) {
 	}
 	eventBytes, _ := json.Marshal(events)
 
-	wd.PushEvents(events)
+	wd.PushEvents(data.BlockEvents{
+		Hash:   "hash1",
+		Events: events,
+	})
 
 	wsEvent := &data.WebSocketEvent{
 		Type: common.PushLogsAndEvents,
@@ -180,6 +202,54 @@ func TestPushEvents(t *testing.T) {
 	require.Equal(t, expectedEventBytes, eventsData)
 }
 
+func TestPushEvents_WithCloudEventsEnvelope(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.JsonMarshalizer{}
+	envelopeHandler, err := cloudevents.NewEnvelopeHandler(cloudevents.ArgsEnvelopeHandler{
+		Config: config.CloudEventsConfig{
+			Enabled:    true,
+			Mode:       cloudevents.BinaryMode,
+			Source:     "multiversx/testnet",
+			TypePrefix: "com.multiversx.notifier",
+		},
+		Marshaller: marshaller,
+	})
+	require.Nil(t, err)
+
+	args := createMockWSDispatcherArgs()
+	args.Marshaller = marshaller
+	args.EnvelopeHandler = envelopeHandler
+	wd, err := ws.NewTestWSDispatcher(args)
+	require.Nil(t, err)
+
+	events := []data.Event{
+		{
+			Address:    "addr1",
+			Identifier: "id1",
+		},
+	}
+	eventBytes, _ := json.Marshal(events)
+
+	wd.PushEvents(data.BlockEvents{
+		Hash:    "hash1",
+		ShardID: 1,
+		Events:  events,
+	})
+
+	// websocket messages are always sent in structured mode
+	envelope := &cloudevents.Envelope{}
+	err = json.Unmarshal(wd.ReadSendChannel(), envelope)
+	require.Nil(t, err)
+
+	require.Equal(t, cloudevents.SpecVersion, envelope.SpecVersion)
+	require.Equal(t, "hash1_"+common.PushLogsAndEvents, envelope.ID)
+	require.Equal(t, "multiversx/testnet/shard/1", envelope.Source)
+	require.Equal(t, "com.multiversx.notifier."+common.PushLogsAndEvents, envelope.Type)
+	require.Equal(t, "application/json", envelope.DataContentType)
+	require.Equal(t, json.RawMessage(eventBytes), envelope.Data)
+}
+
 func TestBlockEventsWithOrder(t *testing.T) {
 	t.Parallel()
 
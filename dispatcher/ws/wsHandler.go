@@ -5,21 +5,24 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 )
 
 // ArgsWebSocketProcessor defines the argument needed to create a websocketHandler
 type ArgsWebSocketProcessor struct {
-	Dispatcher dispatcher.Dispatcher
-	Upgrader   dispatcher.WSUpgrader
-	Marshaller marshal.Marshalizer
+	Dispatcher      dispatcher.Dispatcher
+	Upgrader        dispatcher.WSUpgrader
+	Marshaller      marshal.Marshalizer
+	EnvelopeHandler cloudevents.EnvelopeHandler
 }
 
 type websocketProcessor struct {
-	dispatcher dispatcher.Dispatcher
-	upgrader   dispatcher.WSUpgrader
-	marshaller marshal.Marshalizer
+	dispatcher      dispatcher.Dispatcher
+	upgrader        dispatcher.WSUpgrader
+	marshaller      marshal.Marshalizer
+	envelopeHandler cloudevents.EnvelopeHandler
 }
 
 // NewWebSocketProcessor creates a new websocketProcessor component
@@ -30,9 +33,10 @@ func NewWebSocketProcessor(args ArgsWebSocketProcessor) (*websocketProcessor, er
 	}
 
 	return &websocketProcessor{
-		dispatcher: args.Dispatcher,
-		upgrader:   args.Upgrader,
-		marshaller: args.Marshaller,
+		dispatcher:      args.Dispatcher,
+		upgrader:        args.Upgrader,
+		marshaller:      args.Marshaller,
+		envelopeHandler: args.EnvelopeHandler,
 	}, nil
 }
 
@@ -46,6 +50,9 @@ func checkArgs(args ArgsWebSocketProcessor) error {
 	if check.IfNil(args.Marshaller) {
 		return common.ErrNilMarshaller
 	}
+	if check.IfNil(args.EnvelopeHandler) {
+		return ErrNilEnvelopeHandler
+	}
 
 	return nil
 }
@@ -59,9 +66,10 @@ func (wh *websocketProcessor) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	args := argsWebSocketDispatcher{
-		Dispatcher: wh.dispatcher,
-		Conn:       conn,
-		Marshaller: wh.marshaller,
+		Dispatcher:      wh.dispatcher,
+		Conn:            conn,
+		Marshaller:      wh.marshaller,
+		EnvelopeHandler: wh.envelopeHandler,
 	}
 	wsDispatcher, err := newWebSocketDispatcher(args)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/mock"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/stretchr/testify/assert"
@@ -14,9 +15,10 @@ import (
 
 func createMockArgsWSHandler() ws.ArgsWebSocketProcessor {
 	return ws.ArgsWebSocketProcessor{
-		Dispatcher: &mocks.HubStub{},
-		Upgrader:   &mocks.WSUpgraderStub{},
-		Marshaller: &mock.MarshalizerMock{},
+		Dispatcher:      &mocks.HubStub{},
+		Upgrader:        &mocks.WSUpgraderStub{},
+		Marshaller:      &mock.MarshalizerMock{},
+		EnvelopeHandler: &disabled.EnvelopeHandler{},
 	}
 }
 
@@ -56,6 +58,17 @@ func TestNewWebSocketHandler(t *testing.T) {
 		assert.Equal(t, common.ErrNilMarshaller, err)
 	})
 
+	t.Run("nil envelope handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWSHandler()
+		args.EnvelopeHandler = nil
+
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.True(t, check.IfNil(wh))
+		assert.Equal(t, ws.ErrNilEnvelopeHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
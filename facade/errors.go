@@ -7,3 +7,9 @@ var ErrNilEventsHandler = errors.New("nil events handler")
 
 // ErrNilWSHandler signals that a nil websocket handler was provided
 var ErrNilWSHandler = errors.New("nil websocket handler")
+
+// ErrNilShardsMonitor signals that a nil shards monitor was provided
+var ErrNilShardsMonitor = errors.New("nil shards monitor")
+
+// ErrNilTxCompletionWatcher signals that a nil tx completion watcher was provided
+var ErrNilTxCompletionWatcher = errors.New("nil tx completion watcher")
@@ -9,8 +9,11 @@ import (
 // This will handle push events from observer node.
 type EventsHandler interface {
 	HandleSaveBlockEvents(allEvents data.ArgsSaveBlockData) error
-	HandleRevertEvents(revertBlock data.RevertBlock)
-	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	HandleRevertEvents(revertBlock data.RevertBlock) error
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error
+	HandleRoundsInfo(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRating(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error
 	IsInterfaceNil() bool
 }
 
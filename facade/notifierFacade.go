@@ -19,13 +19,17 @@ type ArgsNotifierFacade struct {
 	EventsHandler        EventsHandler
 	WSHandler            dispatcher.WSHandler
 	StatusMetricsHandler common.StatusMetricsHandler
+	TxCompletionWatcher  common.TxCompletionWatcher
+	ShardsMonitor        common.ShardsMonitor
 }
 
 type notifierFacade struct {
-	config        config.ConnectorApiConfig
-	eventsHandler EventsHandler
-	wsHandler     dispatcher.WSHandler
-	statusMetrics common.StatusMetricsHandler
+	config              config.ConnectorApiConfig
+	eventsHandler       EventsHandler
+	wsHandler           dispatcher.WSHandler
+	statusMetrics       common.StatusMetricsHandler
+	txCompletionWatcher common.TxCompletionWatcher
+	shardsMonitor       common.ShardsMonitor
 }
 
 // NewNotifierFacade creates a new notifier facade instance
@@ -36,10 +40,12 @@ func NewNotifierFacade(args ArgsNotifierFacade) (*notifierFacade, error) {
 	}
 
 	return &notifierFacade{
-		eventsHandler: args.EventsHandler,
-		config:        args.APIConfig,
-		wsHandler:     args.WSHandler,
-		statusMetrics: args.StatusMetricsHandler,
+		eventsHandler:       args.EventsHandler,
+		config:              args.APIConfig,
+		wsHandler:           args.WSHandler,
+		statusMetrics:       args.StatusMetricsHandler,
+		txCompletionWatcher: args.TxCompletionWatcher,
+		shardsMonitor:       args.ShardsMonitor,
 	}, nil
 }
 
@@ -53,6 +59,12 @@ func checkArgs(args ArgsNotifierFacade) error {
 	if check.IfNil(args.StatusMetricsHandler) {
 		return common.ErrNilStatusMetricsHandler
 	}
+	if check.IfNil(args.TxCompletionWatcher) {
+		return ErrNilTxCompletionWatcher
+	}
+	if check.IfNil(args.ShardsMonitor) {
+		return ErrNilShardsMonitor
+	}
 
 	return nil
 }
@@ -60,17 +72,39 @@ func checkArgs(args ArgsNotifierFacade) error {
 // HandlePushEvents will handle push events received from observer
 // It splits block data and handles log, txs and srcs events separately
 func (nf *notifierFacade) HandlePushEvents(allEvents data.ArgsSaveBlockData) error {
+	nf.shardsMonitor.BlockReceived(&allEvents)
+
 	return nf.eventsHandler.HandleSaveBlockEvents(allEvents)
 }
 
 // HandleRevertEvents will handle revents events received from observer
-func (nf *notifierFacade) HandleRevertEvents(events data.RevertBlock) {
-	nf.eventsHandler.HandleRevertEvents(events)
+func (nf *notifierFacade) HandleRevertEvents(events data.RevertBlock) error {
+	return nf.eventsHandler.HandleRevertEvents(events)
 }
 
 // HandleFinalizedEvents will handle finalized events received from observer
-func (nf *notifierFacade) HandleFinalizedEvents(events data.FinalizedBlock) {
-	nf.eventsHandler.HandleFinalizedEvents(events)
+func (nf *notifierFacade) HandleFinalizedEvents(events data.FinalizedBlock) error {
+	return nf.eventsHandler.HandleFinalizedEvents(events)
+}
+
+// WatchTxs will register the provided transactions hashes for the tx completed notifications
+func (nf *notifierFacade) WatchTxs(txHashes []string) error {
+	return nf.txCompletionWatcher.WatchTxs(txHashes)
+}
+
+// HandleRoundsInfo will handle rounds info events received from observer
+func (nf *notifierFacade) HandleRoundsInfo(roundsInfo data.RoundsInfo) error {
+	return nf.eventsHandler.HandleRoundsInfo(roundsInfo)
+}
+
+// HandleValidatorsRating will handle validators rating events received from observer
+func (nf *notifierFacade) HandleValidatorsRating(validatorsRating data.ValidatorsRating) error {
+	return nf.eventsHandler.HandleValidatorsRating(validatorsRating)
+}
+
+// HandleValidatorsPubKeys will handle validators public keys events received from observer
+func (nf *notifierFacade) HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error {
+	return nf.eventsHandler.HandleValidatorsPubKeys(validatorsPubKeys)
 }
 
 // ServeHTTP will handle a websocket request
@@ -94,6 +128,11 @@ func (nf *notifierFacade) GetMetricsForPrometheus() string {
 	return nf.statusMetrics.GetMetricsForPrometheus()
 }
 
+// GetShardsStatus will return the liveness status of the monitored shards
+func (nf *notifierFacade) GetShardsStatus() []data.ShardStatus {
+	return nf.shardsMonitor.GetShardsStatus()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *notifierFacade) IsInterfaceNil() bool {
 	return nf == nil
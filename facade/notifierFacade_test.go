@@ -24,6 +24,8 @@ func createMockFacadeArgs() facade.ArgsNotifierFacade {
 		APIConfig:            config.ConnectorApiConfig{},
 		WSHandler:            &mocks.WSHandlerStub{},
 		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		TxCompletionWatcher:  &mocks.TxCompletionWatcherStub{},
+		ShardsMonitor:        &mocks.ShardsMonitorStub{},
 	}
 }
 
@@ -63,6 +65,28 @@ func TestNewNotifierFacade(t *testing.T) {
 		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
 	})
 
+	t.Run("nil tx completion watcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.TxCompletionWatcher = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilTxCompletionWatcher, err)
+	})
+
+	t.Run("nil shards monitor", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.ShardsMonitor = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilShardsMonitor, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -98,6 +122,14 @@ func TestHandlePushEvents(t *testing.T) {
 			Header: &block.HeaderV2{},
 		}
 
+		blockReceivedWasCalled := false
+		args.ShardsMonitor = &mocks.ShardsMonitorStub{
+			BlockReceivedCalled: func(receivedBlock *data.ArgsSaveBlockData) {
+				require.Equal(t, blockData, *receivedBlock)
+				blockReceivedWasCalled = true
+			},
+		}
+
 		saveBlockWasCalled := false
 		args.EventsHandler = &mocks.EventsHandlerStub{
 			HandleSaveBlockEventsCalled: func(allEvents data.ArgsSaveBlockData) error {
@@ -113,6 +145,7 @@ func TestHandlePushEvents(t *testing.T) {
 		require.Nil(t, err)
 
 		assert.True(t, saveBlockWasCalled)
+		assert.True(t, blockReceivedWasCalled)
 	})
 }
 
@@ -128,15 +161,17 @@ func TestHandleRevertEvents(t *testing.T) {
 
 	revertWasCalled := false
 	args.EventsHandler = &mocks.EventsHandlerStub{
-		HandleRevertEventsCalled: func(revertBlock data.RevertBlock) {
+		HandleRevertEventsCalled: func(revertBlock data.RevertBlock) error {
 			revertWasCalled = true
 			assert.Equal(t, revertData, revertBlock)
+			return nil
 		},
 	}
 	facade, err := facade.NewNotifierFacade(args)
 	require.Nil(t, err)
 
-	facade.HandleRevertEvents(revertData)
+	err = facade.HandleRevertEvents(revertData)
+	require.Nil(t, err)
 
 	assert.True(t, revertWasCalled)
 }
@@ -152,19 +187,72 @@ func TestHandleFinalizedEvents(t *testing.T) {
 
 	finalizedWasCalled := false
 	args.EventsHandler = &mocks.EventsHandlerStub{
-		HandleFinalizedEventsCalled: func(finalizedBlock data.FinalizedBlock) {
+		HandleFinalizedEventsCalled: func(finalizedBlock data.FinalizedBlock) error {
 			finalizedWasCalled = true
 			assert.Equal(t, finalizedData, finalizedBlock)
+			return nil
 		},
 	}
 	facade, err := facade.NewNotifierFacade(args)
 	require.Nil(t, err)
 
-	facade.HandleFinalizedEvents(finalizedData)
+	err = facade.HandleFinalizedEvents(finalizedData)
+	require.Nil(t, err)
 
 	assert.True(t, finalizedWasCalled)
 }
 
+func TestWatchTxs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+
+	txHashes := []string{"txHash1", "txHash2"}
+
+	watchTxsWasCalled := false
+	args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+		WatchTxsCalled: func(hashes []string) error {
+			watchTxsWasCalled = true
+			assert.Equal(t, txHashes, hashes)
+			return nil
+		},
+	}
+	facade, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	err = facade.WatchTxs(txHashes)
+	require.Nil(t, err)
+
+	assert.True(t, watchTxsWasCalled)
+}
+
+func TestHandleRoundsInfo(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+
+	roundsInfoData := data.RoundsInfo{
+		ID:      "1_12",
+		ShardID: 1,
+	}
+
+	roundsInfoWasCalled := false
+	args.EventsHandler = &mocks.EventsHandlerStub{
+		HandleRoundsInfoCalled: func(roundsInfo data.RoundsInfo) error {
+			roundsInfoWasCalled = true
+			assert.Equal(t, roundsInfoData, roundsInfo)
+			return nil
+		},
+	}
+	facade, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	err = facade.HandleRoundsInfo(roundsInfoData)
+	require.Nil(t, err)
+
+	assert.True(t, roundsInfoWasCalled)
+}
+
 func TestServerHTTP(t *testing.T) {
 	t.Parallel()
 
@@ -201,3 +289,27 @@ func TestGetters(t *testing.T) {
 	assert.Equal(t, expuser, user)
 	assert.Equal(t, exppass, pass)
 }
+
+func TestGetShardsStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := []data.ShardStatus{
+		{
+			ShardID:   1,
+			LastNonce: 10,
+			Stalled:   true,
+		},
+	}
+
+	args := createMockFacadeArgs()
+	args.ShardsMonitor = &mocks.ShardsMonitorStub{
+		GetShardsStatusCalled: func() []data.ShardStatus {
+			return expectedStatus
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	require.Equal(t, expectedStatus, f.GetShardsStatus())
+}
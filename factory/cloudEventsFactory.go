@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
+)
+
+// CreateEnvelopeHandler creates the component which wraps the outgoing messages in CloudEvents envelopes
+func CreateEnvelopeHandler(config config.CloudEventsConfig, marshaller marshal.Marshalizer) (cloudevents.EnvelopeHandler, error) {
+	if !config.Enabled {
+		return &disabled.EnvelopeHandler{}, nil
+	}
+
+	args := cloudevents.ArgsEnvelopeHandler{
+		Config:     config,
+		Marshaller: marshaller,
+	}
+
+	return cloudevents.NewEnvelopeHandler(args)
+}
@@ -9,21 +9,27 @@ import (
 )
 
 // CreateHub creates a common hub component
-func CreateHub(apiType string) (dispatcher.Hub, error) {
+func CreateHub(
+	apiType string,
+	deliveryTracker common.DeliveryTracker,
+	txCompletionWatcher common.TxCompletionWatcher,
+) (dispatcher.Hub, error) {
 	switch apiType {
 	case common.MessageQueuePublisherType:
 		return &disabled.Hub{}, nil
 	case common.WSPublisherType:
-		return createHub()
+		return createHub(deliveryTracker, txCompletionWatcher)
 	default:
 		return nil, common.ErrInvalidAPIType
 	}
 }
 
-func createHub() (dispatcher.Hub, error) {
+func createHub(deliveryTracker common.DeliveryTracker, txCompletionWatcher common.TxCompletionWatcher) (dispatcher.Hub, error) {
 	args := hub.ArgsCommonHub{
-		Filter:             filters.NewDefaultFilter(),
-		SubscriptionMapper: dispatcher.NewSubscriptionMapper(),
+		Filter:              filters.NewDefaultFilter(),
+		SubscriptionMapper:  dispatcher.NewSubscriptionMapper(),
+		DeliveryTracker:     deliveryTracker,
+		TxCompletionWatcher: txCompletionWatcher,
 	}
 	return hub.NewCommonHub(args)
 }
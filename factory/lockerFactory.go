@@ -1,26 +1,94 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/disabled"
+	"github.com/multiversx/mx-chain-notifier-go/locker"
+	"github.com/multiversx/mx-chain-notifier-go/process"
 	"github.com/multiversx/mx-chain-notifier-go/redis"
 )
 
 // CreateLockService creates lock service component based on config
-func CreateLockService(checkDuplicates bool, config config.RedisConfig) (redis.LockService, error) {
+func CreateLockService(checkDuplicates bool, config config.MainConfig) (redis.LockService, error) {
 	if !checkDuplicates {
 		return disabled.NewDisabledRedlockWrapper(), nil
 	}
 
+	switch config.General.LockerType {
+	// redis is the default locker type, for backwards compatibility
+	case common.RedisLockerType, "":
+		return createRedisLockService(config.Redis)
+	case common.InMemoryLockerType:
+		return createInMemoryLockService(config.InMemoryLocker)
+	default:
+		return nil, common.ErrInvalidLockerType
+	}
+}
+
+// CreateDeliveryTracker creates the component which commits the locker keys after the events are delivered
+func CreateDeliveryTracker(
+	checkDuplicates bool,
+	lockService redis.LockService,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (common.DeliveryTracker, error) {
+	if !checkDuplicates {
+		return &disabled.DeliveryTracker{}, nil
+	}
+
+	args := process.ArgsDeliveryTracker{
+		Locker:               lockService,
+		StatusMetricsHandler: statusMetricsHandler,
+	}
+
+	return process.NewDeliveryTracker(args)
+}
+
+// CreateLeaderElector creates the leader election component based on config
+func CreateLeaderElector(config config.MainConfig, statusMetricsHandler common.StatusMetricsHandler) (redis.LeaderElector, error) {
+	if !config.LeaderElection.Enabled {
+		return &disabled.LeaderElector{}, nil
+	}
+
+	redisClient, err := createRedisClient(config.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	args := redis.ArgsLeaderElector{
+		Client:               redisClient,
+		StatusMetricsHandler: statusMetricsHandler,
+		InstanceID:           config.LeaderElection.InstanceID,
+		LeaseKey:             config.LeaderElection.LeaseKey,
+		LeaseTTLInMs:         config.LeaderElection.LeaseTTLInMs,
+		RenewIntervalInMs:    config.LeaderElection.RenewIntervalInMs,
+	}
+
+	return redis.NewLeaderElector(args)
+}
+
+// GetLockerReservationTTL returns the time after which the reservations of the configured locker expire
+func GetLockerReservationTTL(config config.MainConfig) time.Duration {
+	reservationTTLInSec := config.Redis.ReservationTTLInSec
+	if config.General.LockerType == common.InMemoryLockerType {
+		reservationTTLInSec = config.InMemoryLocker.ReservationTTLInSec
+	}
+
+	return time.Second * time.Duration(reservationTTLInSec)
+}
+
+func createRedisLockService(config config.RedisConfig) (redis.LockService, error) {
 	redisClient, err := createRedisClient(config)
 	if err != nil {
 		return nil, err
 	}
 
 	redlockArgs := redis.ArgsRedlockWrapper{
-		Client:       redisClient,
-		TTLInMinutes: config.TTL,
+		Client:              redisClient,
+		TTLInMinutes:        config.TTL,
+		ReservationTTLInSec: config.ReservationTTLInSec,
 	}
 	lockService, err := redis.NewRedlockWrapper(redlockArgs)
 	if err != nil {
@@ -30,12 +98,25 @@ func CreateLockService(checkDuplicates bool, config config.RedisConfig) (redis.L
 	return lockService, nil
 }
 
-func createRedisClient(cfg config.RedisConfig) (redis.RedLockClient, error) {
+func createInMemoryLockService(config config.InMemoryLockerConfig) (redis.LockService, error) {
+	args := locker.ArgsInMemoryLocker{
+		Capacity:            config.Capacity,
+		TTLInMinutes:        config.TTLInMinutes,
+		ReservationTTLInSec: config.ReservationTTLInSec,
+		SnapshotFilePath:    config.SnapshotFilePath,
+	}
+
+	return locker.NewInMemoryLocker(args)
+}
+
+func createRedisClient(cfg config.RedisConfig) (redis.RedisClient, error) {
 	switch cfg.ConnectionType {
 	case common.RedisInstanceConnType:
 		return redis.CreateSimpleClient(cfg)
 	case common.RedisSentinelConnType:
 		return redis.CreateFailoverClient(cfg)
+	case common.RedisClusterConnType:
+		return redis.CreateClusterClient(cfg)
 	default:
 		return nil, common.ErrInvalidRedisConnType
 	}
@@ -1,35 +1,211 @@
 package factory
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/multiversx/mx-chain-communication-go/websocket"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/backfill"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/multiversx/mx-chain-notifier-go/process/decoder"
 	"github.com/multiversx/mx-chain-notifier-go/process/preprocess"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
 )
 
 var log = logger.GetOrCreate("factory")
 
-const bech32PubkeyConverterType = "bech32"
+const (
+	bech32PubkeyConverterType = "bech32"
+
+	// the watched transactions of the instances in the same leader election group are shared
+	watchedTxsKeySuffix = "_watched_txs"
+)
 
 // CreateEventsInterceptor will create the events interceptor
-func CreateEventsInterceptor(cfg config.GeneralConfig) (process.EventsInterceptor, error) {
+func CreateEventsInterceptor(cfg config.GeneralConfig, decoderConfig config.EventsDecoderConfig) (process.EventsInterceptor, error) {
 	pubKeyConverter, err := getPubKeyConverter(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	eventsDecoder, err := createEventsDecoder(decoderConfig, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	argsEventsInterceptor := process.ArgsEventsInterceptor{
 		PubKeyConverter: pubKeyConverter,
+		EventsDecoder:   eventsDecoder,
+		EnrichEvents:    cfg.EnrichEvents,
+		EnabledStreams:  cfg.EnabledStreams,
 	}
 
 	return process.NewEventsInterceptor(argsEventsInterceptor)
 }
 
+// CreateShardsMonitor will create the shards liveness monitor, if enabled
+func CreateShardsMonitor(
+	cfg config.MainConfig,
+	publisher process.Publisher,
+	statusMetricsHandler common.StatusMetricsHandler,
+	leaderElector process.LeaderElector,
+) (common.ShardsMonitor, error) {
+	if !cfg.ShardsMonitor.Enabled {
+		return &disabled.ShardsMonitor{}, nil
+	}
+
+	enabledStreams, err := common.NewEnabledStreams(cfg.General.EnabledStreams)
+	if err != nil {
+		return nil, err
+	}
+
+	argsShardsMonitor := process.ArgsShardsMonitor{
+		Publisher:            publisher,
+		StatusMetricsHandler: statusMetricsHandler,
+		LeaderElector:        leaderElector,
+		StallTimeout:         time.Second * time.Duration(cfg.ShardsMonitor.StallTimeoutInSec),
+		CheckInterval:        time.Second * time.Duration(cfg.ShardsMonitor.CheckIntervalInSec),
+		PublishStalledShards: enabledStreams.IsEnabled(common.ShardStalled),
+	}
+
+	return process.NewShardsMonitor(argsShardsMonitor)
+}
+
+// CreateBlocksBackfiller will create the blocks backfiller, if enabled
+func CreateBlocksBackfiller(
+	cfg config.MainConfig,
+	leaderElector backfill.LeaderElector,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (common.BlocksBackfiller, error) {
+	if !cfg.Backfill.Enabled {
+		return &disabled.BlocksBackfiller{}, nil
+	}
+
+	pubKeyConverter, err := getPubKeyConverter(cfg.General)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBackfiller := backfill.ArgsBackfiller{
+		Config:               cfg.Backfill,
+		LeaderElector:        leaderElector,
+		PubKeyConverter:      pubKeyConverter,
+		StatusMetricsHandler: statusMetricsHandler,
+	}
+
+	return backfill.NewBackfiller(argsBackfiller)
+}
+
+// CreateTxCompletionWatcher will create the tx completion watcher, if the tx completed stream is enabled
+func CreateTxCompletionWatcher(cfg config.MainConfig) (common.TxCompletionWatcher, error) {
+	enabledStreams, err := common.NewEnabledStreams(cfg.General.EnabledStreams)
+	if err != nil {
+		return nil, err
+	}
+	if !enabledStreams.IsEnabled(common.TxCompleted) {
+		return &disabled.TxCompletionWatcher{}, nil
+	}
+
+	registry, err := createWatchedTxsRegistry(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	argsTxCompletionWatcher := process.ArgsTxCompletionWatcher{
+		MaxWatchedTxs: cfg.TxCompletionWatcher.MaxWatchedTxs,
+		WatchExpiry:   time.Second * time.Duration(cfg.TxCompletionWatcher.WatchExpiryInSec),
+		Registry:      registry,
+	}
+
+	return process.NewTxCompletionWatcher(argsTxCompletionWatcher)
+}
+
+// createWatchedTxsRegistry shares the watched transactions via redis when leader election is
+// enabled, since the transactions might be registered on a follower instance
+func createWatchedTxsRegistry(cfg config.MainConfig) (process.WatchedTxsRegistry, error) {
+	if !cfg.LeaderElection.Enabled {
+		return &disabled.WatchedTxsRegistry{}, nil
+	}
+
+	redisClient, err := createRedisClient(cfg.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	args := redis.ArgsWatchedTxsRegistry{
+		Client: redisClient,
+		Key:    cfg.LeaderElection.LeaseKey + watchedTxsKeySuffix,
+	}
+
+	return redis.NewWatchedTxsRegistry(args)
+}
+
+func createEventsDecoder(decoderConfig config.EventsDecoderConfig, pubKeyConverter core.PubkeyConverter) (process.EventsDecoder, error) {
+	decoders := make([]decoder.EventDecoder, 0)
+
+	if decoderConfig.DecodeESDTEvents {
+		argsESDTDecoder := decoder.ArgsESDTDecoder{
+			PubKeyConverter: pubKeyConverter,
+		}
+		esdtDecoder, err := decoder.NewESDTDecoder(argsESDTDecoder)
+		if err != nil {
+			return nil, err
+		}
+		decoders = append(decoders, esdtDecoder)
+	}
+
+	if len(decoderConfig.ContractABIs) > 0 {
+		abiDecoder, err := createABIDecoder(decoderConfig.ContractABIs, pubKeyConverter)
+		if err != nil {
+			return nil, err
+		}
+		decoders = append(decoders, abiDecoder)
+	}
+
+	if len(decoders) == 0 {
+		return &disabled.EventsDecoder{}, nil
+	}
+
+	return decoder.NewEventDecodersChain(decoders...)
+}
+
+func createABIDecoder(contractABIs []config.ContractABIConfig, pubKeyConverter core.PubkeyConverter) (decoder.EventDecoder, error) {
+	abis := make(map[string]*decoder.ABI, len(contractABIs))
+	for _, contractABI := range contractABIs {
+		// the address is normalized, since it is matched against the encoded events addresses
+		pubKey, err := pubKeyConverter.Decode(contractABI.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for contract address %s", err, contractABI.Address)
+		}
+		address, err := pubKeyConverter.Encode(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		abi, err := decoder.LoadABIFile(contractABI.ABIFilePath)
+		if err != nil {
+			return nil, err
+		}
+		abis[address] = abi
+
+		log.Info("registered contract ABI", "address", address, "contract", abi.Name, "num events", len(abi.Events))
+	}
+
+	argsABIDecoder := decoder.ArgsABIDecoder{
+		PubKeyConverter: pubKeyConverter,
+		ContractABIs:    abis,
+	}
+
+	return decoder.NewABIDecoder(argsABIDecoder)
+}
+
 func getPubKeyConverter(cfg config.GeneralConfig) (core.PubkeyConverter, error) {
 	switch cfg.AddressConverter.Type {
 	case bech32PubkeyConverterType:
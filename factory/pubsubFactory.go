@@ -2,8 +2,10 @@ package factory
 
 import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/process"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
@@ -15,10 +17,14 @@ func CreatePublisher(
 	config config.MainConfig,
 	marshaller marshal.Marshalizer,
 	commonHub dispatcher.Hub,
+	statusMetricsHandler common.StatusMetricsHandler,
+	envelopeHandler cloudevents.EnvelopeHandler,
+	deliveryTracker common.DeliveryTracker,
+	fencingTokenHandler rabbitmq.FencingTokenHandler,
 ) (process.Publisher, error) {
 	switch apiType {
 	case common.MessageQueuePublisherType:
-		return createRabbitMqPublisher(config.RabbitMQ, marshaller)
+		return createRabbitMqPublisher(config.RabbitMQ, config.General.EnabledStreams, marshaller, statusMetricsHandler, envelopeHandler, deliveryTracker, fencingTokenHandler)
 	case common.WSPublisherType:
 		return createWSPublisher(commonHub)
 	default:
@@ -26,16 +32,41 @@ func CreatePublisher(
 	}
 }
 
-func createRabbitMqPublisher(config config.RabbitMQConfig, marshaller marshal.Marshalizer) (rabbitmq.PublisherService, error) {
-	rabbitClient, err := rabbitmq.NewRabbitMQClient(config.Url)
+func createRabbitMqPublisher(
+	config config.RabbitMQConfig,
+	enabledStreams []string,
+	marshaller marshal.Marshalizer,
+	statusMetricsHandler common.StatusMetricsHandler,
+	envelopeHandler cloudevents.EnvelopeHandler,
+	deliveryTracker common.DeliveryTracker,
+	fencingTokenHandler rabbitmq.FencingTokenHandler,
+) (rabbitmq.PublisherService, error) {
+	outbox, err := createOutbox(config.Outbox, statusMetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	rabbitClientArgs := rabbitmq.ArgsRabbitMqClient{
+		Url:                  config.Url,
+		MaxInFlightMessages:  config.MaxInFlightMessages,
+		PublishTimeoutInSec:  getPublishTimeoutInSec(config),
+		StatusMetricsHandler: statusMetricsHandler,
+		DeliveryTracker:      deliveryTracker,
+		Outbox:               outbox,
+	}
+	rabbitClient, err := rabbitmq.NewRabbitMQClient(rabbitClientArgs)
 	if err != nil {
 		return nil, err
 	}
 
 	rabbitMqPublisherArgs := rabbitmq.ArgsRabbitMqPublisher{
-		Client:     rabbitClient,
-		Config:     config,
-		Marshaller: marshaller,
+		Client:              rabbitClient,
+		Config:              config,
+		Marshaller:          marshaller,
+		Outbox:              outbox,
+		EnabledStreams:      enabledStreams,
+		EnvelopeHandler:     envelopeHandler,
+		FencingTokenHandler: fencingTokenHandler,
 	}
 	rabbitPublisher, err := rabbitmq.NewRabbitMqPublisher(rabbitMqPublisherArgs)
 	if err != nil {
@@ -45,6 +76,33 @@ func createRabbitMqPublisher(config config.RabbitMQConfig, marshaller marshal.Ma
 	return process.NewPublisher(rabbitPublisher)
 }
 
+// getPublishTimeoutInSec returns the publish timeout only if the outbox is enabled. Otherwise,
+// publishing waits indefinitely while the broker is unavailable, so that no message is dropped
+func getPublishTimeoutInSec(config config.RabbitMQConfig) uint32 {
+	if !config.Outbox.Enabled {
+		return 0
+	}
+
+	return config.PublishTimeoutInSec
+}
+
+func createOutbox(
+	config config.RabbitMQOutboxConfig,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (rabbitmq.Outbox, error) {
+	if !config.Enabled {
+		return &disabled.Outbox{}, nil
+	}
+
+	argsDiskOutbox := rabbitmq.ArgsDiskOutbox{
+		Path:                 config.Path,
+		MaxSizeInMB:          config.MaxSizeInMB,
+		StatusMetricsHandler: statusMetricsHandler,
+	}
+
+	return rabbitmq.NewDiskOutbox(argsDiskOutbox)
+}
+
 func createWSPublisher(commonHub dispatcher.Hub) (process.Publisher, error) {
 	return process.NewPublisher(commonHub)
 }
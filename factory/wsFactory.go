@@ -1,10 +1,13 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-communication-go/websocket/data"
 	factoryHost "github.com/multiversx/mx-chain-communication-go/websocket/factory"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/disabled"
@@ -16,49 +19,115 @@ import (
 const (
 	readBufferSize  = 1024
 	writeBufferSize = 1024
+
+	defaultWSConnectorName = "default"
+	wsConnectorNamePrefix  = "connector"
 )
 
 // CreateWSHandler creates websocket handler component based on api type
-func CreateWSHandler(apiType string, wsDispatcher dispatcher.Dispatcher, marshaller marshal.Marshalizer) (dispatcher.WSHandler, error) {
+func CreateWSHandler(
+	apiType string,
+	wsDispatcher dispatcher.Dispatcher,
+	marshaller marshal.Marshalizer,
+	envelopeHandler cloudevents.EnvelopeHandler,
+) (dispatcher.WSHandler, error) {
 	switch apiType {
 	case common.MessageQueuePublisherType:
 		return &disabled.WSHandler{}, nil
 	case common.WSPublisherType:
-		return createWSHandler(wsDispatcher, marshaller)
+		return createWSHandler(wsDispatcher, marshaller, envelopeHandler)
 	default:
 		return nil, common.ErrInvalidAPIType
 	}
 }
 
-func createWSHandler(wsDispatcher dispatcher.Dispatcher, marshaller marshal.Marshalizer) (dispatcher.WSHandler, error) {
+func createWSHandler(
+	wsDispatcher dispatcher.Dispatcher,
+	marshaller marshal.Marshalizer,
+	envelopeHandler cloudevents.EnvelopeHandler,
+) (dispatcher.WSHandler, error) {
 	upgrader, err := ws.NewWSUpgraderWrapper(readBufferSize, writeBufferSize)
 	if err != nil {
 		return nil, err
 	}
 
 	args := ws.ArgsWebSocketProcessor{
-		Dispatcher: wsDispatcher,
-		Upgrader:   upgrader,
-		Marshaller: marshaller,
+		Dispatcher:      wsDispatcher,
+		Upgrader:        upgrader,
+		Marshaller:      marshaller,
+		EnvelopeHandler: envelopeHandler,
 	}
 	return ws.NewWebSocketProcessor(args)
 }
 
-// CreateWSObserverConnector will create the web socket connector for observer node communication
-func CreateWSObserverConnector(
-	config config.WebSocketConfig,
+// CreateWSObserverConnectors will create the web socket connectors for observer nodes communication,
+// one for each enabled connector config. The WebSocketConnector section is kept for backwards compatibility,
+// being used along with the WebSocketConnectors list
+func CreateWSObserverConnectors(
+	mainConfig config.MainConfig,
 	facade process.EventsFacadeHandler,
-) (process.WSClient, error) {
-	if config.Enabled {
-		return createWsObsConnector(config, facade)
+	statusMetricsHandler common.StatusMetricsHandler,
+) ([]process.WSClient, error) {
+	connectorsConfigs, err := getEnabledWSConnectorsConfigs(mainConfig)
+	if err != nil {
+		return nil, err
 	}
 
-	return &disabled.WSHandler{}, nil
+	connectors := make([]process.WSClient, 0, len(connectorsConfigs))
+	for _, connectorConfig := range connectorsConfigs {
+		connector, errCreate := createWsObsConnector(connectorConfig, facade, statusMetricsHandler)
+		if errCreate != nil {
+			closeWSConnectors(connectors)
+			return nil, fmt.Errorf("%w for connector %s", errCreate, connectorConfig.Name)
+		}
+
+		log.Info("created observer websocket connector",
+			"name", connectorConfig.Name,
+			"url", connectorConfig.URL,
+			"mode", connectorConfig.Mode,
+		)
+		connectors = append(connectors, connector)
+	}
+
+	return connectors, nil
+}
+
+func getEnabledWSConnectorsConfigs(mainConfig config.MainConfig) ([]config.WebSocketConfig, error) {
+	configs := make([]config.WebSocketConfig, 0, len(mainConfig.WebSocketConnectors)+1)
+	if mainConfig.WebSocketConnector.Enabled {
+		connectorConfig := mainConfig.WebSocketConnector
+		if connectorConfig.Name == "" {
+			connectorConfig.Name = defaultWSConnectorName
+		}
+		configs = append(configs, connectorConfig)
+	}
+
+	for index, connectorConfig := range mainConfig.WebSocketConnectors {
+		if !connectorConfig.Enabled {
+			continue
+		}
+		if connectorConfig.Name == "" {
+			connectorConfig.Name = fmt.Sprintf("%s%d", wsConnectorNamePrefix, index)
+		}
+		configs = append(configs, connectorConfig)
+	}
+
+	names := make(map[string]struct{})
+	for _, connectorConfig := range configs {
+		_, exists := names[connectorConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", common.ErrDuplicatedConnectorName, connectorConfig.Name)
+		}
+		names[connectorConfig.Name] = struct{}{}
+	}
+
+	return configs, nil
 }
 
 func createWsObsConnector(
 	config config.WebSocketConfig,
 	facade process.EventsFacadeHandler,
+	statusMetricsHandler common.StatusMetricsHandler,
 ) (process.WSClient, error) {
 	marshaller, err := marshalFactory.NewMarshalizer(config.DataMarshallerType)
 	if err != nil {
@@ -75,7 +144,16 @@ func createWsObsConnector(
 		return nil, err
 	}
 
-	err = host.SetPayloadHandler(payloadHandler)
+	connectorPayloadHandler, err := process.NewConnectorPayloadHandler(process.ArgsConnectorPayloadHandler{
+		ConnectorName:        config.Name,
+		PayloadHandler:       payloadHandler,
+		StatusMetricsHandler: statusMetricsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = host.SetPayloadHandler(connectorPayloadHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +161,15 @@ func createWsObsConnector(
 	return host, nil
 }
 
+func closeWSConnectors(connectors []process.WSClient) {
+	for _, connector := range connectors {
+		err := connector.Close()
+		if err != nil {
+			log.Warn("could not close observer websocket connector", "error", err)
+		}
+	}
+}
+
 func createWsHost(wsConfig config.WebSocketConfig, wsMarshaller marshal.Marshalizer) (factoryHost.FullDuplexHost, error) {
 	return factoryHost.CreateWebSocketHost(factoryHost.ArgsWebSocketHost{
 		WebSocketConfig: data.WebSocketConfig{
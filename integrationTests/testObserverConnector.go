@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/factory"
+	"github.com/multiversx/mx-chain-notifier-go/metrics"
 )
 
 // CreateObserverConnector will create observer connector component
@@ -39,6 +40,7 @@ func CreateObserverConnector(facade shared.FacadeHandler, connType string, apiTy
 func newTestWSServer(facade shared.FacadeHandler, marshaller marshal.Marshalizer) (ObserverConnector, error) {
 	port := getRandomPort()
 	conf := config.WebSocketConfig{
+		Name:                    "observer",
 		Enabled:                 true,
 		URL:                     "localhost:" + fmt.Sprintf("%d", port),
 		WithAcknowledge:         true,
@@ -49,7 +51,10 @@ func newTestWSServer(facade shared.FacadeHandler, marshaller marshal.Marshalizer
 		DataMarshallerType:      "json",
 	}
 
-	_, err := factory.CreateWSObserverConnector(conf, facade)
+	mainConfig := config.MainConfig{
+		WebSocketConnectors: []config.WebSocketConfig{conf},
+	}
+	_, err := factory.CreateWSObserverConnectors(mainConfig, facade, metrics.NewStatusMetrics())
 	if err != nil {
 		return nil, err
 	}
@@ -374,8 +374,9 @@ func TestNotifierWithWebsockets_TxsEvents(t *testing.T) {
 		},
 	}
 	expBlockTxs := &data.BlockTxs{
-		Hash: hex.EncodeToString(blockHash),
-		Txs:  expTxs,
+		Hash:    hex.EncodeToString(blockHash),
+		ShardID: 1,
+		Txs:     expTxs,
 	}
 
 	wg := &sync.WaitGroup{}
@@ -457,8 +458,9 @@ func TestNotifierWithWebsockets_ScrsEvents(t *testing.T) {
 		},
 	}
 	expBlockScrs := &data.BlockScrs{
-		Hash: hex.EncodeToString(blockHash),
-		Scrs: expScrs,
+		Hash:    hex.EncodeToString(blockHash),
+		ShardID: 1,
+		Scrs:    expScrs,
 	}
 
 	wg := &sync.WaitGroup{}
@@ -590,8 +592,9 @@ func testNotifierWithWebsockets_AllEvents(t *testing.T, observerType string) {
 		},
 	}
 	blockTxs := &data.BlockTxs{
-		Hash: hex.EncodeToString(blockHash),
-		Txs:  expTxs,
+		Hash:    hex.EncodeToString(blockHash),
+		ShardID: 1,
+		Txs:     expTxs,
 	}
 
 	expScrs := map[string]*smartContractResult.SmartContractResult{
@@ -600,8 +603,9 @@ func testNotifierWithWebsockets_AllEvents(t *testing.T, observerType string) {
 		},
 	}
 	blockScrs := &data.BlockScrs{
-		Hash: hex.EncodeToString(blockHash),
-		Scrs: expScrs,
+		Hash:    hex.EncodeToString(blockHash),
+		ShardID: 1,
+		Scrs:    expScrs,
 	}
 
 	expTxsWithOrder := map[string]*outport.TxInfo{
@@ -0,0 +1,6 @@
+package locker
+
+import "errors"
+
+// ErrZeroValueReceived signals that a zero value has been received
+var ErrZeroValueReceived = errors.New("zero value received")
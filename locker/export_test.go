@@ -0,0 +1,8 @@
+package locker
+
+import "time"
+
+// SetGetTimeHandler -
+func (l *inMemoryLocker) SetGetTimeHandler(handler func() time.Time) {
+	l.getTimeHandler = handler
+}
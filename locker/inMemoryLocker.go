@@ -0,0 +1,207 @@
+package locker
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("locker")
+
+const snapshotFilePerm = 0644
+
+// ArgsInMemoryLocker defines the arguments needed for in memory locker creation
+type ArgsInMemoryLocker struct {
+	Capacity            uint32
+	TTLInMinutes        uint32
+	ReservationTTLInSec uint32
+	SnapshotFilePath    string
+}
+
+type lockEntry struct {
+	Key       string `json:"key"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// inMemoryLocker is a TTL bounded LRU cache of processed keys, which can be used
+// as lock service for a single notifier instance
+type inMemoryLocker struct {
+	mut              sync.Mutex
+	capacity         int
+	ttl              time.Duration
+	reservationTTL   time.Duration
+	snapshotFilePath string
+	entries          map[string]*list.Element
+	lru              *list.List
+	getTimeHandler   func() time.Time
+}
+
+// NewInMemoryLocker creates a new in memory lock service. If a snapshot file path is
+// provided, the entries saved on the previous shutdown are loaded.
+func NewInMemoryLocker(args ArgsInMemoryLocker) (*inMemoryLocker, error) {
+	if args.Capacity == 0 {
+		return nil, fmt.Errorf("%w for capacity", ErrZeroValueReceived)
+	}
+	if args.TTLInMinutes == 0 {
+		return nil, fmt.Errorf("%w for TTL in minutes", ErrZeroValueReceived)
+	}
+	if args.ReservationTTLInSec == 0 {
+		return nil, fmt.Errorf("%w for reservation TTL in seconds", ErrZeroValueReceived)
+	}
+
+	l := &inMemoryLocker{
+		capacity:         int(args.Capacity),
+		ttl:              time.Minute * time.Duration(args.TTLInMinutes),
+		reservationTTL:   time.Second * time.Duration(args.ReservationTTLInSec),
+		snapshotFilePath: args.SnapshotFilePath,
+		entries:          make(map[string]*list.Element),
+		lru:              list.New(),
+		getTimeHandler:   time.Now,
+	}
+
+	err := l.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// ReserveEvent returns true if the key was not set before, or if it expired,
+// reserving it until the reservation TTL expires
+func (l *inMemoryLocker) ReserveEvent(_ context.Context, key string) (bool, error) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	now := l.getTimeHandler()
+	element, exists := l.entries[key]
+	if exists {
+		entry := element.Value.(*lockEntry)
+		if now.UnixNano() < entry.ExpiresAt {
+			l.lru.MoveToFront(element)
+			return false, nil
+		}
+
+		l.removeElement(element)
+	}
+
+	l.addEntry(&lockEntry{
+		Key:       key,
+		ExpiresAt: now.Add(l.reservationTTL).UnixNano(),
+	})
+
+	return true, nil
+}
+
+// CommitEvent marks the key as processed, for the whole TTL
+func (l *inMemoryLocker) CommitEvent(_ context.Context, key string) error {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	expiresAt := l.getTimeHandler().Add(l.ttl).UnixNano()
+	element, exists := l.entries[key]
+	if exists {
+		element.Value.(*lockEntry).ExpiresAt = expiresAt
+		l.lru.MoveToFront(element)
+		return nil
+	}
+
+	l.addEntry(&lockEntry{
+		Key:       key,
+		ExpiresAt: expiresAt,
+	})
+
+	return nil
+}
+
+func (l *inMemoryLocker) addEntry(entry *lockEntry) {
+	l.entries[entry.Key] = l.lru.PushFront(entry)
+
+	for l.lru.Len() > l.capacity {
+		l.removeElement(l.lru.Back())
+	}
+}
+
+func (l *inMemoryLocker) removeElement(element *list.Element) {
+	l.lru.Remove(element)
+	delete(l.entries, element.Value.(*lockEntry).Key)
+}
+
+// HasConnection returns true, since there is no external dependency
+func (l *inMemoryLocker) HasConnection(_ context.Context) bool {
+	return true
+}
+
+func (l *inMemoryLocker) loadSnapshot() error {
+	if len(l.snapshotFilePath) == 0 {
+		return nil
+	}
+
+	snapshotBytes, err := os.ReadFile(l.snapshotFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*lockEntry, 0)
+	err = json.Unmarshal(snapshotBytes, &entries)
+	if err != nil {
+		return err
+	}
+
+	// the snapshot holds the entries from the least recently used one
+	now := l.getTimeHandler().UnixNano()
+	for _, entry := range entries {
+		if entry.ExpiresAt <= now {
+			continue
+		}
+		l.addEntry(entry)
+	}
+
+	log.Info("loaded in memory locker snapshot", "num entries", l.lru.Len())
+
+	return nil
+}
+
+// Close will save the not expired entries to the snapshot file, if configured
+func (l *inMemoryLocker) Close() error {
+	if len(l.snapshotFilePath) == 0 {
+		return nil
+	}
+
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	now := l.getTimeHandler().UnixNano()
+	entries := make([]*lockEntry, 0, l.lru.Len())
+	for element := l.lru.Back(); element != nil; element = element.Prev() {
+		entry := element.Value.(*lockEntry)
+		if entry.ExpiresAt <= now {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	snapshotBytes, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("saving in memory locker snapshot", "num entries", len(entries))
+
+	return os.WriteFile(l.snapshotFilePath, snapshotBytes, snapshotFilePerm)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (l *inMemoryLocker) IsInterfaceNil() bool {
+	return l == nil
+}
@@ -0,0 +1,237 @@
+package locker_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/locker"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsInMemoryLocker() locker.ArgsInMemoryLocker {
+	return locker.ArgsInMemoryLocker{
+		Capacity:            10,
+		TTLInMinutes:        30,
+		ReservationTTLInSec: 60,
+	}
+}
+
+func TestNewInMemoryLocker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero capacity", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInMemoryLocker()
+		args.Capacity = 0
+
+		l, err := locker.NewInMemoryLocker(args)
+		require.True(t, check.IfNil(l))
+		require.True(t, errors.Is(err, locker.ErrZeroValueReceived))
+	})
+
+	t.Run("zero ttl", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInMemoryLocker()
+		args.TTLInMinutes = 0
+
+		l, err := locker.NewInMemoryLocker(args)
+		require.True(t, check.IfNil(l))
+		require.True(t, errors.Is(err, locker.ErrZeroValueReceived))
+	})
+
+	t.Run("zero reservation ttl", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInMemoryLocker()
+		args.ReservationTTLInSec = 0
+
+		l, err := locker.NewInMemoryLocker(args)
+		require.True(t, check.IfNil(l))
+		require.True(t, errors.Is(err, locker.ErrZeroValueReceived))
+	})
+
+	t.Run("invalid snapshot file", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInMemoryLocker()
+		args.SnapshotFilePath = filepath.Join(t.TempDir(), "snapshot.json")
+		err := os.WriteFile(args.SnapshotFilePath, []byte("invalid"), 0644)
+		require.Nil(t, err)
+
+		l, err := locker.NewInMemoryLocker(args)
+		require.True(t, check.IfNil(l))
+		require.NotNil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		l, err := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(l))
+		require.True(t, l.HasConnection(context.Background()))
+	})
+}
+
+func TestInMemoryLocker_ReserveEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicated key should not be processed", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+
+		ok, err := l.ReserveEvent(context.Background(), "hash1")
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		ok, err = l.ReserveEvent(context.Background(), "hash1")
+		require.Nil(t, err)
+		require.False(t, ok)
+
+		ok, err = l.ReserveEvent(context.Background(), "revert_hash1")
+		require.Nil(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("not committed reservation should expire", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+
+		currentTime := time.Now()
+		l.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		ok, _ := l.ReserveEvent(context.Background(), "hash1")
+		require.True(t, ok)
+
+		currentTime = currentTime.Add(59 * time.Second)
+		ok, _ = l.ReserveEvent(context.Background(), "hash1")
+		require.False(t, ok)
+
+		currentTime = currentTime.Add(2 * time.Second)
+		ok, _ = l.ReserveEvent(context.Background(), "hash1")
+		require.True(t, ok)
+	})
+
+	t.Run("expired key should be processed again", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+
+		currentTime := time.Now()
+		l.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		ok, _ := l.ReserveEvent(context.Background(), "hash1")
+		require.True(t, ok)
+		err := l.CommitEvent(context.Background(), "hash1")
+		require.Nil(t, err)
+
+		currentTime = currentTime.Add(29 * time.Minute)
+		ok, _ = l.ReserveEvent(context.Background(), "hash1")
+		require.False(t, ok)
+
+		currentTime = currentTime.Add(2 * time.Minute)
+		ok, _ = l.ReserveEvent(context.Background(), "hash1")
+		require.True(t, ok)
+	})
+
+	t.Run("least recently used key should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInMemoryLocker()
+		args.Capacity = 2
+		l, _ := locker.NewInMemoryLocker(args)
+
+		_, _ = l.ReserveEvent(context.Background(), "hash1")
+		_, _ = l.ReserveEvent(context.Background(), "hash2")
+		// hash1 becomes the most recently used key
+		_, _ = l.ReserveEvent(context.Background(), "hash1")
+		_, _ = l.ReserveEvent(context.Background(), "hash3")
+
+		ok, _ := l.ReserveEvent(context.Background(), "hash1")
+		require.False(t, ok)
+		ok, _ = l.ReserveEvent(context.Background(), "hash2")
+		require.True(t, ok)
+	})
+}
+
+func TestInMemoryLocker_CommitEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("commit without reservation should mark key as processed", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+
+		err := l.CommitEvent(context.Background(), "hash1")
+		require.Nil(t, err)
+
+		ok, _ := l.ReserveEvent(context.Background(), "hash1")
+		require.False(t, ok)
+	})
+
+	t.Run("commit should extend reservation", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := locker.NewInMemoryLocker(createMockArgsInMemoryLocker())
+
+		currentTime := time.Now()
+		l.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		ok, _ := l.ReserveEvent(context.Background(), "hash1")
+		require.True(t, ok)
+
+		currentTime = currentTime.Add(30 * time.Second)
+		err := l.CommitEvent(context.Background(), "hash1")
+		require.Nil(t, err)
+
+		currentTime = currentTime.Add(10 * time.Minute)
+		ok, _ = l.ReserveEvent(context.Background(), "hash1")
+		require.False(t, ok)
+	})
+}
+
+func TestInMemoryLocker_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsInMemoryLocker()
+	args.SnapshotFilePath = filepath.Join(t.TempDir(), "snapshot.json")
+
+	l, err := locker.NewInMemoryLocker(args)
+	require.Nil(t, err)
+
+	currentTime := time.Now()
+	l.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+	_ = l.CommitEvent(context.Background(), "hash1")
+	currentTime = currentTime.Add(20 * time.Minute)
+	_ = l.CommitEvent(context.Background(), "hash2")
+	currentTime = currentTime.Add(15 * time.Minute)
+
+	// hash1 is expired at close time
+	err = l.Close()
+	require.Nil(t, err)
+
+	l, err = locker.NewInMemoryLocker(args)
+	require.Nil(t, err)
+
+	ok, _ := l.ReserveEvent(context.Background(), "hash2")
+	require.False(t, ok)
+	ok, _ = l.ReserveEvent(context.Background(), "hash1")
+	require.True(t, ok)
+}
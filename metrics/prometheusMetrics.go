@@ -30,6 +30,28 @@ func requestsCounterMetric(metricName, endpoint string, count uint64) string {
 	return promMetricAsString(metricFamily)
 }
 
+func gaugeMetric(metricName, endpoint string, value uint64) string {
+	metricFamily := &dto.MetricFamily{
+		Name: proto.String(metricName),
+		Type: dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Label: []*dto.LabelPair{
+					{
+						Name:  proto.String("operation"),
+						Value: proto.String(endpoint),
+					},
+				},
+				Gauge: &dto.Gauge{
+					Value: proto.Float64(float64(value)),
+				},
+			},
+		},
+	}
+
+	return promMetricAsString(metricFamily)
+}
+
 func promMetricAsString(metric *dto.MetricFamily) string {
 	out := bytes.NewBuffer(make([]byte, 0))
 	_, err := expfmt.MetricFamilyToText(out, metric)
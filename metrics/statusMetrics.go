@@ -11,10 +11,12 @@ import (
 const (
 	numRequestsPromMetric       = "num_requests"
 	totalResponseTimePromMetric = "total_response_time"
+	gaugeValuePromMetric        = "gauge_value"
 )
 
 type statusMetrics struct {
 	operationMetrics    map[string]*data.EndpointMetricsResponse
+	gaugeMetrics        map[string]uint64
 	mutOperationMetrics sync.RWMutex
 }
 
@@ -22,6 +24,7 @@ type statusMetrics struct {
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
 		operationMetrics: make(map[string]*data.EndpointMetricsResponse),
+		gaugeMetrics:     make(map[string]uint64),
 	}
 }
 
@@ -46,6 +49,27 @@ func (sm *statusMetrics) AddRequest(path string, duration time.Duration) {
 	currentData.TotalResponseTime += duration
 }
 
+// SetGauge will set the current value for the provided gauge metric
+func (sm *statusMetrics) SetGauge(path string, value uint64) {
+	sm.mutOperationMetrics.Lock()
+	defer sm.mutOperationMetrics.Unlock()
+
+	sm.gaugeMetrics[path] = value
+}
+
+// GetGauges returns the gauge metrics map
+func (sm *statusMetrics) GetGauges() map[string]uint64 {
+	sm.mutOperationMetrics.RLock()
+	defer sm.mutOperationMetrics.RUnlock()
+
+	newMap := make(map[string]uint64)
+	for key, value := range sm.gaugeMetrics {
+		newMap[key] = value
+	}
+
+	return newMap
+}
+
 // GetAll returns the metrics map
 func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetricsResponse {
 	sm.mutOperationMetrics.RLock()
@@ -77,6 +101,10 @@ func (sm *statusMetrics) GetMetricsForPrometheus() string {
 		stringBuilder.WriteString(requestsCounterMetric(totalResponseTimePromMetric, endpointPath, uint64(endpointData.TotalResponseTime.Milliseconds())))
 	}
 
+	for gaugePath, gaugeValue := range sm.gaugeMetrics {
+		stringBuilder.WriteString(gaugeMetric(gaugeValuePromMetric, gaugePath, gaugeValue))
+	}
+
 	return stringBuilder.String()
 }
 
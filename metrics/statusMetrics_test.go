@@ -86,6 +86,20 @@ func TestStatusMetrics_AddRequestData(t *testing.T) {
 	})
 }
 
+func TestStatusMetrics_SetGauge(t *testing.T) {
+	t.Parallel()
+
+	sm := metrics.NewStatusMetrics()
+
+	testOp := "RabbitMQ-inflight"
+	sm.SetGauge(testOp, 10)
+	sm.SetGauge(testOp, 4)
+
+	res := sm.GetGauges()
+	require.Len(t, res, 1)
+	require.Equal(t, uint64(4), res[testOp])
+}
+
 func TestStatusMetrics_GetMetricsForPrometheus(t *testing.T) {
 	t.Parallel()
 
@@ -131,13 +145,15 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	for i := 0; i < numIterations; i++ {
 		go func(index int) {
-			switch index % 3 {
+			switch index % 4 {
 			case 0:
 				sm.AddRequest(fmt.Sprintf("op_%d", index%5), time.Hour*time.Duration(index))
 			case 1:
 				_ = sm.GetAll()
 			case 2:
 				_ = sm.GetMetricsForPrometheus()
+			case 3:
+				sm.SetGauge(fmt.Sprintf("op_%d", index%5), uint64(index))
 			}
 
 			wg.Done()
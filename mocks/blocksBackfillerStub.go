@@ -0,0 +1,40 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/common"
+
+// BlocksBackfillerStub -
+type BlocksBackfillerStub struct {
+	BlockPublishedCalled func(shardID uint32, nonce uint64)
+	BackfillCalled       func(eventsHandler common.SaveBlockEventsHandler) error
+	CloseCalled          func() error
+}
+
+// BlockPublished -
+func (bbs *BlocksBackfillerStub) BlockPublished(shardID uint32, nonce uint64) {
+	if bbs.BlockPublishedCalled != nil {
+		bbs.BlockPublishedCalled(shardID, nonce)
+	}
+}
+
+// Backfill -
+func (bbs *BlocksBackfillerStub) Backfill(eventsHandler common.SaveBlockEventsHandler) error {
+	if bbs.BackfillCalled != nil {
+		return bbs.BackfillCalled(eventsHandler)
+	}
+
+	return nil
+}
+
+// Close -
+func (bbs *BlocksBackfillerStub) Close() error {
+	if bbs.CloseCalled != nil {
+		return bbs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (bbs *BlocksBackfillerStub) IsInterfaceNil() bool {
+	return bbs == nil
+}
@@ -0,0 +1,36 @@
+package mocks
+
+// DeliveryTrackerStub -
+type DeliveryTrackerStub struct {
+	TrackDeliveryCalled   func(key string, messageIDs []string)
+	ConfirmDeliveryCalled func(messageID string)
+	CloseCalled           func() error
+}
+
+// TrackDelivery -
+func (dts *DeliveryTrackerStub) TrackDelivery(key string, messageIDs []string) {
+	if dts.TrackDeliveryCalled != nil {
+		dts.TrackDeliveryCalled(key, messageIDs)
+	}
+}
+
+// ConfirmDelivery -
+func (dts *DeliveryTrackerStub) ConfirmDelivery(messageID string) {
+	if dts.ConfirmDeliveryCalled != nil {
+		dts.ConfirmDeliveryCalled(messageID)
+	}
+}
+
+// Close -
+func (dts *DeliveryTrackerStub) Close() error {
+	if dts.CloseCalled != nil {
+		return dts.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (dts *DeliveryTrackerStub) IsInterfaceNil() bool {
+	return dts == nil
+}
@@ -28,8 +28,8 @@ func (d *DispatcherMock) GetID() uuid.UUID {
 }
 
 // PushEvents -
-func (d *DispatcherMock) PushEvents(events []data.Event) {
-	d.consumer.Receive(events)
+func (d *DispatcherMock) PushEvents(events data.BlockEvents) {
+	d.consumer.Receive(events.Events)
 }
 
 // BlockEvents -
@@ -52,6 +52,62 @@ func (d *DispatcherMock) TxsEvent(event data.BlockTxs) {
 func (d *DispatcherMock) ScrsEvent(event data.BlockScrs) {
 }
 
+// RewardsEvent -
+func (d *DispatcherMock) RewardsEvent(event data.BlockRewards) {
+}
+
+// ReceiptsEvent -
+func (d *DispatcherMock) ReceiptsEvent(event data.BlockReceipts) {
+}
+
+// InvalidTxsEvent -
+func (d *DispatcherMock) InvalidTxsEvent(event data.BlockInvalidTxs) {
+}
+
+// AlteredAccountsEvent -
+func (d *DispatcherMock) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
+}
+
+// BlockHeaderEvent -
+func (d *DispatcherMock) BlockHeaderEvent(event data.BlockHeader) {
+}
+
+// RoundsInfoEvent -
+func (d *DispatcherMock) RoundsInfoEvent(event data.RoundsInfo) {
+}
+
+// ValidatorsRatingEvent -
+func (d *DispatcherMock) ValidatorsRatingEvent(event data.ValidatorsRating) {
+}
+
+// ValidatorsPubKeysEvent -
+func (d *DispatcherMock) ValidatorsPubKeysEvent(event data.ValidatorsPubKeys) {
+}
+
+// EpochStartEvent -
+func (d *DispatcherMock) EpochStartEvent(event data.EpochStart) {
+}
+
+// TxCompletedEvent -
+func (d *DispatcherMock) TxCompletedEvent(event data.TxCompleted) {
+}
+
+// FailedExecutionsEvent -
+func (d *DispatcherMock) FailedExecutionsEvent(event data.BlockFailedExecutions) {
+}
+
+// GapDetectedEvent -
+func (d *DispatcherMock) GapDetectedEvent(event data.GapDetected) {
+}
+
+// RetractedEventsEvent -
+func (d *DispatcherMock) RetractedEventsEvent(event data.RetractedEvents) {
+}
+
+// ShardStalledEvent -
+func (d *DispatcherMock) ShardStalledEvent(event data.ShardStalled) {
+}
+
 // Subscribe -
 func (d *DispatcherMock) Subscribe(event data.SubscribeEvent) {
 	d.hub.Subscribe(event)
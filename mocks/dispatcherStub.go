@@ -7,13 +7,27 @@ import (
 
 // DispatcherStub implements dispatcher EventDispatcher interface
 type DispatcherStub struct {
-	GetIDCalled          func() uuid.UUID
-	PushEventsCalled     func(events []data.Event)
-	BlockEventsCalled    func(event data.BlockEventsWithOrder)
-	RevertEventCalled    func(event data.RevertBlock)
-	FinalizedEventCalled func(event data.FinalizedBlock)
-	TxsEventCalled       func(event data.BlockTxs)
-	ScrsEventCalled      func(event data.BlockScrs)
+	GetIDCalled                  func() uuid.UUID
+	PushEventsCalled             func(events data.BlockEvents)
+	BlockEventsCalled            func(event data.BlockEventsWithOrder)
+	RevertEventCalled            func(event data.RevertBlock)
+	FinalizedEventCalled         func(event data.FinalizedBlock)
+	TxsEventCalled               func(event data.BlockTxs)
+	ScrsEventCalled              func(event data.BlockScrs)
+	RewardsEventCalled           func(event data.BlockRewards)
+	ReceiptsEventCalled          func(event data.BlockReceipts)
+	InvalidTxsEventCalled        func(event data.BlockInvalidTxs)
+	AlteredAccountsEventCalled   func(event data.BlockAlteredAccounts)
+	BlockHeaderEventCalled       func(event data.BlockHeader)
+	RoundsInfoEventCalled        func(event data.RoundsInfo)
+	ValidatorsRatingEventCalled  func(event data.ValidatorsRating)
+	ValidatorsPubKeysEventCalled func(event data.ValidatorsPubKeys)
+	EpochStartEventCalled        func(event data.EpochStart)
+	TxCompletedEventCalled       func(event data.TxCompleted)
+	FailedExecutionsEventCalled  func(event data.BlockFailedExecutions)
+	GapDetectedEventCalled       func(event data.GapDetected)
+	RetractedEventsEventCalled   func(event data.RetractedEvents)
+	ShardStalledEventCalled      func(event data.ShardStalled)
 }
 
 // GetID -
@@ -26,7 +40,7 @@ func (d *DispatcherStub) GetID() uuid.UUID {
 }
 
 // PushEvents -
-func (d *DispatcherStub) PushEvents(events []data.Event) {
+func (d *DispatcherStub) PushEvents(events data.BlockEvents) {
 	if d.PushEventsCalled != nil {
 		d.PushEventsCalled(events)
 	}
@@ -66,3 +80,101 @@ func (d *DispatcherStub) ScrsEvent(event data.BlockScrs) {
 		d.ScrsEventCalled(event)
 	}
 }
+
+// RewardsEvent -
+func (d *DispatcherStub) RewardsEvent(event data.BlockRewards) {
+	if d.RewardsEventCalled != nil {
+		d.RewardsEventCalled(event)
+	}
+}
+
+// ReceiptsEvent -
+func (d *DispatcherStub) ReceiptsEvent(event data.BlockReceipts) {
+	if d.ReceiptsEventCalled != nil {
+		d.ReceiptsEventCalled(event)
+	}
+}
+
+// InvalidTxsEvent -
+func (d *DispatcherStub) InvalidTxsEvent(event data.BlockInvalidTxs) {
+	if d.InvalidTxsEventCalled != nil {
+		d.InvalidTxsEventCalled(event)
+	}
+}
+
+// AlteredAccountsEvent -
+func (d *DispatcherStub) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
+	if d.AlteredAccountsEventCalled != nil {
+		d.AlteredAccountsEventCalled(event)
+	}
+}
+
+// BlockHeaderEvent -
+func (d *DispatcherStub) BlockHeaderEvent(event data.BlockHeader) {
+	if d.BlockHeaderEventCalled != nil {
+		d.BlockHeaderEventCalled(event)
+	}
+}
+
+// RoundsInfoEvent -
+func (d *DispatcherStub) RoundsInfoEvent(event data.RoundsInfo) {
+	if d.RoundsInfoEventCalled != nil {
+		d.RoundsInfoEventCalled(event)
+	}
+}
+
+// ValidatorsRatingEvent -
+func (d *DispatcherStub) ValidatorsRatingEvent(event data.ValidatorsRating) {
+	if d.ValidatorsRatingEventCalled != nil {
+		d.ValidatorsRatingEventCalled(event)
+	}
+}
+
+// ValidatorsPubKeysEvent -
+func (d *DispatcherStub) ValidatorsPubKeysEvent(event data.ValidatorsPubKeys) {
+	if d.ValidatorsPubKeysEventCalled != nil {
+		d.ValidatorsPubKeysEventCalled(event)
+	}
+}
+
+// EpochStartEvent -
+func (d *DispatcherStub) EpochStartEvent(event data.EpochStart) {
+	if d.EpochStartEventCalled != nil {
+		d.EpochStartEventCalled(event)
+	}
+}
+
+// TxCompletedEvent -
+func (d *DispatcherStub) TxCompletedEvent(event data.TxCompleted) {
+	if d.TxCompletedEventCalled != nil {
+		d.TxCompletedEventCalled(event)
+	}
+}
+
+// FailedExecutionsEvent -
+func (d *DispatcherStub) FailedExecutionsEvent(event data.BlockFailedExecutions) {
+	if d.FailedExecutionsEventCalled != nil {
+		d.FailedExecutionsEventCalled(event)
+	}
+}
+
+// GapDetectedEvent -
+func (d *DispatcherStub) GapDetectedEvent(event data.GapDetected) {
+	if d.GapDetectedEventCalled != nil {
+		d.GapDetectedEventCalled(event)
+	}
+}
+
+// RetractedEventsEvent -
+func (d *DispatcherStub) RetractedEventsEvent(event data.RetractedEvents) {
+	if d.RetractedEventsEventCalled != nil {
+		d.RetractedEventsEventCalled(event)
+	}
+}
+
+// ShardStalledEvent -
+func (d *DispatcherStub) ShardStalledEvent(event data.ShardStalled) {
+	if d.ShardStalledEventCalled != nil {
+		d.ShardStalledEventCalled(event)
+	}
+}
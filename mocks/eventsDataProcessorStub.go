@@ -2,9 +2,12 @@ package mocks
 
 // EventsDataProcessorStub -
 type EventsDataProcessorStub struct {
-	SaveBlockCalled          func(marshalledData []byte) error
-	RevertIndexedBlockCalled func(marshalledData []byte) error
-	FinalizedBlockCalled     func(marshalledData []byte) error
+	SaveBlockCalled             func(marshalledData []byte) error
+	RevertIndexedBlockCalled    func(marshalledData []byte) error
+	FinalizedBlockCalled        func(marshalledData []byte) error
+	SaveRoundsInfoCalled        func(marshalledData []byte) error
+	SaveValidatorsRatingCalled  func(marshalledData []byte) error
+	SaveValidatorsPubKeysCalled func(marshalledData []byte) error
 }
 
 // SaveBlock -
@@ -34,6 +37,33 @@ func (stub *EventsDataProcessorStub) FinalizedBlock(marshalledData []byte) error
 	return nil
 }
 
+// SaveRoundsInfo -
+func (stub *EventsDataProcessorStub) SaveRoundsInfo(marshalledData []byte) error {
+	if stub.SaveRoundsInfoCalled != nil {
+		return stub.SaveRoundsInfoCalled(marshalledData)
+	}
+
+	return nil
+}
+
+// SaveValidatorsRating -
+func (stub *EventsDataProcessorStub) SaveValidatorsRating(marshalledData []byte) error {
+	if stub.SaveValidatorsRatingCalled != nil {
+		return stub.SaveValidatorsRatingCalled(marshalledData)
+	}
+
+	return nil
+}
+
+// SaveValidatorsPubKeys -
+func (stub *EventsDataProcessorStub) SaveValidatorsPubKeys(marshalledData []byte) error {
+	if stub.SaveValidatorsPubKeysCalled != nil {
+		return stub.SaveValidatorsPubKeysCalled(marshalledData)
+	}
+
+	return nil
+}
+
 // Close -
 func (stub *EventsDataProcessorStub) Close() error {
 	return nil
@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// EventsDecoderStub -
+type EventsDecoderStub struct {
+	DecodeEventCalled func(event data.Event) *data.DecodedEvent
+}
+
+// DecodeEvent -
+func (stub *EventsDecoderStub) DecodeEvent(event data.Event) *data.DecodedEvent {
+	if stub.DecodeEventCalled != nil {
+		return stub.DecodeEventCalled(event)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EventsDecoderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -4,9 +4,13 @@ import "github.com/multiversx/mx-chain-notifier-go/data"
 
 // EventsHandlerStub implements EventsHandler interface
 type EventsHandlerStub struct {
-	HandleSaveBlockEventsCalled func(allEvents data.ArgsSaveBlockData) error
-	HandleRevertEventsCalled    func(revertBlock data.RevertBlock)
-	HandleFinalizedEventsCalled func(finalizedBlock data.FinalizedBlock)
+	HandleSaveBlockEventsCalled   func(allEvents data.ArgsSaveBlockData) error
+	HandleRevertEventsCalled      func(revertBlock data.RevertBlock) error
+	HandleFinalizedEventsCalled   func(finalizedBlock data.FinalizedBlock) error
+	HandleRoundsInfoCalled        func(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRatingCalled  func(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeysCalled func(validatorsPubKeys data.ValidatorsPubKeys) error
+	CloseCalled                   func() error
 }
 
 // HandleSaveBlockEvents -
@@ -19,17 +23,57 @@ func (e *EventsHandlerStub) HandleSaveBlockEvents(events data.ArgsSaveBlockData)
 }
 
 // HandleRevertEvents -
-func (e *EventsHandlerStub) HandleRevertEvents(revertBlock data.RevertBlock) {
+func (e *EventsHandlerStub) HandleRevertEvents(revertBlock data.RevertBlock) error {
 	if e.HandleRevertEventsCalled != nil {
-		e.HandleRevertEventsCalled(revertBlock)
+		return e.HandleRevertEventsCalled(revertBlock)
 	}
+
+	return nil
 }
 
 // HandleFinalizedEvents -
-func (e *EventsHandlerStub) HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) {
+func (e *EventsHandlerStub) HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error {
 	if e.HandleFinalizedEventsCalled != nil {
-		e.HandleFinalizedEventsCalled(finalizedBlock)
+		return e.HandleFinalizedEventsCalled(finalizedBlock)
+	}
+
+	return nil
+}
+
+// HandleRoundsInfo -
+func (e *EventsHandlerStub) HandleRoundsInfo(roundsInfo data.RoundsInfo) error {
+	if e.HandleRoundsInfoCalled != nil {
+		return e.HandleRoundsInfoCalled(roundsInfo)
+	}
+
+	return nil
+}
+
+// HandleValidatorsRating -
+func (e *EventsHandlerStub) HandleValidatorsRating(validatorsRating data.ValidatorsRating) error {
+	if e.HandleValidatorsRatingCalled != nil {
+		return e.HandleValidatorsRatingCalled(validatorsRating)
 	}
+
+	return nil
+}
+
+// HandleValidatorsPubKeys -
+func (e *EventsHandlerStub) HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error {
+	if e.HandleValidatorsPubKeysCalled != nil {
+		return e.HandleValidatorsPubKeysCalled(validatorsPubKeys)
+	}
+
+	return nil
+}
+
+// Close -
+func (e *EventsHandlerStub) Close() error {
+	if e.CloseCalled != nil {
+		return e.CloseCalled()
+	}
+
+	return nil
 }
 
 // IsInterfaceNil -
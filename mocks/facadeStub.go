@@ -9,12 +9,26 @@ import (
 // FacadeStub implements FacadeHandler interface
 type FacadeStub struct {
 	HandlePushEventsCalled        func(events data.ArgsSaveBlockData) error
-	HandleRevertEventsCalled      func(events data.RevertBlock)
-	HandleFinalizedEventsCalled   func(events data.FinalizedBlock)
+	HandleRevertEventsCalled      func(events data.RevertBlock) error
+	HandleFinalizedEventsCalled   func(events data.FinalizedBlock) error
 	ServeCalled                   func(w http.ResponseWriter, r *http.Request)
 	GetConnectorUserAndPassCalled func() (string, string)
 	GetMetricsCalled              func() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheusCalled func() string
+	HandleRoundsInfoCalled        func(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRatingCalled  func(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeysCalled func(validatorsPubKeys data.ValidatorsPubKeys) error
+	WatchTxsCalled                func(txHashes []string) error
+	GetShardsStatusCalled         func() []data.ShardStatus
+}
+
+// WatchTxs -
+func (fs *FacadeStub) WatchTxs(txHashes []string) error {
+	if fs.WatchTxsCalled != nil {
+		return fs.WatchTxsCalled(txHashes)
+	}
+
+	return nil
 }
 
 // HandlePushEvents -
@@ -27,17 +41,48 @@ func (fs *FacadeStub) HandlePushEvents(events data.ArgsSaveBlockData) error {
 }
 
 // HandleRevertEvents -
-func (fs *FacadeStub) HandleRevertEvents(events data.RevertBlock) {
+func (fs *FacadeStub) HandleRevertEvents(events data.RevertBlock) error {
 	if fs.HandleRevertEventsCalled != nil {
-		fs.HandleRevertEventsCalled(events)
+		return fs.HandleRevertEventsCalled(events)
 	}
+
+	return nil
 }
 
 // HandleFinalizedEvents -
-func (fs *FacadeStub) HandleFinalizedEvents(events data.FinalizedBlock) {
+func (fs *FacadeStub) HandleFinalizedEvents(events data.FinalizedBlock) error {
 	if fs.HandleFinalizedEventsCalled != nil {
-		fs.HandleFinalizedEventsCalled(events)
+		return fs.HandleFinalizedEventsCalled(events)
+	}
+
+	return nil
+}
+
+// HandleRoundsInfo -
+func (fs *FacadeStub) HandleRoundsInfo(roundsInfo data.RoundsInfo) error {
+	if fs.HandleRoundsInfoCalled != nil {
+		return fs.HandleRoundsInfoCalled(roundsInfo)
+	}
+
+	return nil
+}
+
+// HandleValidatorsRating -
+func (fs *FacadeStub) HandleValidatorsRating(validatorsRating data.ValidatorsRating) error {
+	if fs.HandleValidatorsRatingCalled != nil {
+		return fs.HandleValidatorsRatingCalled(validatorsRating)
+	}
+
+	return nil
+}
+
+// HandleValidatorsPubKeys -
+func (fs *FacadeStub) HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error {
+	if fs.HandleValidatorsPubKeysCalled != nil {
+		return fs.HandleValidatorsPubKeysCalled(validatorsPubKeys)
 	}
+
+	return nil
 }
 
 // ServeHTTP -
@@ -74,6 +119,15 @@ func (fs *FacadeStub) GetMetricsForPrometheus() string {
 	return ""
 }
 
+// GetShardsStatus -
+func (fs *FacadeStub) GetShardsStatus() []data.ShardStatus {
+	if fs.GetShardsStatusCalled != nil {
+		return fs.GetShardsStatusCalled()
+	}
+
+	return make([]data.ShardStatus, 0)
+}
+
 // IsInterfaceNil -
 func (fs *FacadeStub) IsInterfaceNil() bool {
 	return fs == nil
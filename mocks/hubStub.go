@@ -13,6 +13,20 @@ type HubStub struct {
 	PublishTxsCalled                  func(blockTxs data.BlockTxs)
 	PublishScrsCalled                 func(blockScrs data.BlockScrs)
 	PublishBlockEventsWithOrderCalled func(blockTxs data.BlockEventsWithOrder)
+	PublishRewardsCalled              func(blockRewards data.BlockRewards)
+	PublishReceiptsCalled             func(blockReceipts data.BlockReceipts)
+	PublishInvalidTxsCalled           func(blockInvalidTxs data.BlockInvalidTxs)
+	PublishAlteredAccountsCalled      func(alteredAccounts data.BlockAlteredAccounts)
+	PublishBlockHeaderCalled          func(blockHeader data.BlockHeader)
+	PublishRoundsInfoCalled           func(roundsInfo data.RoundsInfo)
+	PublishValidatorsRatingCalled     func(validatorsRating data.ValidatorsRating)
+	PublishValidatorsPubKeysCalled    func(validatorsPubKeys data.ValidatorsPubKeys)
+	PublishEpochStartCalled           func(epochStart data.EpochStart)
+	PublishTxCompletedCalled          func(txCompleted data.TxCompleted)
+	PublishFailedExecutionsCalled     func(failedExecutions data.BlockFailedExecutions)
+	PublishGapDetectedCalled          func(gapDetected data.GapDetected)
+	PublishRetractedEventsCalled      func(retractedEvents data.RetractedEvents)
+	PublishShardStalledCalled         func(shardStalled data.ShardStalled)
 	RegisterEventCalled               func(event dispatcher.EventDispatcher)
 	UnregisterEventCalled             func(event dispatcher.EventDispatcher)
 	SubscribeCalled                   func(event data.SubscribeEvent)
@@ -61,6 +75,104 @@ func (h *HubStub) PublishBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder
 	}
 }
 
+// PublishRewards -
+func (h *HubStub) PublishRewards(blockRewards data.BlockRewards) {
+	if h.PublishRewardsCalled != nil {
+		h.PublishRewardsCalled(blockRewards)
+	}
+}
+
+// PublishReceipts -
+func (h *HubStub) PublishReceipts(blockReceipts data.BlockReceipts) {
+	if h.PublishReceiptsCalled != nil {
+		h.PublishReceiptsCalled(blockReceipts)
+	}
+}
+
+// PublishInvalidTxs -
+func (h *HubStub) PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	if h.PublishInvalidTxsCalled != nil {
+		h.PublishInvalidTxsCalled(blockInvalidTxs)
+	}
+}
+
+// PublishAlteredAccounts -
+func (h *HubStub) PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+	if h.PublishAlteredAccountsCalled != nil {
+		h.PublishAlteredAccountsCalled(alteredAccounts)
+	}
+}
+
+// PublishBlockHeader -
+func (h *HubStub) PublishBlockHeader(blockHeader data.BlockHeader) {
+	if h.PublishBlockHeaderCalled != nil {
+		h.PublishBlockHeaderCalled(blockHeader)
+	}
+}
+
+// PublishRoundsInfo -
+func (h *HubStub) PublishRoundsInfo(roundsInfo data.RoundsInfo) {
+	if h.PublishRoundsInfoCalled != nil {
+		h.PublishRoundsInfoCalled(roundsInfo)
+	}
+}
+
+// PublishValidatorsRating -
+func (h *HubStub) PublishValidatorsRating(validatorsRating data.ValidatorsRating) {
+	if h.PublishValidatorsRatingCalled != nil {
+		h.PublishValidatorsRatingCalled(validatorsRating)
+	}
+}
+
+// PublishValidatorsPubKeys -
+func (h *HubStub) PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) {
+	if h.PublishValidatorsPubKeysCalled != nil {
+		h.PublishValidatorsPubKeysCalled(validatorsPubKeys)
+	}
+}
+
+// PublishEpochStart -
+func (h *HubStub) PublishEpochStart(epochStart data.EpochStart) {
+	if h.PublishEpochStartCalled != nil {
+		h.PublishEpochStartCalled(epochStart)
+	}
+}
+
+// PublishTxCompleted -
+func (h *HubStub) PublishTxCompleted(txCompleted data.TxCompleted) {
+	if h.PublishTxCompletedCalled != nil {
+		h.PublishTxCompletedCalled(txCompleted)
+	}
+}
+
+// PublishFailedExecutions -
+func (h *HubStub) PublishFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+	if h.PublishFailedExecutionsCalled != nil {
+		h.PublishFailedExecutionsCalled(failedExecutions)
+	}
+}
+
+// PublishGapDetected -
+func (h *HubStub) PublishGapDetected(gapDetected data.GapDetected) {
+	if h.PublishGapDetectedCalled != nil {
+		h.PublishGapDetectedCalled(gapDetected)
+	}
+}
+
+// PublishRetractedEvents -
+func (h *HubStub) PublishRetractedEvents(retractedEvents data.RetractedEvents) {
+	if h.PublishRetractedEventsCalled != nil {
+		h.PublishRetractedEventsCalled(retractedEvents)
+	}
+}
+
+// PublishShardStalled -
+func (h *HubStub) PublishShardStalled(shardStalled data.ShardStalled) {
+	if h.PublishShardStalledCalled != nil {
+		h.PublishShardStalledCalled(shardStalled)
+	}
+}
+
 // RegisterEvent -
 func (h *HubStub) RegisterEvent(event dispatcher.EventDispatcher) {
 	if h.RegisterEventCalled != nil {
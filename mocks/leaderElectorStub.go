@@ -0,0 +1,40 @@
+package mocks
+
+// LeaderElectorStub -
+type LeaderElectorStub struct {
+	IsLeaderCalled     func() bool
+	FencingTokenCalled func() uint64
+	CloseCalled        func() error
+}
+
+// IsLeader -
+func (les *LeaderElectorStub) IsLeader() bool {
+	if les.IsLeaderCalled != nil {
+		return les.IsLeaderCalled()
+	}
+
+	return true
+}
+
+// FencingToken -
+func (les *LeaderElectorStub) FencingToken() uint64 {
+	if les.FencingTokenCalled != nil {
+		return les.FencingTokenCalled()
+	}
+
+	return 0
+}
+
+// Close -
+func (les *LeaderElectorStub) Close() error {
+	if les.CloseCalled != nil {
+		return les.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (les *LeaderElectorStub) IsInterfaceNil() bool {
+	return les == nil
+}
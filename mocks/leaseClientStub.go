@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+	"time"
+)
+
+// LeaseClientStub -
+type LeaseClientStub struct {
+	AcquireLeaseCalled func(key string, value string, ttl time.Duration) (bool, error)
+	RenewLeaseCalled   func(key string, value string, ttl time.Duration) (bool, error)
+	ReleaseLeaseCalled func(key string, value string) error
+	IncrementCalled    func(key string) (int64, error)
+}
+
+// AcquireLease -
+func (lcs *LeaseClientStub) AcquireLease(_ context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	if lcs.AcquireLeaseCalled != nil {
+		return lcs.AcquireLeaseCalled(key, value, ttl)
+	}
+
+	return false, nil
+}
+
+// RenewLease -
+func (lcs *LeaseClientStub) RenewLease(_ context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	if lcs.RenewLeaseCalled != nil {
+		return lcs.RenewLeaseCalled(key, value, ttl)
+	}
+
+	return false, nil
+}
+
+// ReleaseLease -
+func (lcs *LeaseClientStub) ReleaseLease(_ context.Context, key string, value string) error {
+	if lcs.ReleaseLeaseCalled != nil {
+		return lcs.ReleaseLeaseCalled(key, value)
+	}
+
+	return nil
+}
+
+// Increment -
+func (lcs *LeaseClientStub) Increment(_ context.Context, key string) (int64, error) {
+	if lcs.IncrementCalled != nil {
+		return lcs.IncrementCalled(key)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (lcs *LeaseClientStub) IsInterfaceNil() bool {
+	return lcs == nil
+}
@@ -4,19 +4,30 @@ import "context"
 
 // LockerStub implements LockService interface
 type LockerStub struct {
-	IsEventProcessedCalled func(ctx context.Context, blockHash string) (bool, error)
-	HasConnectionCalled    func(ctx context.Context) bool
+	ReserveEventCalled  func(ctx context.Context, key string) (bool, error)
+	CommitEventCalled   func(ctx context.Context, key string) error
+	HasConnectionCalled func(ctx context.Context) bool
+	CloseCalled         func() error
 }
 
-// IsEventProcessed -
-func (ls *LockerStub) IsEventProcessed(ctx context.Context, blockHash string) (bool, error) {
-	if ls.IsEventProcessedCalled != nil {
-		return ls.IsEventProcessedCalled(ctx, blockHash)
+// ReserveEvent -
+func (ls *LockerStub) ReserveEvent(ctx context.Context, key string) (bool, error) {
+	if ls.ReserveEventCalled != nil {
+		return ls.ReserveEventCalled(ctx, key)
 	}
 
 	return false, nil
 }
 
+// CommitEvent -
+func (ls *LockerStub) CommitEvent(ctx context.Context, key string) error {
+	if ls.CommitEventCalled != nil {
+		return ls.CommitEventCalled(ctx, key)
+	}
+
+	return nil
+}
+
 // HasConnection -
 func (ls *LockerStub) HasConnection(ctx context.Context) bool {
 	if ls.HasConnectionCalled != nil {
@@ -26,6 +37,15 @@ func (ls *LockerStub) HasConnection(ctx context.Context) bool {
 	return false
 }
 
+// Close -
+func (ls *LockerStub) Close() error {
+	if ls.CloseCalled != nil {
+		return ls.CloseCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (ls *LockerStub) IsInterfaceNil() bool {
 	return ls == nil
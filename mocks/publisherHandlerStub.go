@@ -10,6 +10,20 @@ type PublisherHandlerStub struct {
 	PublishTxsCalled                  func(blockTxs data.BlockTxs)
 	PublishScrsCalled                 func(blockScrs data.BlockScrs)
 	PublishBlockEventsWithOrderCalled func(blockTxs data.BlockEventsWithOrder)
+	PublishRewardsCalled              func(blockRewards data.BlockRewards)
+	PublishReceiptsCalled             func(blockReceipts data.BlockReceipts)
+	PublishInvalidTxsCalled           func(blockInvalidTxs data.BlockInvalidTxs)
+	PublishAlteredAccountsCalled      func(alteredAccounts data.BlockAlteredAccounts)
+	PublishBlockHeaderCalled          func(blockHeader data.BlockHeader)
+	PublishRoundsInfoCalled           func(roundsInfo data.RoundsInfo)
+	PublishValidatorsRatingCalled     func(validatorsRating data.ValidatorsRating)
+	PublishValidatorsPubKeysCalled    func(validatorsPubKeys data.ValidatorsPubKeys)
+	PublishEpochStartCalled           func(epochStart data.EpochStart)
+	PublishTxCompletedCalled          func(txCompleted data.TxCompleted)
+	PublishFailedExecutionsCalled     func(failedExecutions data.BlockFailedExecutions)
+	PublishGapDetectedCalled          func(gapDetected data.GapDetected)
+	PublishRetractedEventsCalled      func(retractedEvents data.RetractedEvents)
+	PublishShardStalledCalled         func(shardStalled data.ShardStalled)
 	CloseCalled                       func() error
 }
 
@@ -55,6 +69,104 @@ func (p *PublisherHandlerStub) PublishBlockEventsWithOrder(blockTxs data.BlockEv
 	}
 }
 
+// PublishRewards -
+func (p *PublisherHandlerStub) PublishRewards(blockRewards data.BlockRewards) {
+	if p.PublishRewardsCalled != nil {
+		p.PublishRewardsCalled(blockRewards)
+	}
+}
+
+// PublishReceipts -
+func (p *PublisherHandlerStub) PublishReceipts(blockReceipts data.BlockReceipts) {
+	if p.PublishReceiptsCalled != nil {
+		p.PublishReceiptsCalled(blockReceipts)
+	}
+}
+
+// PublishInvalidTxs -
+func (p *PublisherHandlerStub) PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	if p.PublishInvalidTxsCalled != nil {
+		p.PublishInvalidTxsCalled(blockInvalidTxs)
+	}
+}
+
+// PublishAlteredAccounts -
+func (p *PublisherHandlerStub) PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+	if p.PublishAlteredAccountsCalled != nil {
+		p.PublishAlteredAccountsCalled(alteredAccounts)
+	}
+}
+
+// PublishBlockHeader -
+func (p *PublisherHandlerStub) PublishBlockHeader(blockHeader data.BlockHeader) {
+	if p.PublishBlockHeaderCalled != nil {
+		p.PublishBlockHeaderCalled(blockHeader)
+	}
+}
+
+// PublishRoundsInfo -
+func (p *PublisherHandlerStub) PublishRoundsInfo(roundsInfo data.RoundsInfo) {
+	if p.PublishRoundsInfoCalled != nil {
+		p.PublishRoundsInfoCalled(roundsInfo)
+	}
+}
+
+// PublishValidatorsRating -
+func (p *PublisherHandlerStub) PublishValidatorsRating(validatorsRating data.ValidatorsRating) {
+	if p.PublishValidatorsRatingCalled != nil {
+		p.PublishValidatorsRatingCalled(validatorsRating)
+	}
+}
+
+// PublishValidatorsPubKeys -
+func (p *PublisherHandlerStub) PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) {
+	if p.PublishValidatorsPubKeysCalled != nil {
+		p.PublishValidatorsPubKeysCalled(validatorsPubKeys)
+	}
+}
+
+// PublishEpochStart -
+func (p *PublisherHandlerStub) PublishEpochStart(epochStart data.EpochStart) {
+	if p.PublishEpochStartCalled != nil {
+		p.PublishEpochStartCalled(epochStart)
+	}
+}
+
+// PublishTxCompleted -
+func (p *PublisherHandlerStub) PublishTxCompleted(txCompleted data.TxCompleted) {
+	if p.PublishTxCompletedCalled != nil {
+		p.PublishTxCompletedCalled(txCompleted)
+	}
+}
+
+// PublishFailedExecutions -
+func (p *PublisherHandlerStub) PublishFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+	if p.PublishFailedExecutionsCalled != nil {
+		p.PublishFailedExecutionsCalled(failedExecutions)
+	}
+}
+
+// PublishGapDetected -
+func (p *PublisherHandlerStub) PublishGapDetected(gapDetected data.GapDetected) {
+	if p.PublishGapDetectedCalled != nil {
+		p.PublishGapDetectedCalled(gapDetected)
+	}
+}
+
+// PublishRetractedEvents -
+func (p *PublisherHandlerStub) PublishRetractedEvents(retractedEvents data.RetractedEvents) {
+	if p.PublishRetractedEventsCalled != nil {
+		p.PublishRetractedEventsCalled(retractedEvents)
+	}
+}
+
+// PublishShardStalled -
+func (p *PublisherHandlerStub) PublishShardStalled(shardStalled data.ShardStalled) {
+	if p.PublishShardStalledCalled != nil {
+		p.PublishShardStalledCalled(shardStalled)
+	}
+}
+
 // Close -
 func (p *PublisherHandlerStub) Close() error {
 	if p.CloseCalled != nil {
@@ -11,6 +11,20 @@ type PublisherStub struct {
 	BroadcastTxsCalled                  func(event data.BlockTxs)
 	BroadcastScrsCalled                 func(event data.BlockScrs)
 	BroadcastBlockEventsWithOrderCalled func(event data.BlockEventsWithOrder)
+	BroadcastRewardsCalled              func(event data.BlockRewards)
+	BroadcastReceiptsCalled             func(event data.BlockReceipts)
+	BroadcastInvalidTxsCalled           func(event data.BlockInvalidTxs)
+	BroadcastAlteredAccountsCalled      func(event data.BlockAlteredAccounts)
+	BroadcastBlockHeaderCalled          func(event data.BlockHeader)
+	BroadcastRoundsInfoCalled           func(event data.RoundsInfo)
+	BroadcastValidatorsRatingCalled     func(event data.ValidatorsRating)
+	BroadcastValidatorsPubKeysCalled    func(event data.ValidatorsPubKeys)
+	BroadcastEpochStartCalled           func(event data.EpochStart)
+	BroadcastTxCompletedCalled          func(event data.TxCompleted)
+	BroadcastFailedExecutionsCalled     func(event data.BlockFailedExecutions)
+	BroadcastGapDetectedCalled          func(event data.GapDetected)
+	BroadcastRetractedEventsCalled      func(event data.RetractedEvents)
+	BroadcastShardStalledCalled         func(event data.ShardStalled)
 	CloseCalled                         func() error
 }
 
@@ -65,6 +79,104 @@ func (ps *PublisherStub) BroadcastBlockEventsWithOrder(event data.BlockEventsWit
 	}
 }
 
+// BroadcastRewards -
+func (ps *PublisherStub) BroadcastRewards(event data.BlockRewards) {
+	if ps.BroadcastRewardsCalled != nil {
+		ps.BroadcastRewardsCalled(event)
+	}
+}
+
+// BroadcastReceipts -
+func (ps *PublisherStub) BroadcastReceipts(event data.BlockReceipts) {
+	if ps.BroadcastReceiptsCalled != nil {
+		ps.BroadcastReceiptsCalled(event)
+	}
+}
+
+// BroadcastInvalidTxs -
+func (ps *PublisherStub) BroadcastInvalidTxs(event data.BlockInvalidTxs) {
+	if ps.BroadcastInvalidTxsCalled != nil {
+		ps.BroadcastInvalidTxsCalled(event)
+	}
+}
+
+// BroadcastAlteredAccounts -
+func (ps *PublisherStub) BroadcastAlteredAccounts(event data.BlockAlteredAccounts) {
+	if ps.BroadcastAlteredAccountsCalled != nil {
+		ps.BroadcastAlteredAccountsCalled(event)
+	}
+}
+
+// BroadcastBlockHeader -
+func (ps *PublisherStub) BroadcastBlockHeader(event data.BlockHeader) {
+	if ps.BroadcastBlockHeaderCalled != nil {
+		ps.BroadcastBlockHeaderCalled(event)
+	}
+}
+
+// BroadcastRoundsInfo -
+func (ps *PublisherStub) BroadcastRoundsInfo(event data.RoundsInfo) {
+	if ps.BroadcastRoundsInfoCalled != nil {
+		ps.BroadcastRoundsInfoCalled(event)
+	}
+}
+
+// BroadcastValidatorsRating -
+func (ps *PublisherStub) BroadcastValidatorsRating(event data.ValidatorsRating) {
+	if ps.BroadcastValidatorsRatingCalled != nil {
+		ps.BroadcastValidatorsRatingCalled(event)
+	}
+}
+
+// BroadcastValidatorsPubKeys -
+func (ps *PublisherStub) BroadcastValidatorsPubKeys(event data.ValidatorsPubKeys) {
+	if ps.BroadcastValidatorsPubKeysCalled != nil {
+		ps.BroadcastValidatorsPubKeysCalled(event)
+	}
+}
+
+// BroadcastEpochStart -
+func (ps *PublisherStub) BroadcastEpochStart(event data.EpochStart) {
+	if ps.BroadcastEpochStartCalled != nil {
+		ps.BroadcastEpochStartCalled(event)
+	}
+}
+
+// BroadcastTxCompleted -
+func (ps *PublisherStub) BroadcastTxCompleted(event data.TxCompleted) {
+	if ps.BroadcastTxCompletedCalled != nil {
+		ps.BroadcastTxCompletedCalled(event)
+	}
+}
+
+// BroadcastFailedExecutions -
+func (ps *PublisherStub) BroadcastFailedExecutions(event data.BlockFailedExecutions) {
+	if ps.BroadcastFailedExecutionsCalled != nil {
+		ps.BroadcastFailedExecutionsCalled(event)
+	}
+}
+
+// BroadcastGapDetected -
+func (ps *PublisherStub) BroadcastGapDetected(event data.GapDetected) {
+	if ps.BroadcastGapDetectedCalled != nil {
+		ps.BroadcastGapDetectedCalled(event)
+	}
+}
+
+// BroadcastRetractedEvents -
+func (ps *PublisherStub) BroadcastRetractedEvents(event data.RetractedEvents) {
+	if ps.BroadcastRetractedEventsCalled != nil {
+		ps.BroadcastRetractedEventsCalled(event)
+	}
+}
+
+// BroadcastShardStalled -
+func (ps *PublisherStub) BroadcastShardStalled(event data.ShardStalled) {
+	if ps.BroadcastShardStalledCalled != nil {
+		ps.BroadcastShardStalledCalled(event)
+	}
+}
+
 // Close -
 func (ps *PublisherStub) Close() error {
 	if ps.CloseCalled != nil {
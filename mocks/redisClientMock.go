@@ -40,6 +40,16 @@ func (rc *RedisClientMock) SetEntry(_ context.Context, key string, value bool, t
 	return false, nil
 }
 
+// UpdateEntry -
+func (rc *RedisClientMock) UpdateEntry(_ context.Context, key string, value bool, _ time.Duration) error {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	rc.entries[key] = value
+
+	return nil
+}
+
 // GetEntries -
 func (rc *RedisClientMock) GetEntries() map[string]bool {
 	rc.mut.Lock()
@@ -8,6 +8,7 @@ import (
 // RedisClientStub -
 type RedisClientStub struct {
 	SetEntryCalled    func(key string, value bool, ttl time.Duration) (bool, error)
+	UpdateEntryCalled func(key string, value bool, ttl time.Duration) error
 	PingCalled        func() (string, error)
 	IsConnectedCalled func() bool
 }
@@ -21,6 +22,15 @@ func (rc *RedisClientStub) SetEntry(_ context.Context, key string, value bool, t
 	return false, nil
 }
 
+// UpdateEntry -
+func (rc *RedisClientStub) UpdateEntry(_ context.Context, key string, value bool, ttl time.Duration) error {
+	if rc.UpdateEntryCalled != nil {
+		return rc.UpdateEntryCalled(key, value, ttl)
+	}
+
+	return nil
+}
+
 // Ping -
 func (rc *RedisClientStub) Ping(_ context.Context) (string, error) {
 	if rc.PingCalled != nil {
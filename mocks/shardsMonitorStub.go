@@ -0,0 +1,40 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// ShardsMonitorStub -
+type ShardsMonitorStub struct {
+	BlockReceivedCalled   func(blockData *data.ArgsSaveBlockData)
+	GetShardsStatusCalled func() []data.ShardStatus
+	CloseCalled           func() error
+}
+
+// BlockReceived -
+func (sms *ShardsMonitorStub) BlockReceived(blockData *data.ArgsSaveBlockData) {
+	if sms.BlockReceivedCalled != nil {
+		sms.BlockReceivedCalled(blockData)
+	}
+}
+
+// GetShardsStatus -
+func (sms *ShardsMonitorStub) GetShardsStatus() []data.ShardStatus {
+	if sms.GetShardsStatusCalled != nil {
+		return sms.GetShardsStatusCalled()
+	}
+
+	return make([]data.ShardStatus, 0)
+}
+
+// Close -
+func (sms *ShardsMonitorStub) Close() error {
+	if sms.CloseCalled != nil {
+		return sms.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (sms *ShardsMonitorStub) IsInterfaceNil() bool {
+	return sms == nil
+}
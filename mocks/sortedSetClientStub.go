@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+)
+
+// SortedSetClientStub -
+type SortedSetClientStub struct {
+	AddToSortedSetCalled              func(key string, members map[string]float64) error
+	GetSortedSetMembersCalled         func(key string, minScore float64) (map[string]float64, error)
+	RemoveFromSortedSetCalled         func(key string, members []string) error
+	RemoveSortedSetMembersBelowCalled func(key string, maxScore float64) error
+}
+
+// AddToSortedSet -
+func (scs *SortedSetClientStub) AddToSortedSet(_ context.Context, key string, members map[string]float64) error {
+	if scs.AddToSortedSetCalled != nil {
+		return scs.AddToSortedSetCalled(key, members)
+	}
+
+	return nil
+}
+
+// GetSortedSetMembers -
+func (scs *SortedSetClientStub) GetSortedSetMembers(_ context.Context, key string, minScore float64) (map[string]float64, error) {
+	if scs.GetSortedSetMembersCalled != nil {
+		return scs.GetSortedSetMembersCalled(key, minScore)
+	}
+
+	return nil, nil
+}
+
+// RemoveFromSortedSet -
+func (scs *SortedSetClientStub) RemoveFromSortedSet(_ context.Context, key string, members []string) error {
+	if scs.RemoveFromSortedSetCalled != nil {
+		return scs.RemoveFromSortedSetCalled(key, members)
+	}
+
+	return nil
+}
+
+// RemoveSortedSetMembersBelow -
+func (scs *SortedSetClientStub) RemoveSortedSetMembersBelow(_ context.Context, key string, maxScore float64) error {
+	if scs.RemoveSortedSetMembersBelowCalled != nil {
+		return scs.RemoveSortedSetMembersBelowCalled(key, maxScore)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (scs *SortedSetClientStub) IsInterfaceNil() bool {
+	return scs == nil
+}
@@ -9,6 +9,7 @@ import (
 // StatusMetricsStub -
 type StatusMetricsStub struct {
 	AddRequestCalled              func(path string, duration time.Duration)
+	SetGaugeCalled                func(path string, value uint64)
 	GetAllCalled                  func() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheusCalled func() string
 }
@@ -20,6 +21,13 @@ func (s *StatusMetricsStub) AddRequest(path string, duration time.Duration) {
 	}
 }
 
+// SetGauge -
+func (s *StatusMetricsStub) SetGauge(path string, value uint64) {
+	if s.SetGaugeCalled != nil {
+		s.SetGaugeCalled(path, value)
+	}
+}
+
 // GetAll -
 func (s *StatusMetricsStub) GetAll() map[string]*data.EndpointMetricsResponse {
 	if s.GetAllCalled != nil {
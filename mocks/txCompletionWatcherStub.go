@@ -0,0 +1,42 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// TxCompletionWatcherStub -
+type TxCompletionWatcherStub struct {
+	WatchTxsCalled              func(txHashes []string) error
+	ProcessBlockCalled          func(blockData *data.InterceptorBlockData) []data.TxCompleted
+	ProcessFinalizedBlockCalled func(blockHash string) []data.TxCompleted
+}
+
+// WatchTxs -
+func (tws *TxCompletionWatcherStub) WatchTxs(txHashes []string) error {
+	if tws.WatchTxsCalled != nil {
+		return tws.WatchTxsCalled(txHashes)
+	}
+
+	return nil
+}
+
+// ProcessBlock -
+func (tws *TxCompletionWatcherStub) ProcessBlock(blockData *data.InterceptorBlockData) []data.TxCompleted {
+	if tws.ProcessBlockCalled != nil {
+		return tws.ProcessBlockCalled(blockData)
+	}
+
+	return nil
+}
+
+// ProcessFinalizedBlock -
+func (tws *TxCompletionWatcherStub) ProcessFinalizedBlock(blockHash string) []data.TxCompleted {
+	if tws.ProcessFinalizedBlockCalled != nil {
+		return tws.ProcessFinalizedBlockCalled(blockHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tws *TxCompletionWatcherStub) IsInterfaceNil() bool {
+	return tws == nil
+}
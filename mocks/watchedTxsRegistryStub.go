@@ -0,0 +1,42 @@
+package mocks
+
+import "time"
+
+// WatchedTxsRegistryStub -
+type WatchedTxsRegistryStub struct {
+	AddTxsCalled    func(txHashes []string, expiresAt time.Time) error
+	GetTxsCalled    func() (map[string]time.Time, error)
+	RemoveTxsCalled func(txHashes []string) error
+}
+
+// AddTxs -
+func (wrs *WatchedTxsRegistryStub) AddTxs(txHashes []string, expiresAt time.Time) error {
+	if wrs.AddTxsCalled != nil {
+		return wrs.AddTxsCalled(txHashes, expiresAt)
+	}
+
+	return nil
+}
+
+// GetTxs -
+func (wrs *WatchedTxsRegistryStub) GetTxs() (map[string]time.Time, error) {
+	if wrs.GetTxsCalled != nil {
+		return wrs.GetTxsCalled()
+	}
+
+	return nil, nil
+}
+
+// RemoveTxs -
+func (wrs *WatchedTxsRegistryStub) RemoveTxs(txHashes []string) error {
+	if wrs.RemoveTxsCalled != nil {
+		return wrs.RemoveTxsCalled(txHashes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (wrs *WatchedTxsRegistryStub) IsInterfaceNil() bool {
+	return wrs == nil
+}
@@ -7,12 +7,14 @@ import (
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/facade"
 	"github.com/multiversx/mx-chain-notifier-go/factory"
 	"github.com/multiversx/mx-chain-notifier-go/metrics"
 	"github.com/multiversx/mx-chain-notifier-go/process"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
 )
 
 var log = logger.GetOrCreate("notifierRunner")
@@ -41,39 +43,79 @@ func (nr *notifierRunner) Start() error {
 		return err
 	}
 
-	lockService, err := factory.CreateLockService(nr.configs.MainConfig.General.CheckDuplicates, nr.configs.MainConfig.Redis)
+	lockService, err := factory.CreateLockService(nr.configs.MainConfig.General.CheckDuplicates, nr.configs.MainConfig)
 	if err != nil {
 		return err
 	}
 
-	commonHub, err := factory.CreateHub(publisherType)
+	statusMetricsHandler := metrics.NewStatusMetrics()
+
+	deliveryTracker, err := factory.CreateDeliveryTracker(nr.configs.MainConfig.General.CheckDuplicates, lockService, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
 
-	publisher, err := factory.CreatePublisher(publisherType, nr.configs.MainConfig, externalMarshaller, commonHub)
+	leaderElector, err := factory.CreateLeaderElector(nr.configs.MainConfig, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
 
-	wsHandler, err := factory.CreateWSHandler(publisherType, commonHub, externalMarshaller)
+	txCompletionWatcher, err := factory.CreateTxCompletionWatcher(nr.configs.MainConfig)
 	if err != nil {
 		return err
 	}
 
-	statusMetricsHandler := metrics.NewStatusMetrics()
+	commonHub, err := factory.CreateHub(publisherType, deliveryTracker, txCompletionWatcher)
+	if err != nil {
+		return err
+	}
+
+	envelopeHandler, err := factory.CreateEnvelopeHandler(nr.configs.MainConfig.CloudEvents, externalMarshaller)
+	if err != nil {
+		return err
+	}
+
+	publisher, err := factory.CreatePublisher(publisherType, nr.configs.MainConfig, externalMarshaller, commonHub, statusMetricsHandler, envelopeHandler, deliveryTracker, leaderElector)
+	if err != nil {
+		return err
+	}
+
+	wsHandler, err := factory.CreateWSHandler(publisherType, commonHub, externalMarshaller, envelopeHandler)
+	if err != nil {
+		return err
+	}
+
+	eventsInterceptor, err := factory.CreateEventsInterceptor(nr.configs.MainConfig.General, nr.configs.MainConfig.EventsDecoder)
+	if err != nil {
+		return err
+	}
+
+	shardsMonitor, err := factory.CreateShardsMonitor(nr.configs.MainConfig, publisher, statusMetricsHandler, leaderElector)
+	if err != nil {
+		return err
+	}
 
-	eventsInterceptor, err := factory.CreateEventsInterceptor(nr.configs.MainConfig.General)
+	blocksBackfiller, err := factory.CreateBlocksBackfiller(nr.configs.MainConfig, leaderElector, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
 
 	argsEventsHandler := process.ArgsEventsHandler{
 		CheckDuplicates:      nr.configs.MainConfig.General.CheckDuplicates,
+		EnabledStreams:       nr.configs.MainConfig.General.EnabledStreams,
+		LockerRetryPolicy:    nr.configs.MainConfig.LockerRetryPolicy,
+		BlocksOrdering:       nr.configs.MainConfig.BlocksOrdering,
+		RetractedEvents:      nr.configs.MainConfig.RetractedEvents,
+		LeaderElection:       nr.configs.MainConfig.LeaderElection,
+		LockerReservationTTL: factory.GetLockerReservationTTL(nr.configs.MainConfig),
 		Locker:               lockService,
 		Publisher:            publisher,
 		StatusMetricsHandler: statusMetricsHandler,
 		EventsInterceptor:    eventsInterceptor,
+		DeliveryTracker:      deliveryTracker,
+		LeaderElector:        leaderElector,
+		TxCompletionWatcher:  txCompletionWatcher,
+		BlocksBackfiller:     blocksBackfiller,
 	}
 	eventsHandler, err := process.NewEventsHandler(argsEventsHandler)
 	if err != nil {
@@ -85,6 +127,8 @@ func (nr *notifierRunner) Start() error {
 		APIConfig:            nr.configs.MainConfig.ConnectorApi,
 		WSHandler:            wsHandler,
 		StatusMetricsHandler: statusMetricsHandler,
+		TxCompletionWatcher:  txCompletionWatcher,
+		ShardsMonitor:        shardsMonitor,
 	}
 	facade, err := facade.NewNotifierFacade(facadeArgs)
 	if err != nil {
@@ -96,12 +140,12 @@ func (nr *notifierRunner) Start() error {
 		return err
 	}
 
-	wsConnector, err := factory.CreateWSObserverConnector(nr.configs.MainConfig.WebSocketConnector, facade)
+	err = publisher.Run()
 	if err != nil {
 		return err
 	}
 
-	err = publisher.Run()
+	wsConnectors, err := factory.CreateWSObserverConnectors(nr.configs.MainConfig, facade, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
@@ -111,7 +155,16 @@ func (nr *notifierRunner) Start() error {
 		return err
 	}
 
-	err = waitForGracefulShutdown(webServer, publisher, wsConnector)
+	// the missed blocks are handled concurrently with the live blocks, so that the ingestion is
+	// not delayed, the blocks handled by both being filtered by the duplicates check
+	go func() {
+		errBackfill := blocksBackfiller.Backfill(eventsHandler)
+		if errBackfill != nil {
+			log.Error("could not backfill the missed blocks", "error", errBackfill)
+		}
+	}()
+
+	err = waitForGracefulShutdown(webServer, publisher, wsConnectors, shardsMonitor, blocksBackfiller, eventsHandler, leaderElector, deliveryTracker, lockService)
 	if err != nil {
 		return err
 	}
@@ -123,7 +176,13 @@ func (nr *notifierRunner) Start() error {
 func waitForGracefulShutdown(
 	server shared.WebServerHandler,
 	publisher rabbitmq.PublisherService,
-	wsConnector process.WSClient,
+	wsConnectors []process.WSClient,
+	shardsMonitor common.ShardsMonitor,
+	blocksBackfiller common.BlocksBackfiller,
+	eventsHandler process.EventsHandler,
+	leaderElector redis.LeaderElector,
+	deliveryTracker common.DeliveryTracker,
+	lockService process.LockService,
 ) error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
@@ -134,7 +193,24 @@ func waitForGracefulShutdown(
 		return err
 	}
 
-	err = wsConnector.Close()
+	for _, wsConnector := range wsConnectors {
+		err = wsConnector.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = shardsMonitor.Close()
+	if err != nil {
+		return err
+	}
+
+	err = blocksBackfiller.Close()
+	if err != nil {
+		return err
+	}
+
+	err = eventsHandler.Close()
 	if err != nil {
 		return err
 	}
@@ -144,5 +220,15 @@ func waitForGracefulShutdown(
 		return err
 	}
 
-	return nil
+	err = leaderElector.Close()
+	if err != nil {
+		return err
+	}
+
+	err = deliveryTracker.Close()
+	if err != nil {
+		return err
+	}
+
+	return lockService.Close()
 }
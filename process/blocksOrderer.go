@@ -0,0 +1,346 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const maxGapsCheckInterval = time.Second
+
+type shardBlocksBuffer struct {
+	lastNonce    uint64
+	hasLastNonce bool
+	blocks       map[uint64]*data.InterceptorBlockData
+	waitingSince time.Time
+}
+
+// delivery holds a block, or a gap, to be published once the buffers are unlocked
+type delivery struct {
+	blockData *data.InterceptorBlockData
+	gap       *data.GapDetected
+}
+
+// blocksOrderer delivers the blocks of each shard in nonce order. The blocks received ahead
+// of the next expected nonce are buffered, until the missing blocks are received. If the
+// missing blocks are not received before the gap timeout, or if the buffer is full, the gap
+// is reported and the buffered blocks are delivered. The gap timeouts are also checked
+// periodically, since the blocks of a shard might stop being received.
+// The deliveries are queued in order. If a block could not be published, it is kept at the
+// head of the queue, together with the following deliveries, and it is retried periodically.
+type blocksOrderer struct {
+	mut               sync.Mutex
+	maxBufferedBlocks int
+	gapTimeout        time.Duration
+	shards            map[uint32]*shardBlocksBuffer
+	pendingDeliveries []delivery
+	publishBlock      func(blockData *data.InterceptorBlockData) error
+	publishGap        func(gap data.GapDetected)
+	getTimeHandler    func() time.Time
+
+	// deliveryMut keeps the deliveries in order, while the buffers are unlocked
+	deliveryMut sync.Mutex
+
+	closeChan  chan struct{}
+	loopClosed chan struct{}
+}
+
+// newBlocksOrderer creates a new blocks orderer, which calls the provided handlers in order,
+// and it starts the gaps checking loop
+func newBlocksOrderer(
+	maxBufferedBlocks uint32,
+	gapTimeout time.Duration,
+	publishBlock func(blockData *data.InterceptorBlockData) error,
+	publishGap func(gap data.GapDetected),
+) *blocksOrderer {
+	bo := &blocksOrderer{
+		maxBufferedBlocks: int(maxBufferedBlocks),
+		gapTimeout:        gapTimeout,
+		shards:            make(map[uint32]*shardBlocksBuffer),
+		publishBlock:      publishBlock,
+		publishGap:        publishGap,
+		getTimeHandler:    time.Now,
+		closeChan:         make(chan struct{}),
+		loopClosed:        make(chan struct{}),
+	}
+
+	go bo.checkGapsLoop(getGapsCheckInterval(gapTimeout))
+
+	return bo
+}
+
+// getGapsCheckInterval returns the period of the gaps checking, so that a gap is reported
+// at most half of the gap timeout later
+func getGapsCheckInterval(gapTimeout time.Duration) time.Duration {
+	interval := gapTimeout / 2
+	if interval > maxGapsCheckInterval {
+		return maxGapsCheckInterval
+	}
+	if interval <= 0 {
+		return time.Millisecond
+	}
+
+	return interval
+}
+
+func (bo *blocksOrderer) checkGapsLoop(interval time.Duration) {
+	defer close(bo.loopClosed)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-bo.closeChan:
+			return
+		case <-ticker.C:
+			bo.mut.Lock()
+			bo.checkGaps()
+			bo.mut.Unlock()
+
+			err := bo.deliverPending()
+			if err != nil {
+				log.Warn("blocksOrderer: could not publish block, will retry", "error", err)
+			}
+		}
+	}
+}
+
+// addBlock delivers the provided block, or buffers it if the previous nonce was not delivered yet.
+// The block is rejected if the previous deliveries are still failing, and the delivery error is
+// returned, so that the block is received again.
+func (bo *blocksOrderer) addBlock(blockData *data.InterceptorBlockData) error {
+	err := bo.deliverPending()
+	if err != nil {
+		return err
+	}
+
+	bo.mut.Lock()
+
+	shardID := blockData.Header.GetShardID()
+	nonce := blockData.Header.GetNonce()
+	buffer := bo.getShardBuffer(shardID)
+
+	switch {
+	case !buffer.hasLastNonce:
+		buffer.lastNonce = nonce
+		buffer.hasLastNonce = true
+		bo.addBlockDelivery(blockData)
+	case nonce <= buffer.lastNonce:
+		// a block replacing a reverted one, or a block received again, is delivered right away
+		bo.addBlockDelivery(blockData)
+	case nonce == buffer.lastNonce+1:
+		buffer.lastNonce = nonce
+		bo.addBlockDelivery(blockData)
+		bo.publishConsecutiveBlocks(buffer)
+	default:
+		if len(buffer.blocks) == 0 {
+			buffer.waitingSince = bo.getTimeHandler()
+		}
+		buffer.blocks[nonce] = blockData
+
+		log.Debug("blocksOrderer: buffered out of order block",
+			"shard", shardID,
+			"nonce", nonce,
+			"expected nonce", buffer.lastNonce+1,
+		)
+	}
+
+	bo.checkGaps()
+	bo.mut.Unlock()
+
+	return bo.deliverPending()
+}
+
+func (bo *blocksOrderer) addBlockDelivery(blockData *data.InterceptorBlockData) {
+	bo.pendingDeliveries = append(bo.pendingDeliveries, delivery{blockData: blockData})
+}
+
+// deliverPending publishes, in order, the queued deliveries, without holding the buffers lock.
+// The deliveries are taken one by one, so that a block removed from the queue meanwhile is not
+// published. It stops at the first block which could not be published, which is kept at the
+// head of the queue.
+func (bo *blocksOrderer) deliverPending() error {
+	bo.deliveryMut.Lock()
+	defer bo.deliveryMut.Unlock()
+
+	for {
+		pending, ok := bo.popDelivery()
+		if !ok {
+			return nil
+		}
+
+		if pending.gap != nil {
+			bo.publishGap(*pending.gap)
+			continue
+		}
+
+		err := bo.publishBlock(pending.blockData)
+		if err != nil {
+			bo.mut.Lock()
+			bo.pendingDeliveries = append([]delivery{pending}, bo.pendingDeliveries...)
+			bo.mut.Unlock()
+
+			return err
+		}
+	}
+}
+
+func (bo *blocksOrderer) popDelivery() (delivery, bool) {
+	bo.mut.Lock()
+	defer bo.mut.Unlock()
+
+	if len(bo.pendingDeliveries) == 0 {
+		return delivery{}, false
+	}
+
+	pending := bo.pendingDeliveries[0]
+	bo.pendingDeliveries = bo.pendingDeliveries[1:]
+
+	return pending, true
+}
+
+// removeBlock drops the block with the provided hash, if it is buffered, or waiting to be
+// delivered. It returns true if the block was found.
+func (bo *blocksOrderer) removeBlock(hash string, shardID uint32, nonce uint64) bool {
+	bo.mut.Lock()
+	defer bo.mut.Unlock()
+
+	removed := false
+	buffer, ok := bo.shards[shardID]
+	if ok {
+		blockData, isBuffered := buffer.blocks[nonce]
+		if isBuffered && blockData.Hash == hash {
+			delete(buffer.blocks, nonce)
+			removed = true
+		}
+	}
+
+	deliveries := make([]delivery, 0, len(bo.pendingDeliveries))
+	for _, pending := range bo.pendingDeliveries {
+		if pending.blockData != nil && pending.blockData.Hash == hash {
+			removed = true
+			continue
+		}
+		deliveries = append(deliveries, pending)
+	}
+	bo.pendingDeliveries = deliveries
+
+	return removed
+}
+
+func (bo *blocksOrderer) getShardBuffer(shardID uint32) *shardBlocksBuffer {
+	buffer, ok := bo.shards[shardID]
+	if !ok {
+		buffer = &shardBlocksBuffer{
+			blocks: make(map[uint64]*data.InterceptorBlockData),
+		}
+		bo.shards[shardID] = buffer
+	}
+
+	return buffer
+}
+
+func (bo *blocksOrderer) publishConsecutiveBlocks(buffer *shardBlocksBuffer) {
+	published := false
+	for {
+		blockData, ok := buffer.blocks[buffer.lastNonce+1]
+		if !ok {
+			break
+		}
+
+		delete(buffer.blocks, buffer.lastNonce+1)
+		buffer.lastNonce++
+		bo.addBlockDelivery(blockData)
+		published = true
+	}
+
+	if published {
+		buffer.waitingSince = bo.getTimeHandler()
+	}
+}
+
+func (bo *blocksOrderer) checkGaps() {
+	shardIDs := make([]uint32, 0, len(bo.shards))
+	for shardID := range bo.shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		buffer := bo.shards[shardID]
+		for bo.shouldSkipGap(buffer) {
+			bo.skipGap(shardID, buffer)
+		}
+	}
+}
+
+func (bo *blocksOrderer) shouldSkipGap(buffer *shardBlocksBuffer) bool {
+	if len(buffer.blocks) == 0 {
+		return false
+	}
+	if len(buffer.blocks) >= bo.maxBufferedBlocks {
+		return true
+	}
+
+	return bo.getTimeHandler().Sub(buffer.waitingSince) >= bo.gapTimeout
+}
+
+func (bo *blocksOrderer) skipGap(shardID uint32, buffer *shardBlocksBuffer) {
+	nextNonce := getLowestNonce(buffer.blocks)
+	gap := data.GapDetected{
+		ID:            fmt.Sprintf("%d_%d_%d", shardID, buffer.lastNonce, nextNonce),
+		ShardID:       shardID,
+		LastNonce:     buffer.lastNonce,
+		NextNonce:     nextNonce,
+		MissingNonces: nextNonce - buffer.lastNonce - 1,
+	}
+
+	log.Warn("blocksOrderer: nonce gap detected",
+		"shard", shardID,
+		"last nonce", gap.LastNonce,
+		"next nonce", gap.NextNonce,
+	)
+
+	bo.pendingDeliveries = append(bo.pendingDeliveries, delivery{gap: &gap})
+
+	buffer.lastNonce = nextNonce - 1
+	bo.publishConsecutiveBlocks(buffer)
+}
+
+// numBufferedBlocks returns the number of blocks waiting for the missing nonces, for all shards
+func (bo *blocksOrderer) numBufferedBlocks() int {
+	bo.mut.Lock()
+	defer bo.mut.Unlock()
+
+	numBlocks := 0
+	for _, buffer := range bo.shards {
+		numBlocks += len(buffer.blocks)
+	}
+
+	return numBlocks
+}
+
+// close will stop the gaps checking loop
+func (bo *blocksOrderer) close() {
+	close(bo.closeChan)
+	<-bo.loopClosed
+}
+
+func getLowestNonce(blocks map[uint64]*data.InterceptorBlockData) uint64 {
+	first := true
+	lowestNonce := uint64(0)
+	for nonce := range blocks {
+		if first || nonce < lowestNonce {
+			lowestNonce = nonce
+			first = false
+		}
+	}
+
+	return lowestNonce
+}
@@ -0,0 +1,311 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createOrderedBlock(shardID uint32, nonce uint64) *data.InterceptorBlockData {
+	return &data.InterceptorBlockData{
+		Hash: fmt.Sprintf("hash_%d_%d", shardID, nonce),
+		Header: &block.Header{
+			ShardID: shardID,
+			Nonce:   nonce,
+		},
+	}
+}
+
+type blocksOrdererRecorder struct {
+	mut             sync.Mutex
+	publishedNonces []uint64
+	gaps            []data.GapDetected
+	publishErr      error
+}
+
+func (r *blocksOrdererRecorder) publishBlock(blockData *data.InterceptorBlockData) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if r.publishErr != nil {
+		return r.publishErr
+	}
+	r.publishedNonces = append(r.publishedNonces, blockData.Header.GetNonce())
+
+	return nil
+}
+
+func (r *blocksOrdererRecorder) setPublishErr(err error) {
+	r.mut.Lock()
+	r.publishErr = err
+	r.mut.Unlock()
+}
+
+func (r *blocksOrdererRecorder) publishGap(gap data.GapDetected) {
+	r.mut.Lock()
+	r.gaps = append(r.gaps, gap)
+	r.mut.Unlock()
+}
+
+func (r *blocksOrdererRecorder) getPublishedNonces() []uint64 {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	return append([]uint64{}, r.publishedNonces...)
+}
+
+func (r *blocksOrdererRecorder) getGaps() []data.GapDetected {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	return append([]data.GapDetected{}, r.gaps...)
+}
+
+func TestBlocksOrderer_AddBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("in order blocks should be published right away", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(0, 5))
+		orderer.AddBlock(createOrderedBlock(0, 6))
+		orderer.AddBlock(createOrderedBlock(0, 7))
+
+		require.Equal(t, []uint64{5, 6, 7}, recorder.getPublishedNonces())
+		require.Empty(t, recorder.getGaps())
+		require.Equal(t, 0, orderer.NumBufferedBlocks())
+	})
+
+	t.Run("out of order blocks should be buffered until the missing block is received", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(0, 5))
+		orderer.AddBlock(createOrderedBlock(0, 8))
+		orderer.AddBlock(createOrderedBlock(0, 7))
+		require.Equal(t, []uint64{5}, recorder.getPublishedNonces())
+		require.Equal(t, 2, orderer.NumBufferedBlocks())
+
+		orderer.AddBlock(createOrderedBlock(0, 6))
+		require.Equal(t, []uint64{5, 6, 7, 8}, recorder.getPublishedNonces())
+		require.Empty(t, recorder.getGaps())
+		require.Equal(t, 0, orderer.NumBufferedBlocks())
+	})
+
+	t.Run("shards should be ordered independently", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(0, 5))
+		orderer.AddBlock(createOrderedBlock(1, 20))
+		orderer.AddBlock(createOrderedBlock(0, 7))
+		orderer.AddBlock(createOrderedBlock(1, 21))
+
+		require.Equal(t, []uint64{5, 20, 21}, recorder.getPublishedNonces())
+		require.Equal(t, 1, orderer.NumBufferedBlocks())
+	})
+
+	t.Run("already published nonce should be published right away", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(0, 5))
+		orderer.AddBlock(createOrderedBlock(0, 6))
+		orderer.AddBlock(createOrderedBlock(0, 6))
+
+		require.Equal(t, []uint64{5, 6, 6}, recorder.getPublishedNonces())
+		require.Empty(t, recorder.getGaps())
+	})
+
+	t.Run("gap should be reported when the buffer is full", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(2, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(1, 5))
+		orderer.AddBlock(createOrderedBlock(1, 9))
+		require.Equal(t, []uint64{5}, recorder.getPublishedNonces())
+
+		orderer.AddBlock(createOrderedBlock(1, 8))
+
+		expectedGap := data.GapDetected{
+			ID:            "1_5_8",
+			ShardID:       1,
+			LastNonce:     5,
+			NextNonce:     8,
+			MissingNonces: 2,
+		}
+		require.Equal(t, []data.GapDetected{expectedGap}, recorder.getGaps())
+		require.Equal(t, []uint64{5, 8, 9}, recorder.getPublishedNonces())
+		require.Equal(t, 0, orderer.NumBufferedBlocks())
+
+		orderer.AddBlock(createOrderedBlock(1, 10))
+		require.Equal(t, []uint64{5, 8, 9, 10}, recorder.getPublishedNonces())
+	})
+
+	t.Run("gap should be reported when the timeout expires, without receiving other blocks", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Millisecond*100, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		orderer.AddBlock(createOrderedBlock(0, 5))
+		orderer.AddBlock(createOrderedBlock(0, 7))
+		require.Equal(t, []uint64{5}, recorder.getPublishedNonces())
+		require.Empty(t, recorder.getGaps())
+
+		require.Eventually(t, func() bool {
+			return len(recorder.getGaps()) == 1
+		}, time.Second*5, time.Millisecond*10)
+
+		expectedGap := data.GapDetected{
+			ID:            "0_5_7",
+			ShardID:       0,
+			LastNonce:     5,
+			NextNonce:     7,
+			MissingNonces: 1,
+		}
+		require.Equal(t, []data.GapDetected{expectedGap}, recorder.getGaps())
+		require.Equal(t, []uint64{5, 7}, recorder.getPublishedNonces())
+		require.Equal(t, 0, orderer.NumBufferedBlocks())
+	})
+
+	t.Run("blocks should be published after unlocking the buffers", func(t *testing.T) {
+		t.Parallel()
+
+		var orderer interface{ NumBufferedBlocks() int }
+		numBufferedWhilePublishing := make([]int, 0)
+		publishBlock := func(blockData *data.InterceptorBlockData) error {
+			// would deadlock if the buffers were locked while publishing
+			numBufferedWhilePublishing = append(numBufferedWhilePublishing, orderer.NumBufferedBlocks())
+			return nil
+		}
+		blocksOrderer := process.NewBlocksOrderer(10, time.Minute, publishBlock, func(gap data.GapDetected) {})
+		defer blocksOrderer.Close()
+		orderer = blocksOrderer
+
+		blocksOrderer.AddBlock(createOrderedBlock(0, 5))
+		blocksOrderer.AddBlock(createOrderedBlock(0, 7))
+		blocksOrderer.AddBlock(createOrderedBlock(0, 6))
+
+		require.Equal(t, []int{0, 0, 0}, numBufferedWhilePublishing)
+	})
+
+	t.Run("failed delivery should be returned and retried in order", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		err := orderer.AddBlock(createOrderedBlock(0, 5))
+		require.Nil(t, err)
+
+		expectedErr := errors.New("expected error")
+		recorder.setPublishErr(expectedErr)
+		err = orderer.AddBlock(createOrderedBlock(0, 6))
+		require.Equal(t, expectedErr, err)
+
+		// the previous delivery is still failing, the block is not accepted
+		err = orderer.AddBlock(createOrderedBlock(0, 7))
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, []uint64{5}, recorder.getPublishedNonces())
+
+		recorder.setPublishErr(nil)
+		err = orderer.AddBlock(createOrderedBlock(0, 7))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{5, 6, 7}, recorder.getPublishedNonces())
+	})
+
+	t.Run("failed delivery should be retried periodically", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Millisecond*100, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		recorder.setPublishErr(errors.New("expected error"))
+		err := orderer.AddBlock(createOrderedBlock(0, 5))
+		require.NotNil(t, err)
+
+		recorder.setPublishErr(nil)
+		require.Eventually(t, func() bool {
+			return len(recorder.getPublishedNonces()) == 1
+		}, time.Second*5, time.Millisecond*10)
+		require.Equal(t, []uint64{5}, recorder.getPublishedNonces())
+	})
+}
+
+func TestBlocksOrderer_RemoveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("buffered block should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		_ = orderer.AddBlock(createOrderedBlock(0, 5))
+		_ = orderer.AddBlock(createOrderedBlock(0, 7))
+		require.Equal(t, 1, orderer.NumBufferedBlocks())
+
+		require.False(t, orderer.RemoveBlock("other hash", 0, 7))
+		require.True(t, orderer.RemoveBlock("hash_0_7", 0, 7))
+		require.Equal(t, 0, orderer.NumBufferedBlocks())
+
+		_ = orderer.AddBlock(createOrderedBlock(0, 6))
+		require.Equal(t, []uint64{5, 6}, recorder.getPublishedNonces())
+	})
+
+	t.Run("block waiting for a failed delivery should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		recorder.setPublishErr(errors.New("expected error"))
+		_ = orderer.AddBlock(createOrderedBlock(0, 5))
+		require.True(t, orderer.RemoveBlock("hash_0_5", 0, 5))
+
+		recorder.setPublishErr(nil)
+		err := orderer.AddBlock(createOrderedBlock(0, 6))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{6}, recorder.getPublishedNonces())
+	})
+
+	t.Run("published block should not be removed", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &blocksOrdererRecorder{}
+		orderer := process.NewBlocksOrderer(10, time.Minute, recorder.publishBlock, recorder.publishGap)
+		defer orderer.Close()
+
+		_ = orderer.AddBlock(createOrderedBlock(0, 5))
+		require.False(t, orderer.RemoveBlock("hash_0_5", 0, 5))
+	})
+}
@@ -0,0 +1,65 @@
+package process
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops calling a failing dependency after a number of consecutive failures.
+// After the open duration passes, one call is let through: a success closes the circuit,
+// while a failure opens it again.
+type circuitBreaker struct {
+	mut                 sync.Mutex
+	threshold           uint32
+	openDuration        time.Duration
+	consecutiveFailures uint32
+	openUntil           time.Time
+	getTimeHandler      func() time.Time
+}
+
+// newCircuitBreaker creates a new circuit breaker. A zero threshold disables it.
+func newCircuitBreaker(threshold uint32, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold:      threshold,
+		openDuration:   openDuration,
+		getTimeHandler: time.Now,
+	}
+}
+
+func (cb *circuitBreaker) isOpen() bool {
+	cb.mut.Lock()
+	defer cb.mut.Unlock()
+
+	return cb.getTimeHandler().Before(cb.openUntil)
+}
+
+// recordSuccess closes the circuit and it returns true if the circuit was previously opened
+func (cb *circuitBreaker) recordSuccess() bool {
+	cb.mut.Lock()
+	defer cb.mut.Unlock()
+
+	wasOpened := !cb.openUntil.IsZero()
+	cb.consecutiveFailures = 0
+	cb.openUntil = time.Time{}
+
+	return wasOpened
+}
+
+// recordFailure returns true if the circuit has been opened because of this failure
+func (cb *circuitBreaker) recordFailure() bool {
+	cb.mut.Lock()
+	defer cb.mut.Unlock()
+
+	if cb.threshold == 0 {
+		return false
+	}
+
+	cb.consecutiveFailures++
+	if cb.consecutiveFailures < cb.threshold {
+		return false
+	}
+
+	cb.openUntil = cb.getTimeHandler().Add(cb.openDuration)
+
+	return true
+}
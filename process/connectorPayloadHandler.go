@@ -0,0 +1,80 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-communication-go/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+)
+
+const (
+	wsConnectorMetricPrefix = "WS"
+	wsConnectorErrorsMetric = "errors"
+)
+
+// ArgsConnectorPayloadHandler defines the arguments needed for connector payload handler creation
+type ArgsConnectorPayloadHandler struct {
+	ConnectorName        string
+	PayloadHandler       websocket.PayloadHandler
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+// connectorPayloadHandler processes the payloads received by a websocket connector, labelling
+// the processing metrics with the connector name, so that each observer can be monitored separately
+type connectorPayloadHandler struct {
+	connectorName  string
+	payloadHandler websocket.PayloadHandler
+	metricsHandler common.StatusMetricsHandler
+}
+
+// NewConnectorPayloadHandler creates a new connector payload handler
+func NewConnectorPayloadHandler(args ArgsConnectorPayloadHandler) (*connectorPayloadHandler, error) {
+	if len(args.ConnectorName) == 0 {
+		return nil, fmt.Errorf("%w for ConnectorName", ErrInvalidValue)
+	}
+	if check.IfNil(args.PayloadHandler) {
+		return nil, ErrNilPayloadHandler
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+
+	return &connectorPayloadHandler{
+		connectorName:  args.ConnectorName,
+		payloadHandler: args.PayloadHandler,
+		metricsHandler: args.StatusMetricsHandler,
+	}, nil
+}
+
+// ProcessPayload will process the provided payload, recording its processing duration
+func (cph *connectorPayloadHandler) ProcessPayload(payload []byte, topic string, version uint32) error {
+	t := time.Now()
+	err := cph.payloadHandler.ProcessPayload(payload, topic, version)
+	cph.metricsHandler.AddRequest(cph.getMetricID(topic), time.Since(t))
+	if err != nil {
+		log.Debug("connectorPayloadHandler: failed to process payload",
+			"connector", cph.connectorName,
+			"topic", topic,
+			"error", err,
+		)
+		cph.metricsHandler.AddRequest(cph.getMetricID(wsConnectorErrorsMetric), 0)
+	}
+
+	return err
+}
+
+func (cph *connectorPayloadHandler) getMetricID(operation string) string {
+	return fmt.Sprintf("%s-%s-%s", wsConnectorMetricPrefix, cph.connectorName, operation)
+}
+
+// Close will close the underlying payload handler
+func (cph *connectorPayloadHandler) Close() error {
+	return cph.payloadHandler.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cph *connectorPayloadHandler) IsInterfaceNil() bool {
+	return cph == nil
+}
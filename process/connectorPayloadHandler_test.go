@@ -0,0 +1,142 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockConnectorPayloadHandlerArgs() process.ArgsConnectorPayloadHandler {
+	return process.ArgsConnectorPayloadHandler{
+		ConnectorName:        "shard0",
+		PayloadHandler:       &mocks.PayloadHandlerStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+func TestNewConnectorPayloadHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty connector name", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConnectorPayloadHandlerArgs()
+		args.ConnectorName = ""
+
+		cph, err := process.NewConnectorPayloadHandler(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, cph)
+	})
+
+	t.Run("nil payload handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConnectorPayloadHandlerArgs()
+		args.PayloadHandler = nil
+
+		cph, err := process.NewConnectorPayloadHandler(args)
+		require.Equal(t, process.ErrNilPayloadHandler, err)
+		require.Nil(t, cph)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConnectorPayloadHandlerArgs()
+		args.StatusMetricsHandler = nil
+
+		cph, err := process.NewConnectorPayloadHandler(args)
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+		require.Nil(t, cph)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cph, err := process.NewConnectorPayloadHandler(createMockConnectorPayloadHandlerArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(cph))
+	})
+}
+
+func TestConnectorPayloadHandler_ProcessPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should process payload and add labelled metric", func(t *testing.T) {
+		t.Parallel()
+
+		processedTopics := make([]string, 0)
+		metricIDs := make([]string, 0)
+
+		args := createMockConnectorPayloadHandlerArgs()
+		args.PayloadHandler = &mocks.PayloadHandlerStub{
+			ProcessPayloadCalled: func(payload []byte, topic string, version uint32) error {
+				processedTopics = append(processedTopics, topic)
+				return nil
+			},
+		}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, duration time.Duration) {
+				metricIDs = append(metricIDs, path)
+			},
+		}
+
+		cph, _ := process.NewConnectorPayloadHandler(args)
+
+		err := cph.ProcessPayload([]byte("payload"), outport.TopicSaveBlock, common.PayloadV1)
+		require.Nil(t, err)
+		require.Equal(t, []string{outport.TopicSaveBlock}, processedTopics)
+		require.Equal(t, []string{"WS-shard0-" + outport.TopicSaveBlock}, metricIDs)
+	})
+
+	t.Run("processing error should be counted", func(t *testing.T) {
+		t.Parallel()
+
+		metricIDs := make([]string, 0)
+		expectedErr := errors.New("expected error")
+
+		args := createMockConnectorPayloadHandlerArgs()
+		args.PayloadHandler = &mocks.PayloadHandlerStub{
+			ProcessPayloadCalled: func(payload []byte, topic string, version uint32) error {
+				return expectedErr
+			},
+		}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, duration time.Duration) {
+				metricIDs = append(metricIDs, path)
+			},
+		}
+
+		cph, _ := process.NewConnectorPayloadHandler(args)
+
+		err := cph.ProcessPayload([]byte("payload"), outport.TopicRevertIndexedBlock, common.PayloadV1)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, []string{"WS-shard0-" + outport.TopicRevertIndexedBlock, "WS-shard0-errors"}, metricIDs)
+	})
+}
+
+func TestConnectorPayloadHandler_Close(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createMockConnectorPayloadHandlerArgs()
+	args.PayloadHandler = &mocks.PayloadHandlerStub{
+		CloseCalled: func() error {
+			wasCalled = true
+			return nil
+		},
+	}
+
+	cph, _ := process.NewConnectorPayloadHandler(args)
+
+	err := cph.Close()
+	require.Nil(t, err)
+	require.True(t, wasCalled)
+}
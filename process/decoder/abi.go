@@ -0,0 +1,234 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	structTypeKind = "struct"
+	enumTypeKind   = "enum"
+)
+
+// ABI holds the parts of a MultiversX smart contract ABI needed for decoding events
+type ABI struct {
+	Name   string                       `json:"name"`
+	Events []ABIEvent                   `json:"events"`
+	Types  map[string]ABITypeDefinition `json:"types"`
+}
+
+// ABIEvent defines an event emitted by a smart contract
+type ABIEvent struct {
+	Identifier string          `json:"identifier"`
+	Inputs     []ABIEventInput `json:"inputs"`
+}
+
+// ABIEventInput defines an event argument. The indexed arguments are sent as topics,
+// while the other ones are sent in the data field
+type ABIEventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+// ABITypeDefinition defines a custom struct or enum type
+type ABITypeDefinition struct {
+	Type     string           `json:"type"`
+	Fields   []ABIField       `json:"fields"`
+	Variants []ABIEnumVariant `json:"variants"`
+}
+
+// ABIField defines a struct field, or an enum variant field
+type ABIField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ABIEnumVariant defines an enum variant
+type ABIEnumVariant struct {
+	Name         string     `json:"name"`
+	Discriminant uint8      `json:"discriminant"`
+	Fields       []ABIField `json:"fields"`
+}
+
+// LoadABIFile loads and validates the ABI from the provided json file
+func LoadABIFile(filePath string) (*ABI, error) {
+	abiBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	abi := &ABI{}
+	err = json.Unmarshal(abiBytes, abi)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing ABI file %s", err, filePath)
+	}
+
+	err = abi.checkEventTypes()
+	if err != nil {
+		return nil, fmt.Errorf("%w in ABI file %s", err, filePath)
+	}
+
+	return abi, nil
+}
+
+// checkEventTypes checks that all the types used by the events can be decoded, so
+// that a misconfigured ABI is reported on startup
+func (abi *ABI) checkEventTypes() error {
+	for _, event := range abi.Events {
+		for _, input := range event.Inputs {
+			err := abi.checkType(input.Type, make(map[string]struct{}))
+			if err != nil {
+				return fmt.Errorf("%w for event %s, input %s", err, event.Identifier, input.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (abi *ABI) checkType(typeName string, visitedTypes map[string]struct{}) error {
+	name, args, err := parseTypeName(typeName)
+	if err != nil {
+		return err
+	}
+
+	if isSimpleType(name) || isBuiltInStruct(name) {
+		return nil
+	}
+
+	switch {
+	case isGenericType(name):
+		if len(args) != 1 {
+			return fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+		return abi.checkType(args[0], visitedTypes)
+	case name == tupleType:
+		if len(args) == 0 {
+			return fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+		for _, arg := range args {
+			err = abi.checkType(arg, visitedTypes)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case isArrayType(name):
+		_, err = getArrayLength(name)
+		if err != nil || len(args) != 1 {
+			return fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+		return abi.checkType(args[0], visitedTypes)
+	}
+
+	typeDefinition, ok := abi.Types[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownABIType, typeName)
+	}
+
+	// recursive types are validated only once
+	_, visited := visitedTypes[name]
+	if visited {
+		return nil
+	}
+	visitedTypes[name] = struct{}{}
+
+	switch typeDefinition.Type {
+	case structTypeKind:
+		return abi.checkFieldTypes(typeDefinition.Fields, visitedTypes)
+	case enumTypeKind:
+		for _, variant := range typeDefinition.Variants {
+			err = abi.checkFieldTypes(variant.Fields, visitedTypes)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s of kind %s", ErrInvalidABIType, name, typeDefinition.Type)
+	}
+}
+
+func (abi *ABI) checkFieldTypes(fields []ABIField, visitedTypes map[string]struct{}) error {
+	for _, field := range fields {
+		err := abi.checkType(field.Type, visitedTypes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseTypeName splits a type name like "Option<tuple<u64,BigUint>>" into the
+// base name and the type arguments
+func parseTypeName(typeName string) (string, []string, error) {
+	typeName = strings.TrimSpace(typeName)
+
+	startIndex := strings.Index(typeName, "<")
+	if startIndex < 0 {
+		if len(typeName) == 0 || strings.Contains(typeName, ">") {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+		return typeName, nil, nil
+	}
+	if !strings.HasSuffix(typeName, ">") || startIndex == 0 {
+		return "", nil, fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+	}
+
+	name := typeName[:startIndex]
+	argsString := typeName[startIndex+1 : len(typeName)-1]
+
+	args := make([]string, 0)
+	depth := 0
+	lastIndex := 0
+	for i, c := range argsString {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(argsString[lastIndex:i]))
+				lastIndex = i + 1
+			}
+		}
+		if depth < 0 {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+	}
+	if depth != 0 {
+		return "", nil, fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+	}
+	args = append(args, strings.TrimSpace(argsString[lastIndex:]))
+
+	for _, arg := range args {
+		if len(arg) == 0 {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidABIType, typeName)
+		}
+	}
+
+	return name, args, nil
+}
+
+func isArrayType(name string) bool {
+	return strings.HasPrefix(name, arrayTypePrefix) && len(name) > len(arrayTypePrefix)
+}
+
+// getArrayLength returns the length of a fixed size array type, which has to be positive
+func getArrayLength(name string) (int, error) {
+	length, err := strconv.Atoi(strings.TrimPrefix(name, arrayTypePrefix))
+	if err != nil {
+		return 0, err
+	}
+	if length <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidABIType, name)
+	}
+
+	return length, nil
+}
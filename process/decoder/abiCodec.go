@@ -0,0 +1,456 @@
+package decoder
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	optionType      = "Option"
+	tupleType       = "tuple"
+	arrayTypePrefix = "array"
+
+	boolType                      = "bool"
+	bigUintType                   = "BigUint"
+	bigIntType                    = "BigInt"
+	addressType                   = "Address"
+	h256Type                      = "H256"
+	tokenIdentifierType           = "TokenIdentifier"
+	egldOrEsdtTokenIdentifierType = "EgldOrEsdtTokenIdentifier"
+	esdtTokenPaymentType          = "EsdtTokenPayment"
+	egldOrEsdtTokenPaymentType    = "EgldOrEsdtTokenPayment"
+
+	lengthPrefixSize = 4
+	addressSize      = 32
+	optionNoneTag    = 0
+	optionSomeTag    = 1
+)
+
+var unsignedTypeSizes = map[string]int{
+	"u8":    1,
+	"u16":   2,
+	"u32":   4,
+	"u64":   8,
+	"usize": 4,
+}
+
+var signedTypeSizes = map[string]int{
+	"i8":    1,
+	"i16":   2,
+	"i32":   4,
+	"i64":   8,
+	"isize": 4,
+}
+
+// stringTypes are decoded as utf-8 strings, while bytesTypes are decoded as hex strings
+var stringTypes = map[string]struct{}{
+	tokenIdentifierType:           {},
+	egldOrEsdtTokenIdentifierType: {},
+	"utf-8 string":                {},
+	"String":                      {},
+}
+
+var bytesTypes = map[string]struct{}{
+	"bytes":         {},
+	"ManagedBuffer": {},
+	"BoxedBytes":    {},
+}
+
+var listTypes = map[string]struct{}{
+	"List":       {},
+	"vec":        {},
+	"Vec":        {},
+	"ManagedVec": {},
+}
+
+var builtInStructs = map[string][]ABIField{
+	esdtTokenPaymentType: {
+		{Name: "token_identifier", Type: tokenIdentifierType},
+		{Name: "token_nonce", Type: "u64"},
+		{Name: "amount", Type: bigUintType},
+	},
+	egldOrEsdtTokenPaymentType: {
+		{Name: "token_identifier", Type: egldOrEsdtTokenIdentifierType},
+		{Name: "token_nonce", Type: "u64"},
+		{Name: "amount", Type: bigUintType},
+	},
+}
+
+func isSimpleType(name string) bool {
+	_, isUnsigned := unsignedTypeSizes[name]
+	_, isSigned := signedTypeSizes[name]
+	_, isString := stringTypes[name]
+	_, isBytes := bytesTypes[name]
+
+	switch name {
+	case boolType, bigUintType, bigIntType, addressType, h256Type:
+		return true
+	default:
+		return isUnsigned || isSigned || isString || isBytes
+	}
+}
+
+func isGenericType(name string) bool {
+	_, isList := listTypes[name]
+	return isList || name == optionType
+}
+
+func isBuiltInStruct(name string) bool {
+	_, ok := builtInStructs[name]
+	return ok
+}
+
+// abiCodec decodes values encoded with the MultiversX serialization format. The top level
+// encoding is used for the values sent as separate topics, while the nested encoding is used
+// for the values which are part of other values (struct fields, list items, etc.)
+type abiCodec struct {
+	abi             *ABI
+	pubKeyConverter core.PubkeyConverter
+}
+
+func (codec *abiCodec) decodeTopLevel(typeName string, encoded []byte) (interface{}, error) {
+	name, args, err := parseTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if size, ok := unsignedTypeSizes[name]; ok {
+		if len(encoded) > size {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidEncodedData, typeName)
+		}
+		return big.NewInt(0).SetBytes(encoded).Uint64(), nil
+	}
+	if size, ok := signedTypeSizes[name]; ok {
+		if len(encoded) > size {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidEncodedData, typeName)
+		}
+		return decodeSigned(encoded).Int64(), nil
+	}
+	if _, ok := stringTypes[name]; ok {
+		return string(encoded), nil
+	}
+	if _, ok := bytesTypes[name]; ok {
+		return hex.EncodeToString(encoded), nil
+	}
+	if _, ok := listTypes[name]; ok {
+		return codec.decodeListItems(args[0], encoded)
+	}
+
+	switch name {
+	case boolType:
+		if len(encoded) > 1 || (len(encoded) == 1 && encoded[0] != 1) {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidEncodedData, typeName)
+		}
+		return len(encoded) == 1, nil
+	case bigUintType:
+		return big.NewInt(0).SetBytes(encoded).String(), nil
+	case bigIntType:
+		return decodeSigned(encoded).String(), nil
+	case optionType:
+		if len(encoded) == 0 {
+			return nil, nil
+		}
+	default:
+		if len(encoded) == 0 && codec.isEnum(name) {
+			// the first variant of an enum is top level encoded as empty bytes
+			encoded = []byte{0}
+		}
+	}
+
+	// the other types have the same top level and nested encodings
+	reader := &bytesReader{data: encoded}
+	value, err := codec.decodeNested(typeName, reader)
+	if err != nil {
+		return nil, err
+	}
+	if !reader.isEmpty() {
+		return nil, fmt.Errorf("%w for %s: unexpected trailing bytes", ErrInvalidEncodedData, typeName)
+	}
+
+	return value, nil
+}
+
+func (codec *abiCodec) decodeNested(typeName string, reader *bytesReader) (interface{}, error) {
+	name, args, err := parseTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if size, ok := unsignedTypeSizes[name]; ok {
+		encoded, errRead := reader.read(size)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return big.NewInt(0).SetBytes(encoded).Uint64(), nil
+	}
+	if size, ok := signedTypeSizes[name]; ok {
+		encoded, errRead := reader.read(size)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return decodeSigned(encoded).Int64(), nil
+	}
+	if _, ok := stringTypes[name]; ok {
+		encoded, errRead := reader.readWithLength()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return string(encoded), nil
+	}
+	if _, ok := bytesTypes[name]; ok {
+		encoded, errRead := reader.readWithLength()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return hex.EncodeToString(encoded), nil
+	}
+	if _, ok := listTypes[name]; ok {
+		numItems, errRead := reader.readLength()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return codec.decodeItems(args[0], numItems, reader)
+	}
+	if fields, ok := builtInStructs[name]; ok {
+		return codec.decodeFields(fields, reader)
+	}
+	if isArrayType(name) {
+		return codec.decodeArray(name, args[0], reader)
+	}
+
+	switch name {
+	case boolType:
+		encoded, errRead := reader.read(1)
+		if errRead != nil {
+			return nil, errRead
+		}
+		if encoded[0] > 1 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidEncodedData, typeName)
+		}
+		return encoded[0] == 1, nil
+	case bigUintType:
+		encoded, errRead := reader.readWithLength()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return big.NewInt(0).SetBytes(encoded).String(), nil
+	case bigIntType:
+		encoded, errRead := reader.readWithLength()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return decodeSigned(encoded).String(), nil
+	case addressType:
+		encoded, errRead := reader.read(addressSize)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return codec.pubKeyConverter.Encode(encoded)
+	case h256Type:
+		encoded, errRead := reader.read(addressSize)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return hex.EncodeToString(encoded), nil
+	case optionType:
+		return codec.decodeOption(args[0], reader)
+	case tupleType:
+		items := make([]interface{}, 0, len(args))
+		for _, arg := range args {
+			item, errDecode := codec.decodeNested(arg, reader)
+			if errDecode != nil {
+				return nil, errDecode
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	return codec.decodeCustomType(name, reader)
+}
+
+func (codec *abiCodec) decodeOption(typeName string, reader *bytesReader) (interface{}, error) {
+	tag, err := reader.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag[0] {
+	case optionNoneTag:
+		return nil, nil
+	case optionSomeTag:
+		return codec.decodeNested(typeName, reader)
+	default:
+		return nil, fmt.Errorf("%w: invalid option tag %d", ErrInvalidEncodedData, tag[0])
+	}
+}
+
+func (codec *abiCodec) decodeListItems(typeName string, encoded []byte) ([]interface{}, error) {
+	reader := &bytesReader{data: encoded}
+	items := make([]interface{}, 0)
+	for !reader.isEmpty() {
+		item, err := codec.decodeNested(typeName, reader)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (codec *abiCodec) decodeItems(typeName string, numItems int, reader *bytesReader) ([]interface{}, error) {
+	items := make([]interface{}, 0, numItems)
+	for i := 0; i < numItems; i++ {
+		item, err := codec.decodeNested(typeName, reader)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// decodeArray decodes a fixed size array. The byte arrays are decoded as hex strings
+func (codec *abiCodec) decodeArray(name string, itemType string, reader *bytesReader) (interface{}, error) {
+	length, err := getArrayLength(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABIType, name)
+	}
+
+	if itemType == "u8" {
+		encoded, errRead := reader.read(length)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return hex.EncodeToString(encoded), nil
+	}
+
+	return codec.decodeItems(itemType, length, reader)
+}
+
+func (codec *abiCodec) decodeCustomType(name string, reader *bytesReader) (interface{}, error) {
+	typeDefinition, ok := codec.abi.Types[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownABIType, name)
+	}
+
+	switch typeDefinition.Type {
+	case structTypeKind:
+		return codec.decodeFields(typeDefinition.Fields, reader)
+	case enumTypeKind:
+		return codec.decodeEnum(name, typeDefinition.Variants, reader)
+	default:
+		return nil, fmt.Errorf("%w: %s of kind %s", ErrInvalidABIType, name, typeDefinition.Type)
+	}
+}
+
+func (codec *abiCodec) decodeFields(fields []ABIField, reader *bytesReader) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, err := codec.decodeNested(field.Type, reader)
+		if err != nil {
+			return nil, fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		values[field.Name] = value
+	}
+
+	return values, nil
+}
+
+// decodeEnum returns the variant name for the variants without fields, and an object
+// holding the variant name and fields otherwise
+func (codec *abiCodec) decodeEnum(name string, variants []ABIEnumVariant, reader *bytesReader) (interface{}, error) {
+	discriminant, err := reader.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variant := range variants {
+		if variant.Discriminant != discriminant[0] {
+			continue
+		}
+		if len(variant.Fields) == 0 {
+			return variant.Name, nil
+		}
+
+		fields, errDecode := codec.decodeFields(variant.Fields, reader)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		return map[string]interface{}{
+			"name":   variant.Name,
+			"fields": fields,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown discriminant %d for enum %s", ErrInvalidEncodedData, discriminant[0], name)
+}
+
+func (codec *abiCodec) isEnum(name string) bool {
+	typeDefinition, ok := codec.abi.Types[name]
+	return ok && typeDefinition.Type == enumTypeKind
+}
+
+// decodeSigned decodes a two's complement big endian number
+func decodeSigned(encoded []byte) *big.Int {
+	value := big.NewInt(0).SetBytes(encoded)
+	if len(encoded) > 0 && encoded[0]&0x80 != 0 {
+		modulus := big.NewInt(0).Lsh(big.NewInt(1), uint(len(encoded)*8))
+		value.Sub(value, modulus)
+	}
+
+	return value
+}
+
+type bytesReader struct {
+	data []byte
+}
+
+func (reader *bytesReader) read(numBytes int) ([]byte, error) {
+	if numBytes < 0 {
+		return nil, fmt.Errorf("%w: invalid number of bytes %d", ErrInvalidEncodedData, numBytes)
+	}
+	if numBytes > len(reader.data) {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidEncodedData, numBytes, len(reader.data))
+	}
+
+	result := reader.data[:numBytes]
+	reader.data = reader.data[numBytes:]
+
+	return result, nil
+}
+
+func (reader *bytesReader) readLength() (int, error) {
+	encodedLength, err := reader.read(lengthPrefixSize)
+	if err != nil {
+		return 0, err
+	}
+
+	length := binary.BigEndian.Uint32(encodedLength)
+	if uint64(length) > uint64(len(reader.data)) {
+		// each item is encoded on at least one byte
+		return 0, fmt.Errorf("%w: length %d exceeds the remaining data", ErrInvalidEncodedData, length)
+	}
+
+	return int(length), nil
+}
+
+func (reader *bytesReader) readWithLength() ([]byte, error) {
+	length, err := reader.readLength()
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.read(length)
+}
+
+func (reader *bytesReader) isEmpty() bool {
+	return len(reader.data) == 0
+}
@@ -0,0 +1,146 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// ArgsABIDecoder defines the arguments needed for ABI decoder creation
+type ArgsABIDecoder struct {
+	PubKeyConverter core.PubkeyConverter
+	// ContractABIs maps the bech32 contract addresses to their ABIs
+	ContractABIs map[string]*ABI
+}
+
+type contractEvents struct {
+	codec  *abiCodec
+	events map[string]ABIEvent
+}
+
+// abiDecoder decodes the events emitted by the registered smart contracts, based on their ABIs
+type abiDecoder struct {
+	contracts map[string]*contractEvents
+}
+
+// NewABIDecoder creates a new ABI events decoder instance
+func NewABIDecoder(args ArgsABIDecoder) (*abiDecoder, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	contracts := make(map[string]*contractEvents, len(args.ContractABIs))
+	for address, abi := range args.ContractABIs {
+		if abi == nil {
+			return nil, fmt.Errorf("%w for contract %s", ErrNilABI, address)
+		}
+
+		events := make(map[string]ABIEvent, len(abi.Events))
+		for _, event := range abi.Events {
+			events[event.Identifier] = event
+		}
+
+		contracts[address] = &contractEvents{
+			codec: &abiCodec{
+				abi:             abi,
+				pubKeyConverter: args.PubKeyConverter,
+			},
+			events: events,
+		}
+	}
+
+	return &abiDecoder{
+		contracts: contracts,
+	}, nil
+}
+
+// DecodeEvent returns the named fields of the provided event, if it was emitted by a registered
+// contract. The first topic holds the event identifier, the next topics hold the indexed inputs,
+// while the data field holds the other inputs.
+func (ad *abiDecoder) DecodeEvent(event data.Event) *data.DecodedEvent {
+	contract, ok := ad.contracts[event.Address]
+	if !ok || len(event.Topics) == 0 {
+		return nil
+	}
+
+	abiEvent, ok := contract.events[string(event.Topics[0])]
+	if !ok {
+		return nil
+	}
+
+	fields, err := contract.decodeInputs(abiEvent, event.Topics[1:], event.Data)
+	if err != nil {
+		log.Debug("abiDecoder: failed to decode event",
+			"address", event.Address,
+			"event", abiEvent.Identifier,
+			"tx hash", event.TxHash,
+			"error", err,
+		)
+		return nil
+	}
+
+	return &data.DecodedEvent{
+		Name:   abiEvent.Identifier,
+		Fields: fields,
+	}
+}
+
+func (ce *contractEvents) decodeInputs(abiEvent ABIEvent, topics [][]byte, eventData []byte) (map[string]interface{}, error) {
+	indexedInputs := make([]ABIEventInput, 0, len(abiEvent.Inputs))
+	dataInputs := make([]ABIEventInput, 0)
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexedInputs = append(indexedInputs, input)
+			continue
+		}
+		dataInputs = append(dataInputs, input)
+	}
+
+	if len(indexedInputs) != len(topics) {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrTopicsMismatch, len(indexedInputs), len(topics))
+	}
+
+	fields := make(map[string]interface{}, len(abiEvent.Inputs))
+	for i, input := range indexedInputs {
+		value, err := ce.codec.decodeTopLevel(input.Type, topics[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w for input %s", err, input.Name)
+		}
+		fields[input.Name] = value
+	}
+
+	switch len(dataInputs) {
+	case 0:
+		return fields, nil
+	case 1:
+		value, err := ce.codec.decodeTopLevel(dataInputs[0].Type, eventData)
+		if err != nil {
+			return nil, fmt.Errorf("%w for input %s", err, dataInputs[0].Name)
+		}
+		fields[dataInputs[0].Name] = value
+
+		return fields, nil
+	}
+
+	// multiple data inputs are nested encoded one after the other
+	reader := &bytesReader{data: eventData}
+	for _, input := range dataInputs {
+		value, err := ce.codec.decodeNested(input.Type, reader)
+		if err != nil {
+			return nil, fmt.Errorf("%w for input %s", err, input.Name)
+		}
+		fields[input.Name] = value
+	}
+	if !reader.isEmpty() {
+		return nil, fmt.Errorf("%w: unexpected trailing bytes in data field", ErrInvalidEncodedData)
+	}
+
+	return fields, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *abiDecoder) IsInterfaceNil() bool {
+	return ad == nil
+}
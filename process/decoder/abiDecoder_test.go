@@ -0,0 +1,356 @@
+package decoder_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process/decoder"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	contractAddress = "contract"
+	swapABIFilePath = "./testdata/swap.abi.json"
+)
+
+var callerPk = bytes.Repeat([]byte{0x01}, 32)
+
+func createABIDecoder(t *testing.T) decoder.EventDecoder {
+	abi, err := decoder.LoadABIFile(swapABIFilePath)
+	require.Nil(t, err)
+
+	ad, err := decoder.NewABIDecoder(decoder.ArgsABIDecoder{
+		PubKeyConverter: &mocks.PubkeyConverterMock{},
+		ContractABIs: map[string]*decoder.ABI{
+			contractAddress: abi,
+		},
+	})
+	require.Nil(t, err)
+
+	return ad
+}
+
+func writeABIFileWithInputType(t *testing.T, typeName string) string {
+	abi := decoder.ABI{
+		Name: "Test",
+		Events: []decoder.ABIEvent{
+			{
+				Identifier: "event",
+				Inputs: []decoder.ABIEventInput{
+					{Name: "value", Type: typeName},
+				},
+			},
+		},
+	}
+	abiBytes, err := json.Marshal(abi)
+	require.Nil(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "test.abi.json")
+	err = os.WriteFile(filePath, abiBytes, 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadABIFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		abi, err := decoder.LoadABIFile("./testdata/missing.abi.json")
+		require.Nil(t, abi)
+		require.True(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		t.Parallel()
+
+		abi, err := decoder.LoadABIFile("./testdata/invalid.abi.json")
+		require.Nil(t, abi)
+		require.True(t, errors.Is(err, decoder.ErrUnknownABIType))
+	})
+
+	t.Run("invalid array types", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name     string
+			typeName string
+		}{
+			{name: "negative length", typeName: "array-5<u8>"},
+			{name: "zero length", typeName: "array0<u8>"},
+			{name: "not a number", typeName: "arrayX<u8>"},
+			{name: "missing item type", typeName: "array5"},
+		}
+
+		for _, tc := range testCases {
+			filePath := writeABIFileWithInputType(t, tc.typeName)
+
+			abi, err := decoder.LoadABIFile(filePath)
+			require.Nil(t, abi, tc.name)
+			require.True(t, errors.Is(err, decoder.ErrInvalidABIType), tc.name)
+		}
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		abi, err := decoder.LoadABIFile(swapABIFilePath)
+		require.Nil(t, err)
+		require.Equal(t, "Swap", abi.Name)
+		require.Equal(t, 2, len(abi.Events))
+	})
+}
+
+func TestNewABIDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := decoder.NewABIDecoder(decoder.ArgsABIDecoder{})
+		require.True(t, check.IfNil(ad))
+		require.Equal(t, decoder.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("nil ABI", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := decoder.NewABIDecoder(decoder.ArgsABIDecoder{
+			PubKeyConverter: &mocks.PubkeyConverterMock{},
+			ContractABIs: map[string]*decoder.ABI{
+				contractAddress: nil,
+			},
+		})
+		require.True(t, check.IfNil(ad))
+		require.True(t, errors.Is(err, decoder.ErrNilABI))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		require.False(t, check.IfNil(ad))
+	})
+}
+
+func TestABIDecoder_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	swapEventData := []byte{
+		0, 0, 0, 2, 0x03, 0xe8, // amount_in: 1000
+		0, 0, 0, 1, 0x64, // amount_out: 100
+		1,          // success: true
+		2,          // status: Paused
+		0, 0, 0, 7, // until_epoch: 7
+	}
+
+	t.Run("not registered contract, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address: "other contract",
+			Topics:  [][]byte{[]byte("swap"), callerPk, []byte("TKN-123456"), {0x01}},
+			Data:    swapEventData,
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("unknown event identifier, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			Topics:  [][]byte{[]byte("unknown")},
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("topics not matching the indexed inputs, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			Topics:  [][]byte{[]byte("swap"), callerPk, []byte("TKN-123456")},
+			Data:    swapEventData,
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("malformed data, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			Topics:  [][]byte{[]byte("swap"), callerPk, []byte("TKN-123456"), {0x01}},
+			Data:    swapEventData[:len(swapEventData)-1],
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("negative array length in a not validated ABI, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := decoder.NewABIDecoder(decoder.ArgsABIDecoder{
+			PubKeyConverter: &mocks.PubkeyConverterMock{},
+			ContractABIs: map[string]*decoder.ABI{
+				contractAddress: {
+					Name: "Test",
+					Events: []decoder.ABIEvent{
+						{
+							Identifier: "event",
+							Inputs: []decoder.ABIEventInput{
+								{Name: "value", Type: "array-5<u8>"},
+							},
+						},
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		decoded := ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			Topics:  [][]byte{[]byte("event")},
+			Data:    []byte{0x01, 0x02, 0x03, 0x04, 0x05},
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("struct data input", func(t *testing.T) {
+		t.Parallel()
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address:    contractAddress,
+			Identifier: "swapTokens",
+			Topics:     [][]byte{[]byte("swap"), callerPk, []byte("TKN-123456"), {0x01, 0x00}},
+			Data:       swapEventData,
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Name: "swap",
+			Fields: map[string]interface{}{
+				"caller":   hex.EncodeToString(callerPk),
+				"token_in": "TKN-123456",
+				"epoch":    uint64(256),
+				"swap_event": map[string]interface{}{
+					"amount_in":  "1000",
+					"amount_out": "100",
+					"success":    true,
+					"status": map[string]interface{}{
+						"name": "Paused",
+						"fields": map[string]interface{}{
+							"until_epoch": uint64(7),
+						},
+					},
+				},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+
+	t.Run("enum, signed, option and list inputs", func(t *testing.T) {
+		t.Parallel()
+
+		payments := []byte{
+			0, 0, 0, 2, // number of payments
+			0, 0, 0, 3, 'A', 'B', 'C', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0x0a,
+			0, 0, 0, 3, 'N', 'F', 'T', 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 1, 0x01,
+		}
+
+		ad := createABIDecoder(t)
+		decoded := ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			// the first enum variant is top level encoded as empty bytes
+			Topics: [][]byte{[]byte("status_changed"), {}, {0xfe}, {0x01, 0, 0, 0, 1, 0x0a}},
+			Data:   payments[4:],
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Name: "status_changed",
+			Fields: map[string]interface{}{
+				"status": "Inactive",
+				"delta":  int64(-2),
+				"fee":    "10",
+				"payments": []interface{}{
+					map[string]interface{}{"token_identifier": "ABC", "token_nonce": uint64(0), "amount": "10"},
+					map[string]interface{}{"token_identifier": "NFT", "token_nonce": uint64(5), "amount": "1"},
+				},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+
+		decoded = ad.DecodeEvent(data.Event{
+			Address: contractAddress,
+			Topics:  [][]byte{[]byte("status_changed"), {0x01}, {}, {}},
+		})
+
+		expectedDecoded = &data.DecodedEvent{
+			Name: "status_changed",
+			Fields: map[string]interface{}{
+				"status":   "Active",
+				"delta":    int64(0),
+				"fee":      nil,
+				"payments": []interface{}{},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+}
+
+func TestEventDecodersChain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil decoder", func(t *testing.T) {
+		t.Parallel()
+
+		chain, err := decoder.NewEventDecodersChain(&mocks.EventsDecoderStub{}, nil)
+		require.True(t, check.IfNil(chain))
+		require.Equal(t, decoder.ErrNilEventDecoder, err)
+	})
+
+	t.Run("should return the first decoded result", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvent := &data.DecodedEvent{Name: "event"}
+		numCalls := 0
+		chain, err := decoder.NewEventDecodersChain(
+			&mocks.EventsDecoderStub{
+				DecodeEventCalled: func(event data.Event) *data.DecodedEvent {
+					numCalls++
+					return nil
+				},
+			},
+			&mocks.EventsDecoderStub{
+				DecodeEventCalled: func(event data.Event) *data.DecodedEvent {
+					numCalls++
+					return decodedEvent
+				},
+			},
+			&mocks.EventsDecoderStub{
+				DecodeEventCalled: func(event data.Event) *data.DecodedEvent {
+					require.Fail(t, "should have not been called")
+					return nil
+				},
+			},
+		)
+		require.Nil(t, err)
+
+		require.Equal(t, decodedEvent, chain.DecodeEvent(data.Event{}))
+		require.Equal(t, 2, numCalls)
+	})
+}
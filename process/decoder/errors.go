@@ -0,0 +1,24 @@
+package decoder
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilEventDecoder signals that a nil event decoder has been provided
+var ErrNilEventDecoder = errors.New("nil event decoder")
+
+// ErrNilABI signals that a nil ABI has been provided
+var ErrNilABI = errors.New("nil ABI")
+
+// ErrUnknownABIType signals that a type is neither a known type, nor defined in the ABI
+var ErrUnknownABIType = errors.New("unknown ABI type")
+
+// ErrInvalidABIType signals that an invalid ABI type has been provided
+var ErrInvalidABIType = errors.New("invalid ABI type")
+
+// ErrInvalidEncodedData signals that the encoded data does not match the ABI type
+var ErrInvalidEncodedData = errors.New("invalid encoded data")
+
+// ErrTopicsMismatch signals that the number of event topics does not match the ABI indexed inputs
+var ErrTopicsMismatch = errors.New("event topics do not match the ABI indexed inputs")
@@ -0,0 +1,144 @@
+package decoder
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+var log = logger.GetOrCreate("process/decoder")
+
+const (
+	// token identifier, nonce and value
+	numTopicsPerTransfer = 3
+)
+
+// ArgsESDTDecoder defines the arguments needed for ESDT decoder creation
+type ArgsESDTDecoder struct {
+	PubKeyConverter core.PubkeyConverter
+}
+
+type esdtDecodeFunc func(event data.Event) *data.DecodedEvent
+
+// esdtDecoder decodes the topics of the ESDT built-in functions events
+type esdtDecoder struct {
+	pubKeyConverter core.PubkeyConverter
+	decodeFuncs     map[string]esdtDecodeFunc
+}
+
+// NewESDTDecoder creates a new ESDT events decoder instance
+func NewESDTDecoder(args ArgsESDTDecoder) (*esdtDecoder, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	ed := &esdtDecoder{
+		pubKeyConverter: args.PubKeyConverter,
+	}
+
+	ed.decodeFuncs = map[string]esdtDecodeFunc{
+		core.BuiltInFunctionESDTTransfer:         ed.decodeTransfer,
+		core.BuiltInFunctionESDTNFTTransfer:      ed.decodeTransfer,
+		core.BuiltInFunctionMultiESDTNFTTransfer: ed.decodeMultiTransfer,
+		core.BuiltInFunctionESDTLocalMint:        ed.decodeSupplyChange,
+		core.BuiltInFunctionESDTLocalBurn:        ed.decodeSupplyChange,
+		core.BuiltInFunctionESDTNFTCreate:        ed.decodeSupplyChange,
+		core.BuiltInFunctionESDTNFTBurn:          ed.decodeSupplyChange,
+		core.BuiltInFunctionESDTNFTAddQuantity:   ed.decodeSupplyChange,
+	}
+
+	return ed, nil
+}
+
+// DecodeEvent returns the decoded fields of the provided event, or nil if the event
+// is not a recognised ESDT event, or if its topics are malformed
+func (ed *esdtDecoder) DecodeEvent(event data.Event) *data.DecodedEvent {
+	decodeFunc, ok := ed.decodeFuncs[event.Identifier]
+	if !ok {
+		return nil
+	}
+
+	return decodeFunc(event)
+}
+
+// decodeTransfer decodes the topics: token identifier, nonce, value and receiver address
+func (ed *esdtDecoder) decodeTransfer(event data.Event) *data.DecodedEvent {
+	if len(event.Topics) != numTopicsPerTransfer+1 {
+		return nil
+	}
+
+	receiver, ok := ed.encodeAddress(event.Topics[numTopicsPerTransfer])
+	if !ok {
+		return nil
+	}
+
+	return &data.DecodedEvent{
+		Sender:    event.Address,
+		Receiver:  receiver,
+		Transfers: []data.DecodedTransfer{decodeTransfer(event.Topics)},
+	}
+}
+
+// decodeMultiTransfer decodes the topics: (token identifier, nonce, value) for each transfer,
+// followed by the receiver address
+func (ed *esdtDecoder) decodeMultiTransfer(event data.Event) *data.DecodedEvent {
+	numTopics := len(event.Topics)
+	if numTopics <= numTopicsPerTransfer || (numTopics-1)%numTopicsPerTransfer != 0 {
+		return nil
+	}
+
+	receiver, ok := ed.encodeAddress(event.Topics[numTopics-1])
+	if !ok {
+		return nil
+	}
+
+	transfers := make([]data.DecodedTransfer, 0, numTopics/numTopicsPerTransfer)
+	for i := 0; i < numTopics-1; i += numTopicsPerTransfer {
+		transfers = append(transfers, decodeTransfer(event.Topics[i:i+numTopicsPerTransfer]))
+	}
+
+	return &data.DecodedEvent{
+		Sender:    event.Address,
+		Receiver:  receiver,
+		Transfers: transfers,
+	}
+}
+
+// decodeSupplyChange decodes the topics: token identifier, nonce and value. The next
+// topics, if any, are specific to each event (for example the created NFT attributes)
+func (ed *esdtDecoder) decodeSupplyChange(event data.Event) *data.DecodedEvent {
+	if len(event.Topics) < numTopicsPerTransfer {
+		return nil
+	}
+
+	return &data.DecodedEvent{
+		Sender:    event.Address,
+		Transfers: []data.DecodedTransfer{decodeTransfer(event.Topics)},
+	}
+}
+
+func (ed *esdtDecoder) encodeAddress(pubKey []byte) (string, bool) {
+	address, err := ed.pubKeyConverter.Encode(pubKey)
+	if err != nil {
+		log.Debug("esdtDecoder: failed to encode address", "error", err)
+		return "", false
+	}
+
+	return address, true
+}
+
+func decodeTransfer(topics [][]byte) data.DecodedTransfer {
+	return data.DecodedTransfer{
+		Token:  string(topics[0]),
+		Nonce:  big.NewInt(0).SetBytes(topics[1]).Uint64(),
+		Amount: big.NewInt(0).SetBytes(topics[2]).String(),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *esdtDecoder) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,200 @@
+package decoder_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process/decoder"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	senderAddress = hex.EncodeToString([]byte("sender"))
+	receiverPk    = []byte("receiver")
+	amountBytes   = big.NewInt(0).Mul(big.NewInt(1e18), big.NewInt(1000)).Bytes()
+)
+
+func createESDTDecoder(t *testing.T) decoder.EventDecoder {
+	ed, err := decoder.NewESDTDecoder(decoder.ArgsESDTDecoder{
+		PubKeyConverter: &mocks.PubkeyConverterMock{},
+	})
+	require.Nil(t, err)
+
+	return ed
+}
+
+func TestNewESDTDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter", func(t *testing.T) {
+		t.Parallel()
+
+		ed, err := decoder.NewESDTDecoder(decoder.ArgsESDTDecoder{})
+		require.True(t, check.IfNil(ed))
+		require.Equal(t, decoder.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ed, err := decoder.NewESDTDecoder(decoder.ArgsESDTDecoder{
+			PubKeyConverter: &mocks.PubkeyConverterMock{},
+		})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ed))
+	})
+}
+
+func TestESDTDecoder_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not recognised event, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: "writeLog",
+			Topics:     [][]byte{[]byte("TKN-123456"), {}, amountBytes, receiverPk},
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Topics:     [][]byte{[]byte("TKN-123456"), {}, amountBytes, receiverPk},
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Sender:   senderAddress,
+			Receiver: hex.EncodeToString(receiverPk),
+			Transfers: []data.DecodedTransfer{
+				{Token: "TKN-123456", Nonce: 0, Amount: "1000000000000000000000"},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+
+	t.Run("ESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionESDTNFTTransfer,
+			Topics:     [][]byte{[]byte("NFT-123456"), {0x01, 0x02}, {1}, receiverPk},
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Sender:   senderAddress,
+			Receiver: hex.EncodeToString(receiverPk),
+			Transfers: []data.DecodedTransfer{
+				{Token: "NFT-123456", Nonce: 258, Amount: "1"},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+
+	t.Run("ESDTTransfer with malformed topics, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Topics:     [][]byte{[]byte("TKN-123456"), {}, amountBytes},
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Topics: [][]byte{
+				[]byte("TKN-123456"), {}, amountBytes,
+				[]byte("NFT-123456"), {0x0a}, {1},
+				receiverPk,
+			},
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Sender:   senderAddress,
+			Receiver: hex.EncodeToString(receiverPk),
+			Transfers: []data.DecodedTransfer{
+				{Token: "TKN-123456", Nonce: 0, Amount: "1000000000000000000000"},
+				{Token: "NFT-123456", Nonce: 10, Amount: "1"},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+
+	t.Run("MultiESDTNFTTransfer with malformed topics, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Topics: [][]byte{
+				[]byte("TKN-123456"), {}, amountBytes,
+				[]byte("NFT-123456"), {0x0a},
+				receiverPk,
+			},
+		})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("ESDTLocalMint and ESDTLocalBurn", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		for _, identifier := range []string{core.BuiltInFunctionESDTLocalMint, core.BuiltInFunctionESDTLocalBurn} {
+			decoded := ed.DecodeEvent(data.Event{
+				Address:    senderAddress,
+				Identifier: identifier,
+				Topics:     [][]byte{[]byte("TKN-123456"), {}, {0x01, 0x00}},
+			})
+
+			expectedDecoded := &data.DecodedEvent{
+				Sender: senderAddress,
+				Transfers: []data.DecodedTransfer{
+					{Token: "TKN-123456", Nonce: 0, Amount: "256"},
+				},
+			}
+			require.Equal(t, expectedDecoded, decoded)
+		}
+	})
+
+	t.Run("ESDTNFTCreate, should ignore the attributes topic", func(t *testing.T) {
+		t.Parallel()
+
+		ed := createESDTDecoder(t)
+		decoded := ed.DecodeEvent(data.Event{
+			Address:    senderAddress,
+			Identifier: core.BuiltInFunctionESDTNFTCreate,
+			Topics:     [][]byte{[]byte("NFT-123456"), {0x05}, {1}, []byte("attributes")},
+		})
+
+		expectedDecoded := &data.DecodedEvent{
+			Sender: senderAddress,
+			Transfers: []data.DecodedTransfer{
+				{Token: "NFT-123456", Nonce: 5, Amount: "1"},
+			},
+		}
+		require.Equal(t, expectedDecoded, decoded)
+	})
+}
@@ -0,0 +1,41 @@
+package decoder
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// eventDecodersChain tries the provided decoders in order, the first decoded result being used
+type eventDecodersChain struct {
+	decoders []EventDecoder
+}
+
+// NewEventDecodersChain creates a new event decoders chain instance
+func NewEventDecodersChain(decoders ...EventDecoder) (*eventDecodersChain, error) {
+	for _, decoder := range decoders {
+		if check.IfNil(decoder) {
+			return nil, ErrNilEventDecoder
+		}
+	}
+
+	return &eventDecodersChain{
+		decoders: decoders,
+	}, nil
+}
+
+// DecodeEvent returns the result of the first decoder which recognises the provided event
+func (chain *eventDecodersChain) DecodeEvent(event data.Event) *data.DecodedEvent {
+	for _, decoder := range chain.decoders {
+		decoded := decoder.DecodeEvent(event)
+		if decoded != nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chain *eventDecodersChain) IsInterfaceNil() bool {
+	return chain == nil
+}
@@ -0,0 +1,9 @@
+package decoder
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// EventDecoder defines the behaviour of a component which decodes the raw topics of the events
+type EventDecoder interface {
+	DecodeEvent(event data.Event) *data.DecodedEvent
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,190 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+)
+
+const (
+	commitQueueSize   = 1000
+	maxCommitAttempts = 3
+
+	commitMetricID            = "Redis-commit"
+	pendingDeliveriesMetricID = "Locker-pending-deliveries"
+)
+
+// ArgsDeliveryTracker defines the arguments needed for delivery tracker creation
+type ArgsDeliveryTracker struct {
+	Locker               LockService
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+// deliveryTracker keeps the locker keys reserved for the published messages, and it
+// commits a key after all the messages published for it have been delivered
+type deliveryTracker struct {
+	locker         LockService
+	metricsHandler common.StatusMetricsHandler
+
+	mut         sync.Mutex
+	pendingKeys map[string]map[string]struct{}
+	messageKeys map[string]string
+
+	commitCh   chan string
+	closeChan  chan struct{}
+	loopClosed chan struct{}
+}
+
+// NewDeliveryTracker creates a new delivery tracker instance
+func NewDeliveryTracker(args ArgsDeliveryTracker) (*deliveryTracker, error) {
+	if check.IfNil(args.Locker) {
+		return nil, ErrNilLockService
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+
+	dt := &deliveryTracker{
+		locker:         args.Locker,
+		metricsHandler: args.StatusMetricsHandler,
+		pendingKeys:    make(map[string]map[string]struct{}),
+		messageKeys:    make(map[string]string),
+		commitCh:       make(chan string, commitQueueSize),
+		closeChan:      make(chan struct{}),
+		loopClosed:     make(chan struct{}),
+	}
+
+	go dt.commitLoop()
+
+	return dt, nil
+}
+
+// TrackDelivery registers the messages which have to be delivered before committing
+// the provided locker key. It has to be called before publishing the messages.
+func (dt *deliveryTracker) TrackDelivery(key string, messageIDs []string) {
+	if len(messageIDs) == 0 {
+		dt.enqueueCommit(key)
+		return
+	}
+
+	dt.mut.Lock()
+	defer dt.mut.Unlock()
+
+	pendingMessages := make(map[string]struct{}, len(messageIDs))
+	for _, messageID := range messageIDs {
+		pendingMessages[messageID] = struct{}{}
+		dt.messageKeys[messageID] = key
+	}
+	dt.pendingKeys[key] = pendingMessages
+
+	dt.updatePendingMetric()
+}
+
+// ConfirmDelivery marks the provided message as delivered. The related locker key is
+// committed after all its messages are delivered.
+func (dt *deliveryTracker) ConfirmDelivery(messageID string) {
+	key, allDelivered := dt.removePendingMessage(messageID)
+	if !allDelivered {
+		return
+	}
+
+	dt.enqueueCommit(key)
+}
+
+// enqueueCommit does not block the caller, which might be the publisher confirmations loop.
+// If the commit is dropped, the reservation will expire and the events might be published again.
+func (dt *deliveryTracker) enqueueCommit(key string) {
+	select {
+	case dt.commitCh <- key:
+	default:
+		log.Warn("commit queue is full, dropping locker commit", "key", key)
+	}
+}
+
+func (dt *deliveryTracker) removePendingMessage(messageID string) (string, bool) {
+	dt.mut.Lock()
+	defer dt.mut.Unlock()
+
+	key, ok := dt.messageKeys[messageID]
+	if !ok {
+		return "", false
+	}
+	delete(dt.messageKeys, messageID)
+
+	pendingMessages := dt.pendingKeys[key]
+	delete(pendingMessages, messageID)
+	if len(pendingMessages) > 0 {
+		return "", false
+	}
+
+	delete(dt.pendingKeys, key)
+	dt.updatePendingMetric()
+
+	return key, true
+}
+
+func (dt *deliveryTracker) updatePendingMetric() {
+	dt.metricsHandler.SetGauge(pendingDeliveriesMetricID, uint64(len(dt.pendingKeys)))
+}
+
+func (dt *deliveryTracker) commitLoop() {
+	defer close(dt.loopClosed)
+
+	for {
+		select {
+		case <-dt.closeChan:
+			dt.commitQueued()
+			return
+		case key := <-dt.commitCh:
+			dt.commit(key)
+		}
+	}
+}
+
+func (dt *deliveryTracker) commitQueued() {
+	for {
+		select {
+		case key := <-dt.commitCh:
+			dt.commit(key)
+		default:
+			return
+		}
+	}
+}
+
+// commit will mark the key as processed. If it fails, the reservation will expire and
+// the events might be published again by another instance.
+func (dt *deliveryTracker) commit(key string) {
+	var err error
+	for attempt := 0; attempt < maxCommitAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(setRetryDuration)
+		}
+
+		t := time.Now()
+		err = dt.locker.CommitEvent(context.Background(), key)
+		dt.metricsHandler.AddRequest(commitMetricID, time.Since(t))
+		if err == nil {
+			log.Debug("locker commit", "key", key)
+			return
+		}
+	}
+
+	log.Error("failed to commit event in locker", "key", key, "error", err.Error())
+}
+
+// Close will commit the already delivered events and it will stop the commit loop
+func (dt *deliveryTracker) Close() error {
+	close(dt.closeChan)
+	<-dt.loopClosed
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dt *deliveryTracker) IsInterfaceNil() bool {
+	return dt == nil
+}
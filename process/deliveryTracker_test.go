@@ -0,0 +1,152 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockDeliveryTrackerArgs() process.ArgsDeliveryTracker {
+	return process.ArgsDeliveryTracker{
+		Locker:               &mocks.LockerStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+type committedKeysHandler struct {
+	mut  sync.Mutex
+	keys []string
+}
+
+func (ckh *committedKeysHandler) add(key string) {
+	ckh.mut.Lock()
+	ckh.keys = append(ckh.keys, key)
+	ckh.mut.Unlock()
+}
+
+func (ckh *committedKeysHandler) get() []string {
+	ckh.mut.Lock()
+	defer ckh.mut.Unlock()
+
+	return append([]string{}, ckh.keys...)
+}
+
+func TestNewDeliveryTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil locker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDeliveryTrackerArgs()
+		args.Locker = nil
+
+		dt, err := process.NewDeliveryTracker(args)
+		require.True(t, check.IfNil(dt))
+		require.Equal(t, process.ErrNilLockService, err)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDeliveryTrackerArgs()
+		args.StatusMetricsHandler = nil
+
+		dt, err := process.NewDeliveryTracker(args)
+		require.True(t, check.IfNil(dt))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dt, err := process.NewDeliveryTracker(createMockDeliveryTrackerArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(dt))
+		require.Nil(t, dt.Close())
+	})
+}
+
+func TestDeliveryTracker_ConfirmDelivery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should commit key after all messages are delivered", func(t *testing.T) {
+		t.Parallel()
+
+		committedKeys := &committedKeysHandler{}
+		args := createMockDeliveryTrackerArgs()
+		args.Locker = &mocks.LockerStub{
+			CommitEventCalled: func(ctx context.Context, key string) error {
+				committedKeys.add(key)
+				return nil
+			},
+		}
+		dt, _ := process.NewDeliveryTracker(args)
+
+		dt.TrackDelivery("hash1", []string{"hash1_all_events", "hash1_block_txs"})
+		dt.TrackDelivery("revert_hash1", []string{"hash1_revert_events"})
+
+		dt.ConfirmDelivery("hash1_all_events")
+		dt.ConfirmDelivery("unknown")
+		dt.ConfirmDelivery("hash1_revert_events")
+
+		// close commits the already delivered keys
+		err := dt.Close()
+		require.Nil(t, err)
+		require.Equal(t, []string{"revert_hash1"}, committedKeys.get())
+	})
+
+	t.Run("should commit all delivered keys", func(t *testing.T) {
+		t.Parallel()
+
+		committedKeys := &committedKeysHandler{}
+		args := createMockDeliveryTrackerArgs()
+		args.Locker = &mocks.LockerStub{
+			CommitEventCalled: func(ctx context.Context, key string) error {
+				committedKeys.add(key)
+				return nil
+			},
+		}
+		dt, _ := process.NewDeliveryTracker(args)
+
+		dt.TrackDelivery("hash1", []string{"hash1_all_events", "hash1_block_txs"})
+		dt.TrackDelivery("hash2", []string{})
+
+		dt.ConfirmDelivery("hash1_block_txs")
+		dt.ConfirmDelivery("hash1_all_events")
+
+		err := dt.Close()
+		require.Nil(t, err)
+		require.ElementsMatch(t, []string{"hash1", "hash2"}, committedKeys.get())
+	})
+
+	t.Run("should retry commit on failure", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockDeliveryTrackerArgs()
+		args.Locker = &mocks.LockerStub{
+			CommitEventCalled: func(ctx context.Context, key string) error {
+				numCalls++
+				if numCalls < 2 {
+					return errors.New("commit error")
+				}
+				return nil
+			},
+		}
+		dt, _ := process.NewDeliveryTracker(args)
+
+		dt.TrackDelivery("hash1", []string{"hash1_all_events"})
+		dt.ConfirmDelivery("hash1_all_events")
+
+		err := dt.Close()
+		require.Nil(t, err)
+		require.Equal(t, 2, numCalls)
+	})
+}
@@ -29,5 +29,38 @@ var ErrNilBlockHeader = errors.New("nil block header provided")
 // ErrNilPublisherHandler signals that a nil publisher handler has been provided
 var ErrNilPublisherHandler = errors.New("nil publisher handler provided")
 
+// ErrNilEventsDecoder signals that a nil events decoder was provided
+var ErrNilEventsDecoder = errors.New("nil events decoder")
+
 // ErrNilEventsInterceptor signals that a nil events interceptor was provided
 var ErrNilEventsInterceptor = errors.New("nil events interceptor")
+
+// ErrNilDeliveryTracker signals that a nil delivery tracker has been provided
+var ErrNilDeliveryTracker = errors.New("nil delivery tracker")
+
+// ErrNilLeaderElector signals that a nil leader elector has been provided
+var ErrNilLeaderElector = errors.New("nil leader elector")
+
+// ErrLockerUnavailable signals that the lock service could not be reached in the configured retry duration
+var ErrLockerUnavailable = errors.New("lock service unavailable")
+
+// ErrLockerCircuitOpen signals that the lock service is not called, since it failed too many times
+var ErrLockerCircuitOpen = errors.New("lock service circuit breaker is open")
+
+// ErrNilTxCompletionWatcher signals that a nil tx completion watcher has been provided
+var ErrNilTxCompletionWatcher = errors.New("nil tx completion watcher")
+
+// ErrNilBlocksBackfiller signals that a nil blocks backfiller has been provided
+var ErrNilBlocksBackfiller = errors.New("nil blocks backfiller")
+
+// ErrInvalidTxHash signals that an invalid transaction hash has been provided
+var ErrInvalidTxHash = errors.New("invalid transaction hash")
+
+// ErrTooManyWatchedTxs signals that the maximum number of watched transactions has been reached
+var ErrTooManyWatchedTxs = errors.New("too many watched transactions")
+
+// ErrNilWatchedTxsRegistry signals that a nil watched transactions registry has been provided
+var ErrNilWatchedTxsRegistry = errors.New("nil watched transactions registry")
+
+// ErrNilPayloadHandler signals that a nil payload handler has been provided
+var ErrNilPayloadHandler = errors.New("nil payload handler")
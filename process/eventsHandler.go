@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
@@ -23,23 +27,75 @@ const (
 
 	rabbitmqMetricPrefix = "RabbitMQ"
 	redisMetricPrefix    = "Redis"
+
+	lockerFailureMetricID     = "Redis-failure"
+	lockerFailOpenMetricID    = "Redis-fail-open"
+	lockerFailClosedMetricID  = "Redis-fail-closed"
+	lockerCircuitOpenMetricID = "Redis-circuit-open"
+
+	orderingGapMetricID            = "Ordering-gap"
+	orderingLastGapSizeMetricID    = "Ordering-last-gap-size"
+	orderingBufferedBlocksMetricID = "Ordering-buffered-blocks"
+
+	retractedCacheMissMetricID    = "Retracted-cache-miss"
+	retractedCachedBlocksMetricID = "Retracted-cached-blocks"
+
+	followerBufferedEventsMetricID = "LeaderElection-follower-buffered-events"
+	followerDroppedEventsMetricID  = "LeaderElection-follower-dropped-events"
+	followerReplayedEventsMetricID = "LeaderElection-replayed-events"
 )
 
+// saveBlockStreams defines the output streams published for a saved block, all of
+// them being guarded by the same locker key
+var saveBlockStreams = []string{
+	common.PushLogsAndEvents,
+	common.BlockTxs,
+	common.BlockScrs,
+	common.BlockEvents,
+	common.BlockRewards,
+	common.BlockReceipts,
+	common.BlockInvalidTxs,
+	common.AlteredAccounts,
+	common.BlockHeaders,
+	common.FailedExecutions,
+}
+
 // ArgsEventsHandler defines the arguments needed for an events handler
 type ArgsEventsHandler struct {
 	Locker               LockService
 	Publisher            Publisher
 	StatusMetricsHandler common.StatusMetricsHandler
 	EventsInterceptor    EventsInterceptor
+	DeliveryTracker      common.DeliveryTracker
+	LeaderElector        LeaderElector
+	TxCompletionWatcher  common.TxCompletionWatcher
+	BlocksBackfiller     common.BlocksBackfiller
 	CheckDuplicates      bool
+	EnabledStreams       []string
+	LockerRetryPolicy    config.LockerRetryPolicyConfig
+	BlocksOrdering       config.BlocksOrderingConfig
+	RetractedEvents      config.RetractedEventsConfig
+	LeaderElection       config.LeaderElectionConfig
+	LockerReservationTTL time.Duration
 }
 
 type eventsHandler struct {
-	locker            LockService
-	publisher         Publisher
-	metricsHandler    common.StatusMetricsHandler
-	eventsInterceptor EventsInterceptor
-	checkDuplicates   bool
+	locker              LockService
+	publisher           Publisher
+	metricsHandler      common.StatusMetricsHandler
+	eventsInterceptor   EventsInterceptor
+	deliveryTracker     common.DeliveryTracker
+	leaderElector       LeaderElector
+	txCompletionWatcher common.TxCompletionWatcher
+	blocksBackfiller    common.BlocksBackfiller
+	checkDuplicates     bool
+	enabledStreams      common.EnabledStreams
+	maxRetryDuration    time.Duration
+	failOpen            bool
+	circuitBreaker      *circuitBreaker
+	blocksOrderer       *blocksOrderer
+	publishedBlocks     *publishedBlocksCache
+	followerEvents      *followerEventsBuffer
 }
 
 // NewEventsHandler creates a new events handler component
@@ -49,13 +105,55 @@ func NewEventsHandler(args ArgsEventsHandler) (*eventsHandler, error) {
 		return nil, err
 	}
 
-	return &eventsHandler{
-		locker:            args.Locker,
-		publisher:         args.Publisher,
-		metricsHandler:    args.StatusMetricsHandler,
-		eventsInterceptor: args.EventsInterceptor,
-		checkDuplicates:   args.CheckDuplicates,
-	}, nil
+	enabledStreams, err := common.NewEnabledStreams(args.EnabledStreams)
+	if err != nil {
+		return nil, err
+	}
+	if enabledStreams.IsEnabled(common.RetractedEvents) && args.RetractedEvents.MaxCachedBlocks == 0 {
+		return nil, fmt.Errorf("%w for RetractedEvents.MaxCachedBlocks", ErrInvalidValue)
+	}
+	if args.LeaderElection.Enabled && args.LeaderElection.MaxFollowerBufferedEvents == 0 {
+		return nil, fmt.Errorf("%w for LeaderElection.MaxFollowerBufferedEvents", ErrInvalidValue)
+	}
+
+	retryPolicy := args.LockerRetryPolicy
+	circuitOpenDuration := time.Millisecond * time.Duration(retryPolicy.CircuitBreakerOpenDurationInMs)
+
+	eh := &eventsHandler{
+		locker:              args.Locker,
+		publisher:           args.Publisher,
+		metricsHandler:      args.StatusMetricsHandler,
+		eventsInterceptor:   args.EventsInterceptor,
+		deliveryTracker:     args.DeliveryTracker,
+		leaderElector:       args.LeaderElector,
+		txCompletionWatcher: args.TxCompletionWatcher,
+		blocksBackfiller:    args.BlocksBackfiller,
+		checkDuplicates:     args.CheckDuplicates,
+		enabledStreams:      enabledStreams,
+		maxRetryDuration:    time.Millisecond * time.Duration(retryPolicy.MaxRetryDurationInMs),
+		failOpen:            retryPolicy.FailurePolicy == common.FailOpenPolicy,
+		circuitBreaker:      newCircuitBreaker(retryPolicy.CircuitBreakerThreshold, circuitOpenDuration),
+	}
+
+	if args.BlocksOrdering.Enabled {
+		gapTimeout := time.Millisecond * time.Duration(args.BlocksOrdering.GapTimeoutInMs)
+		eh.blocksOrderer = newBlocksOrderer(args.BlocksOrdering.MaxBufferedBlocks, gapTimeout, eh.publishOrderedBlock, eh.handleGapDetected)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.RetractedEvents) {
+		eh.publishedBlocks = newPublishedBlocksCache(args.RetractedEvents.MaxCachedBlocks)
+	}
+
+	if args.LeaderElection.Enabled {
+		// without duplicates checking, the replayed events are published right away
+		verifyDelay := time.Duration(0)
+		if args.CheckDuplicates {
+			verifyDelay = args.LockerReservationTTL
+		}
+		eh.followerEvents = newFollowerEventsBuffer(args.LeaderElection.MaxFollowerBufferedEvents, verifyDelay)
+	}
+
+	return eh, nil
 }
 
 func checkArgs(args ArgsEventsHandler) error {
@@ -71,6 +169,32 @@ func checkArgs(args ArgsEventsHandler) error {
 	if check.IfNil(args.EventsInterceptor) {
 		return ErrNilEventsInterceptor
 	}
+	if check.IfNil(args.DeliveryTracker) {
+		return ErrNilDeliveryTracker
+	}
+	if check.IfNil(args.LeaderElector) {
+		return ErrNilLeaderElector
+	}
+	if check.IfNil(args.TxCompletionWatcher) {
+		return ErrNilTxCompletionWatcher
+	}
+	if check.IfNil(args.BlocksBackfiller) {
+		return ErrNilBlocksBackfiller
+	}
+
+	if args.BlocksOrdering.Enabled && args.BlocksOrdering.MaxBufferedBlocks == 0 {
+		return fmt.Errorf("%w for BlocksOrdering.MaxBufferedBlocks", ErrInvalidValue)
+	}
+	if args.BlocksOrdering.Enabled && args.BlocksOrdering.GapTimeoutInMs == 0 {
+		return fmt.Errorf("%w for BlocksOrdering.GapTimeoutInMs", ErrInvalidValue)
+	}
+
+	switch args.LockerRetryPolicy.FailurePolicy {
+	// fail-closed is the default policy, so that no event is lost nor duplicated
+	case common.FailClosedPolicy, common.FailOpenPolicy, "":
+	default:
+		return fmt.Errorf("%w: %s", common.ErrInvalidFailurePolicy, args.LockerRetryPolicy.FailurePolicy)
+	}
 
 	return nil
 }
@@ -78,7 +202,21 @@ func checkArgs(args ArgsEventsHandler) error {
 // HandleSaveBlockEvents will handle save block events received from observer
 func (eh *eventsHandler) HandleSaveBlockEvents(allEvents data.ArgsSaveBlockData) error {
 	blockHash := hex.EncodeToString(allEvents.HeaderHash)
-	shouldProcessPushEvents := eh.shouldProcessSaveBlockEvents(blockHash)
+	replay := func() error {
+		return eh.HandleSaveBlockEvents(allEvents)
+	}
+	if !eh.checkLeadership(common.PushLogsAndEvents, blockHash, replay) {
+		return nil
+	}
+
+	if eh.blocksOrderer != nil {
+		return eh.handleOrderedSaveBlockEvents(allEvents)
+	}
+
+	shouldProcessPushEvents, err := eh.shouldProcessSaveBlockEvents(blockHash)
+	if err != nil {
+		return err
+	}
 	if !shouldProcessPushEvents {
 		return nil
 	}
@@ -88,40 +226,219 @@ func (eh *eventsHandler) HandleSaveBlockEvents(allEvents data.ArgsSaveBlockData)
 		return err
 	}
 
-	pushEvents := data.BlockEvents{
-		Hash:      eventsData.Hash,
-		ShardID:   eventsData.Header.GetShardID(),
-		TimeStamp: eventsData.Header.GetTimeStamp(),
-		Events:    eventsData.LogEvents,
+	if eventsData.Hash != "" {
+		eh.trackDelivery(common.PushLogsAndEvents, blockHash, eh.getEnabledSaveBlockStreams(eventsData.Header))
 	}
-	err = eh.handlePushEvents(pushEvents)
+
+	return eh.publishBlockEvents(eventsData)
+}
+
+// handleOrderedSaveBlockEvents adds the block to the blocks orderer. The block is reserved,
+// and its delivery is tracked, only when it is published in order.
+func (eh *eventsHandler) handleOrderedSaveBlockEvents(allEvents data.ArgsSaveBlockData) error {
+	eventsData, err := eh.eventsInterceptor.ProcessBlockEvents(&allEvents)
 	if err != nil {
 		return err
 	}
 
-	txs := data.BlockTxs{
-		Hash: eventsData.Hash,
-		Txs:  eventsData.Txs,
+	if eventsData.Hash == "" {
+		return eh.publishBlockEvents(eventsData)
 	}
-	eh.handleBlockTxs(txs)
 
-	scrs := data.BlockScrs{
-		Hash: eventsData.Hash,
-		Scrs: eventsData.Scrs,
+	err = eh.blocksOrderer.addBlock(eventsData)
+	eh.metricsHandler.SetGauge(orderingBufferedBlocksMetricID, uint64(eh.blocksOrderer.numBufferedBlocks()))
+
+	return err
+}
+
+// publishBlockEvents publishes the enabled output streams of a saved block
+func (eh *eventsHandler) publishBlockEvents(eventsData *data.InterceptorBlockData) error {
+	if eh.enabledStreams.IsEnabled(common.PushLogsAndEvents) {
+		pushEvents := data.BlockEvents{
+			Hash:      eventsData.Hash,
+			ShardID:   eventsData.Header.GetShardID(),
+			Nonce:     eventsData.Header.GetNonce(),
+			TimeStamp: eventsData.Header.GetTimeStamp(),
+			Events:    getAllEvents(eventsData),
+		}
+		err := eh.handlePushEvents(pushEvents)
+		if err != nil {
+			return err
+		}
 	}
-	eh.handleBlockScrs(scrs)
 
-	txsWithOrder := data.BlockEventsWithOrder{
+	if eh.enabledStreams.IsEnabled(common.BlockTxs) {
+		txs := data.BlockTxs{
+			Hash:    eventsData.Hash,
+			ShardID: eventsData.Header.GetShardID(),
+			Nonce:   eventsData.Header.GetNonce(),
+			Txs:     eventsData.Txs,
+		}
+		eh.handleBlockTxs(txs)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockScrs) {
+		scrs := data.BlockScrs{
+			Hash:    eventsData.Hash,
+			ShardID: eventsData.Header.GetShardID(),
+			Nonce:   eventsData.Header.GetNonce(),
+			Scrs:    eventsData.Scrs,
+		}
+		eh.handleBlockScrs(scrs)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockEvents) {
+		txsWithOrder := data.BlockEventsWithOrder{
+			Hash:      eventsData.Hash,
+			ShardID:   eventsData.Header.GetShardID(),
+			Nonce:     eventsData.Header.GetNonce(),
+			TimeStamp: eventsData.Header.GetTimeStamp(),
+			Txs:       eventsData.TxsWithOrder,
+			Scrs:      eventsData.ScrsWithOrder,
+			Events:    eventsData.LogEvents,
+		}
+		eh.handleBlockEventsWithOrder(txsWithOrder)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockRewards) {
+		rewards := data.BlockRewards{
+			Hash:    eventsData.Hash,
+			ShardID: eventsData.Header.GetShardID(),
+			Nonce:   eventsData.Header.GetNonce(),
+			Rewards: eventsData.Rewards,
+		}
+		eh.handleBlockRewards(rewards)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockReceipts) {
+		receipts := data.BlockReceipts{
+			Hash:     eventsData.Hash,
+			ShardID:  eventsData.Header.GetShardID(),
+			Nonce:    eventsData.Header.GetNonce(),
+			Receipts: eventsData.Receipts,
+		}
+		eh.handleBlockReceipts(receipts)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockInvalidTxs) {
+		invalidTxs := data.BlockInvalidTxs{
+			Hash:       eventsData.Hash,
+			ShardID:    eventsData.Header.GetShardID(),
+			Nonce:      eventsData.Header.GetNonce(),
+			InvalidTxs: eventsData.InvalidTxs,
+		}
+		eh.handleBlockInvalidTxs(invalidTxs)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.AlteredAccounts) {
+		alteredAccounts := data.BlockAlteredAccounts{
+			Hash:            eventsData.Hash,
+			ShardID:         eventsData.Header.GetShardID(),
+			Nonce:           eventsData.Header.GetNonce(),
+			AlteredAccounts: eventsData.AlteredAccounts,
+		}
+		eh.handleAlteredAccounts(alteredAccounts)
+	}
+
+	if eh.enabledStreams.IsEnabled(common.BlockHeaders) {
+		eh.handleBlockHeader(getBlockHeader(eventsData))
+	}
+
+	if eh.enabledStreams.IsEnabled(common.FailedExecutions) {
+		failedExecutions := data.BlockFailedExecutions{
+			Hash:             eventsData.Hash,
+			ShardID:          eventsData.Header.GetShardID(),
+			Nonce:            eventsData.Header.GetNonce(),
+			TimeStamp:        eventsData.Header.GetTimeStamp(),
+			FailedExecutions: eventsData.FailedExecutions,
+		}
+		eh.handleFailedExecutions(failedExecutions)
+	}
+
+	if eh.isEpochStartEnabled(eventsData.Header) {
+		eh.handleEpochStart(getEpochStart(eventsData))
+	}
+
+	if eh.enabledStreams.IsEnabled(common.TxCompleted) {
+		eh.publishCompletedTxs(eh.txCompletionWatcher.ProcessBlock(eventsData))
+	}
+
+	if eh.publishedBlocks != nil {
+		eh.publishedBlocks.put(eh.getPublishedPayload(eventsData))
+		eh.metricsHandler.SetGauge(retractedCachedBlocksMetricID, uint64(eh.publishedBlocks.len()))
+	}
+
+	eh.blocksBackfiller.BlockPublished(eventsData.Header.GetShardID(), eventsData.Header.GetNonce())
+
+	return nil
+}
+
+// getPublishedPayload returns the events, transactions and smart contract results published
+// for a block, for the enabled streams
+func (eh *eventsHandler) getPublishedPayload(eventsData *data.InterceptorBlockData) *data.RetractedEvents {
+	payload := &data.RetractedEvents{
 		Hash:      eventsData.Hash,
 		ShardID:   eventsData.Header.GetShardID(),
+		Nonce:     eventsData.Header.GetNonce(),
+		Round:     eventsData.Header.GetRound(),
+		Epoch:     eventsData.Header.GetEpoch(),
 		TimeStamp: eventsData.Header.GetTimeStamp(),
-		Txs:       eventsData.TxsWithOrder,
-		Scrs:      eventsData.ScrsWithOrder,
-		Events:    eventsData.LogEvents,
+		Events:    make([]data.Event, 0),
 	}
-	eh.handleBlockEventsWithOrder(txsWithOrder)
 
-	return nil
+	if eh.enabledStreams.IsEnabled(common.PushLogsAndEvents) || eh.enabledStreams.IsEnabled(common.BlockEvents) {
+		payload.Events = append(payload.Events, eventsData.LogEvents...)
+	}
+	if eh.enabledStreams.IsEnabled(common.BlockTxs) {
+		payload.Txs = eventsData.Txs
+	}
+	if eh.enabledStreams.IsEnabled(common.BlockScrs) {
+		payload.Scrs = eventsData.Scrs
+	}
+
+	return payload
+}
+
+// publishOrderedBlock publishes a block delivered by the blocks orderer. An error keeps the
+// block in the orderer, which retries it later.
+func (eh *eventsHandler) publishOrderedBlock(eventsData *data.InterceptorBlockData) error {
+	// the leadership might have been lost while the block was buffered
+	if !eh.isLeader(common.PushLogsAndEvents, eventsData.Hash) {
+		return nil
+	}
+
+	shouldProcessPushEvents, err := eh.shouldProcessSaveBlockEvents(eventsData.Hash)
+	if err != nil {
+		return err
+	}
+	if !shouldProcessPushEvents {
+		return nil
+	}
+
+	eh.trackDelivery(common.PushLogsAndEvents, eventsData.Hash, eh.getEnabledSaveBlockStreams(eventsData.Header))
+
+	return eh.publishBlockEvents(eventsData)
+}
+
+func (eh *eventsHandler) handleGapDetected(gap data.GapDetected) {
+	eh.metricsHandler.AddRequest(orderingGapMetricID, 0)
+	eh.metricsHandler.SetGauge(orderingLastGapSizeMetricID, gap.MissingNonces)
+	// the gaps are also skipped by the periodic check, when no block is added
+	eh.metricsHandler.SetGauge(orderingBufferedBlocksMetricID, uint64(eh.blocksOrderer.numBufferedBlocks()))
+
+	if !eh.enabledStreams.IsEnabled(common.GapDetected) {
+		return
+	}
+
+	log.Info("received", "event", common.GapDetected,
+		"shard", gap.ShardID,
+		"last nonce", gap.LastNonce,
+		"next nonce", gap.NextNonce,
+	)
+
+	t := time.Now()
+	eh.publisher.BroadcastGapDetected(gap)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.GapDetected), time.Since(t))
 }
 
 // HandlePushEvents will handle push events received from observer
@@ -150,10 +467,106 @@ func (eh *eventsHandler) handlePushEvents(events data.BlockEvents) error {
 	return nil
 }
 
-func (eh *eventsHandler) shouldProcessSaveBlockEvents(blockHash string) bool {
+// isLeader returns true if the current instance should publish the events. The
+// follower instances keep receiving the events, without publishing them.
+func (eh *eventsHandler) isLeader(id string, blockHash string) bool {
+	if eh.leaderElector.IsLeader() {
+		return true
+	}
+
+	log.Debug("not leader instance", "event", id,
+		"block hash", blockHash,
+		"will process", false,
+	)
+
+	return false
+}
+
+// checkLeadership returns true if the current instance is the leader, after replaying the events
+// received while it was a follower. Otherwise, the event is buffered, to be replayed if the
+// instance takes over, since the leader might have crashed before publishing it.
+func (eh *eventsHandler) checkLeadership(id string, hash string, replay followerEvent) bool {
+	if !eh.isLeader(id, hash) {
+		eh.bufferFollowerEvent(replay)
+		return false
+	}
+
+	eh.replayFollowerEvents()
+
+	return true
+}
+
+func (eh *eventsHandler) bufferFollowerEvent(event followerEvent) {
+	if eh.followerEvents == nil {
+		return
+	}
+
+	numDropped := eh.followerEvents.add(event)
+	if numDropped > 0 {
+		log.Warn("follower events buffer is full, dropped the oldest events", "num dropped", numDropped)
+		eh.metricsHandler.AddRequest(followerDroppedEventsMetricID, 0)
+	}
+	eh.metricsHandler.SetGauge(followerBufferedEventsMetricID, uint64(eh.followerEvents.len()))
+}
+
+// replayFollowerEvents handles the events received while the instance was a follower. The events
+// already handled by the previous leader are skipped by the duplicates check. The replayed events
+// are replayed once more, after the locker reservations of the previous leader have expired, since
+// they might have been reserved, but not published.
+func (eh *eventsHandler) replayFollowerEvents() {
+	if eh.followerEvents == nil {
+		return
+	}
+
+	events, verifyEvents := eh.followerEvents.takeEvents()
+	if len(events) == 0 && len(verifyEvents) == 0 {
+		return
+	}
+
+	log.Info("replaying the events received as follower",
+		"num events", len(events),
+		"num verified events", len(verifyEvents),
+	)
+
+	failedEvents := make([]followerEvent, 0)
+	replayedEvents := make([]followerEvent, 0, len(events))
+	for _, event := range events {
+		err := event()
+		if err != nil {
+			log.Warn("could not replay follower event, will retry", "error", err)
+			failedEvents = append(failedEvents, event)
+			continue
+		}
+		replayedEvents = append(replayedEvents, event)
+	}
+	for _, event := range verifyEvents {
+		err := event()
+		if err != nil {
+			log.Warn("could not replay follower event, will retry", "error", err)
+			failedEvents = append(failedEvents, event)
+		}
+	}
+
+	eh.followerEvents.scheduleVerify(replayedEvents)
+	if len(failedEvents) > 0 {
+		numDropped := eh.followerEvents.putBack(failedEvents)
+		if numDropped > 0 {
+			eh.metricsHandler.AddRequest(followerDroppedEventsMetricID, 0)
+		}
+	}
+
+	eh.metricsHandler.AddRequest(followerReplayedEventsMetricID, 0)
+	eh.metricsHandler.SetGauge(followerBufferedEventsMetricID, uint64(eh.followerEvents.len()))
+}
+
+func (eh *eventsHandler) shouldProcessSaveBlockEvents(blockHash string) (bool, error) {
 	shouldProcessEvents := true
 	if eh.checkDuplicates {
-		shouldProcessEvents = eh.tryCheckProcessedWithRetry(common.PushLogsAndEvents, blockHash)
+		var err error
+		shouldProcessEvents, err = eh.tryCheckProcessedWithRetry(common.PushLogsAndEvents, blockHash)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	if !shouldProcessEvents {
@@ -162,24 +575,45 @@ func (eh *eventsHandler) shouldProcessSaveBlockEvents(blockHash string) bool {
 			"will process", false,
 		)
 
-		return false
+		return false, nil
 	}
 
-	return true
+	return true, nil
 }
 
 // HandleRevertEvents will handle revents events received from observer
-func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
+func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) error {
+	if eh.removeBufferedBlock(revertBlock) {
+		return nil
+	}
+
+	isRevertEnabled := eh.enabledStreams.IsEnabled(common.RevertBlockEvents)
+	isRetractEnabled := eh.enabledStreams.IsEnabled(common.RetractedEvents)
+	if !isRevertEnabled && !isRetractEnabled {
+		return nil
+	}
+
 	if revertBlock.Hash == "" {
 		log.Warn("received empty hash", "event", common.RevertBlockEvents,
 			"will process", false,
 		)
-		return
+		return nil
+	}
+
+	replay := func() error {
+		return eh.HandleRevertEvents(revertBlock)
+	}
+	if !eh.checkLeadership(common.RevertBlockEvents, revertBlock.Hash, replay) {
+		return nil
 	}
 
 	shouldProcessRevert := true
 	if eh.checkDuplicates {
-		shouldProcessRevert = eh.tryCheckProcessedWithRetry(common.RevertBlockEvents, revertBlock.Hash)
+		var err error
+		shouldProcessRevert, err = eh.tryCheckProcessedWithRetry(common.RevertBlockEvents, revertBlock.Hash)
+		if err != nil {
+			return err
+		}
 	}
 
 	if !shouldProcessRevert {
@@ -187,7 +621,7 @@ func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
 			"block hash", revertBlock.Hash,
 			"will process", false,
 		)
-		return
+		return nil
 	}
 
 	log.Info("received", "event", common.RevertBlockEvents,
@@ -195,22 +629,122 @@ func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
 		"will process", shouldProcessRevert,
 	)
 
-	t := time.Now()
-	eh.publisher.BroadcastRevert(revertBlock)
-	eh.metricsHandler.AddRequest(getRabbitOpID(common.RevertBlockEvents), time.Since(t))
+	streams := make([]string, 0, 2)
+	if isRevertEnabled {
+		streams = append(streams, common.RevertBlockEvents)
+	}
+
+	var retractedEvents *data.RetractedEvents
+	if isRetractEnabled {
+		retractedEvents = eh.getRetractedEvents(revertBlock)
+		if retractedEvents != nil {
+			streams = append(streams, common.RetractedEvents)
+		}
+	}
+
+	eh.trackDelivery(common.RevertBlockEvents, revertBlock.Hash, streams)
+
+	if isRevertEnabled {
+		t := time.Now()
+		eh.publisher.BroadcastRevert(revertBlock)
+		eh.metricsHandler.AddRequest(getRabbitOpID(common.RevertBlockEvents), time.Since(t))
+	}
+
+	if retractedEvents != nil {
+		t := time.Now()
+		eh.publisher.BroadcastRetractedEvents(*retractedEvents)
+		eh.metricsHandler.AddRequest(getRabbitOpID(common.RetractedEvents), time.Since(t))
+	}
+
+	return nil
+}
+
+// getRetractedEvents returns the payload previously published for the reverted block, or nil if
+// the block was not published by this instance, or was evicted from the cache
+// removeBufferedBlock drops the reverted block from the blocks orderer, if it was not published yet.
+// It returns true if the block was dropped, in which case there is nothing to revert.
+func (eh *eventsHandler) removeBufferedBlock(revertBlock data.RevertBlock) bool {
+	if eh.blocksOrderer == nil || revertBlock.Hash == "" {
+		return false
+	}
+
+	removed := eh.blocksOrderer.removeBlock(revertBlock.Hash, revertBlock.ShardID, revertBlock.Nonce)
+	if !removed {
+		return false
+	}
+
+	log.Info("dropped reverted block before publishing it",
+		"block hash", revertBlock.Hash,
+		"shard", revertBlock.ShardID,
+		"nonce", revertBlock.Nonce,
+	)
+	eh.metricsHandler.SetGauge(orderingBufferedBlocksMetricID, uint64(eh.blocksOrderer.numBufferedBlocks()))
+
+	return true
+}
+
+func (eh *eventsHandler) getRetractedEvents(revertBlock data.RevertBlock) *data.RetractedEvents {
+	retractedEvents, ok := eh.publishedBlocks.pop(revertBlock.Hash)
+	eh.metricsHandler.SetGauge(retractedCachedBlocksMetricID, uint64(eh.publishedBlocks.len()))
+	if !ok {
+		log.Warn("no published events found for reverted block", "event", common.RetractedEvents,
+			"block hash", revertBlock.Hash,
+		)
+		eh.metricsHandler.AddRequest(retractedCacheMissMetricID, 0)
+		return nil
+	}
+
+	log.Info("received", "event", common.RetractedEvents,
+		"block hash", revertBlock.Hash,
+		"num events", len(retractedEvents.Events),
+	)
+
+	return retractedEvents
 }
 
 // HandleFinalizedEvents will handle finalized events received from observer
-func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) {
+func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error {
+	isFinalizedEnabled := eh.enabledStreams.IsEnabled(common.FinalizedBlockEvents)
+	if !isFinalizedEnabled && !eh.enabledStreams.IsEnabled(common.TxCompleted) {
+		return nil
+	}
+
+	if finalizedBlock.Hash != "" {
+		replay := func() error {
+			return eh.HandleFinalizedEvents(finalizedBlock)
+		}
+		if !eh.checkLeadership(common.FinalizedBlockEvents, finalizedBlock.Hash, replay) {
+			return nil
+		}
+	}
+
+	err := eh.handleCompletedTxs(finalizedBlock.Hash)
+	if err != nil {
+		return err
+	}
+
+	if !isFinalizedEnabled {
+		return nil
+	}
+
 	if finalizedBlock.Hash == "" {
 		log.Warn("received empty hash", "event", common.FinalizedBlockEvents,
 			"will process", false,
 		)
-		return
+		return nil
 	}
+
+	if !eh.isLeader(common.FinalizedBlockEvents, finalizedBlock.Hash) {
+		return nil
+	}
+
 	shouldProcessFinalized := true
 	if eh.checkDuplicates {
-		shouldProcessFinalized = eh.tryCheckProcessedWithRetry(common.FinalizedBlockEvents, finalizedBlock.Hash)
+		var err error
+		shouldProcessFinalized, err = eh.tryCheckProcessedWithRetry(common.FinalizedBlockEvents, finalizedBlock.Hash)
+		if err != nil {
+			return err
+		}
 	}
 
 	if !shouldProcessFinalized {
@@ -218,7 +752,7 @@ func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBloc
 			"block hash", finalizedBlock.Hash,
 			"will process", false,
 		)
-		return
+		return nil
 	}
 
 	log.Info("received", "event", common.FinalizedBlockEvents,
@@ -226,9 +760,113 @@ func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBloc
 		"will process", shouldProcessFinalized,
 	)
 
+	eh.trackDelivery(common.FinalizedBlockEvents, finalizedBlock.Hash, []string{common.FinalizedBlockEvents})
+
 	t := time.Now()
 	eh.publisher.BroadcastFinalized(finalizedBlock)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.FinalizedBlockEvents), time.Since(t))
+
+	return nil
+}
+
+// HandleRoundsInfo will handle rounds info events received from observer
+func (eh *eventsHandler) HandleRoundsInfo(roundsInfo data.RoundsInfo) error {
+	if !eh.enabledStreams.IsEnabled(common.RoundsInfo) {
+		return nil
+	}
+
+	replay := func() error {
+		return eh.HandleRoundsInfo(roundsInfo)
+	}
+	shouldProcess, err := eh.shouldProcessEvent(common.RoundsInfo, roundsInfo.ID, replay)
+	if err != nil || !shouldProcess {
+		return err
+	}
+
+	eh.trackDelivery(common.RoundsInfo, roundsInfo.ID, []string{common.RoundsInfo})
+
+	t := time.Now()
+	eh.publisher.BroadcastRoundsInfo(roundsInfo)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.RoundsInfo), time.Since(t))
+
+	return nil
+}
+
+// HandleValidatorsRating will handle validators rating events received from observer
+func (eh *eventsHandler) HandleValidatorsRating(validatorsRating data.ValidatorsRating) error {
+	if !eh.enabledStreams.IsEnabled(common.ValidatorsRating) {
+		return nil
+	}
+
+	replay := func() error {
+		return eh.HandleValidatorsRating(validatorsRating)
+	}
+	shouldProcess, err := eh.shouldProcessEvent(common.ValidatorsRating, validatorsRating.ID, replay)
+	if err != nil || !shouldProcess {
+		return err
+	}
+
+	eh.trackDelivery(common.ValidatorsRating, validatorsRating.ID, []string{common.ValidatorsRating})
+
+	t := time.Now()
+	eh.publisher.BroadcastValidatorsRating(validatorsRating)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.ValidatorsRating), time.Since(t))
+
+	return nil
+}
+
+// HandleValidatorsPubKeys will handle validators public keys events received from observer
+func (eh *eventsHandler) HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error {
+	if !eh.enabledStreams.IsEnabled(common.ValidatorsPubKeys) {
+		return nil
+	}
+
+	replay := func() error {
+		return eh.HandleValidatorsPubKeys(validatorsPubKeys)
+	}
+	shouldProcess, err := eh.shouldProcessEvent(common.ValidatorsPubKeys, validatorsPubKeys.ID, replay)
+	if err != nil || !shouldProcess {
+		return err
+	}
+
+	eh.trackDelivery(common.ValidatorsPubKeys, validatorsPubKeys.ID, []string{common.ValidatorsPubKeys})
+
+	t := time.Now()
+	eh.publisher.BroadcastValidatorsPubKeys(validatorsPubKeys)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.ValidatorsPubKeys), time.Since(t))
+
+	return nil
+}
+
+// shouldProcessEvent checks the leadership and the duplicates for the events which are not
+// part of the save block flow, identified by the provided id
+func (eh *eventsHandler) shouldProcessEvent(stream string, id string, replay followerEvent) (bool, error) {
+	if id == "" {
+		log.Warn("received empty id", "event", stream,
+			"will process", false,
+		)
+		return false, nil
+	}
+
+	if !eh.checkLeadership(stream, id, replay) {
+		return false, nil
+	}
+
+	shouldProcess := true
+	if eh.checkDuplicates {
+		var err error
+		shouldProcess, err = eh.tryCheckProcessedWithRetry(stream, id)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	log.Info("received", "event", stream,
+		"id", id,
+		"will process", shouldProcess,
+	)
+
+	return shouldProcess, nil
 }
 
 // handleBlockTxs will handle txs events received from observer
@@ -297,35 +935,426 @@ func (eh *eventsHandler) handleBlockEventsWithOrder(blockTxs data.BlockEventsWit
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockEvents), time.Since(t))
 }
 
-func (eh *eventsHandler) tryCheckProcessedWithRetry(id, blockHash string) bool {
-	var err error
-	var setSuccessful bool
+// handleBlockRewards will handle rewards events received from observer
+func (eh *eventsHandler) handleBlockRewards(blockRewards data.BlockRewards) {
+	if blockRewards.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockRewards,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockRewards.Rewards) == 0 {
+		log.Debug("received empty events", "event", common.BlockRewards,
+			"block hash", blockRewards.Hash,
+		)
+	} else {
+		log.Info("received", "event", common.BlockRewards,
+			"block hash", blockRewards.Hash,
+		)
+	}
+
+	t := time.Now()
+	eh.publisher.BroadcastRewards(blockRewards)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockRewards), time.Since(t))
+}
+
+// handleBlockReceipts will handle receipts events received from observer
+func (eh *eventsHandler) handleBlockReceipts(blockReceipts data.BlockReceipts) {
+	if blockReceipts.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockReceipts,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockReceipts.Receipts) == 0 {
+		log.Debug("received empty events", "event", common.BlockReceipts,
+			"block hash", blockReceipts.Hash,
+		)
+	} else {
+		log.Info("received", "event", common.BlockReceipts,
+			"block hash", blockReceipts.Hash,
+		)
+	}
+
+	t := time.Now()
+	eh.publisher.BroadcastReceipts(blockReceipts)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockReceipts), time.Since(t))
+}
+
+// handleBlockInvalidTxs will handle invalid txs events received from observer
+func (eh *eventsHandler) handleBlockInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	if blockInvalidTxs.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockInvalidTxs,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockInvalidTxs.InvalidTxs) == 0 {
+		log.Debug("received empty events", "event", common.BlockInvalidTxs,
+			"block hash", blockInvalidTxs.Hash,
+		)
+	} else {
+		log.Info("received", "event", common.BlockInvalidTxs,
+			"block hash", blockInvalidTxs.Hash,
+		)
+	}
+
+	t := time.Now()
+	eh.publisher.BroadcastInvalidTxs(blockInvalidTxs)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockInvalidTxs), time.Since(t))
+}
+
+// handleAlteredAccounts will handle altered accounts events received from observer
+func (eh *eventsHandler) handleAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+	if alteredAccounts.Hash == "" {
+		log.Warn("received empty hash", "event", common.AlteredAccounts,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(alteredAccounts.AlteredAccounts) == 0 {
+		log.Debug("received empty events", "event", common.AlteredAccounts,
+			"block hash", alteredAccounts.Hash,
+		)
+	} else {
+		log.Info("received", "event", common.AlteredAccounts,
+			"block hash", alteredAccounts.Hash,
+			"num accounts", len(alteredAccounts.AlteredAccounts),
+		)
+	}
+
+	t := time.Now()
+	eh.publisher.BroadcastAlteredAccounts(alteredAccounts)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.AlteredAccounts), time.Since(t))
+}
+
+// handleFailedExecutions will handle failed executions events received from observer
+func (eh *eventsHandler) handleFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+	if failedExecutions.Hash == "" {
+		log.Warn("received empty hash", "event", common.FailedExecutions,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(failedExecutions.FailedExecutions) == 0 {
+		log.Debug("received empty events", "event", common.FailedExecutions,
+			"block hash", failedExecutions.Hash,
+		)
+		failedExecutions.FailedExecutions = make([]data.FailedExecution, 0)
+	} else {
+		log.Info("received", "event", common.FailedExecutions,
+			"block hash", failedExecutions.Hash,
+			"num failed executions", len(failedExecutions.FailedExecutions),
+		)
+	}
+
+	t := time.Now()
+	eh.publisher.BroadcastFailedExecutions(failedExecutions)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.FailedExecutions), time.Since(t))
+}
+
+// handleBlockHeader will handle block header events received from observer
+func (eh *eventsHandler) handleBlockHeader(blockHeader data.BlockHeader) {
+	if blockHeader.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockHeaders,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Info("received", "event", common.BlockHeaders,
+		"block hash", blockHeader.Hash,
+		"shard", blockHeader.ShardID,
+		"nonce", blockHeader.Nonce,
+	)
+
+	t := time.Now()
+	eh.publisher.BroadcastBlockHeader(blockHeader)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockHeaders), time.Since(t))
+}
+
+// getAllEvents returns the events published on the all events stream, enriched with their
+// context if the events enrichment is enabled
+func getAllEvents(eventsData *data.InterceptorBlockData) []data.Event {
+	if eventsData.EnrichedLogEvents != nil {
+		return eventsData.EnrichedLogEvents
+	}
+
+	return eventsData.LogEvents
+}
+
+func getBlockHeader(eventsData *data.InterceptorBlockData) data.BlockHeader {
+	header := eventsData.Header
+
+	blockHeader := data.BlockHeader{
+		Hash:           eventsData.Hash,
+		ShardID:        header.GetShardID(),
+		Nonce:          header.GetNonce(),
+		Round:          header.GetRound(),
+		Epoch:          header.GetEpoch(),
+		PrevHash:       hex.EncodeToString(header.GetPrevHash()),
+		TimeStamp:      header.GetTimeStamp(),
+		TxCount:        header.GetTxCount(),
+		SignersIndexes: eventsData.SignersIndexes,
+		GasConsumption: eventsData.HeaderGasConsumption,
+	}
+
+	if header.GetShardID() == core.MetachainShardId {
+		blockHeader.NotarizedHeadersHashes = eventsData.NotarizedHeadersHashes
+	}
+
+	return blockHeader
+}
+
+// isEpochStartEnabled returns true if the epoch start stream is enabled and the header starts a new epoch
+func (eh *eventsHandler) isEpochStartEnabled(header nodeData.HeaderHandler) bool {
+	if !eh.enabledStreams.IsEnabled(common.EpochStart) || check.IfNil(header) {
+		return false
+	}
+
+	return header.IsStartOfEpochBlock()
+}
+
+// handleCompletedTxs will publish the watched transactions completed by the finalized block.
+// The finalized block is recorded by the watcher even if it was already handled, but the
+// completed transactions are published only once.
+func (eh *eventsHandler) handleCompletedTxs(blockHash string) error {
+	if !eh.enabledStreams.IsEnabled(common.TxCompleted) || blockHash == "" {
+		return nil
+	}
+
+	if !eh.isLeader(common.TxCompleted, blockHash) {
+		return nil
+	}
+
+	shouldProcess := true
+	if eh.checkDuplicates {
+		var err error
+		shouldProcess, err = eh.tryCheckProcessedWithRetry(common.TxCompleted, blockHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	completedTxs := eh.txCompletionWatcher.ProcessFinalizedBlock(blockHash)
+	if !shouldProcess {
+		log.Info("received duplicated events", "event", common.TxCompleted,
+			"block hash", blockHash,
+			"will process", false,
+		)
+		return nil
+	}
+
+	eh.trackCompletedTxsDelivery(blockHash, completedTxs)
+	eh.publishCompletedTxs(completedTxs)
+
+	return nil
+}
+
+// trackCompletedTxsDelivery registers the completed transactions messages, which are identified
+// by the transaction hashes, under the locker key of the finalized block
+func (eh *eventsHandler) trackCompletedTxsDelivery(blockHash string, completedTxs []data.TxCompleted) {
+	if !eh.checkDuplicates {
+		return
+	}
+
+	messageIDs := make([]string, 0, len(completedTxs))
+	for _, txCompleted := range completedTxs {
+		messageIDs = append(messageIDs, common.GetMessageID(txCompleted.TxHash, common.TxCompleted))
+	}
+
+	eh.deliveryTracker.TrackDelivery(getLockerKey(common.TxCompleted, blockHash), messageIDs)
+}
+
+func (eh *eventsHandler) publishCompletedTxs(completedTxs []data.TxCompleted) {
+	for _, txCompleted := range completedTxs {
+		log.Info("received", "event", common.TxCompleted,
+			"tx hash", txCompleted.TxHash,
+			"status", txCompleted.Status,
+		)
+
+		t := time.Now()
+		eh.publisher.BroadcastTxCompleted(txCompleted)
+		eh.metricsHandler.AddRequest(getRabbitOpID(common.TxCompleted), time.Since(t))
+	}
+}
+
+// handleEpochStart will handle epoch start events detected from the received headers
+func (eh *eventsHandler) handleEpochStart(epochStart data.EpochStart) {
+	if epochStart.Hash == "" {
+		log.Warn("received empty hash", "event", common.EpochStart,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Info("received", "event", common.EpochStart,
+		"block hash", epochStart.Hash,
+		"shard", epochStart.ShardID,
+		"epoch", epochStart.Epoch,
+	)
+
+	t := time.Now()
+	eh.publisher.BroadcastEpochStart(epochStart)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.EpochStart), time.Since(t))
+}
+
+func getEpochStart(eventsData *data.InterceptorBlockData) data.EpochStart {
+	header := eventsData.Header
+
+	epochStart := data.EpochStart{
+		Hash:      eventsData.Hash,
+		ShardID:   header.GetShardID(),
+		Epoch:     header.GetEpoch(),
+		Round:     header.GetRound(),
+		Nonce:     header.GetNonce(),
+		TimeStamp: header.GetTimeStamp(),
+	}
+
+	metaHeader, ok := header.(nodeData.MetaHeaderHandler)
+	if !ok || check.IfNilReflect(metaHeader.GetEpochStartHandler()) {
+		return epochStart
+	}
+
+	economics := metaHeader.GetEpochStartHandler().GetEconomicsHandler()
+	if check.IfNilReflect(economics) {
+		return epochStart
+	}
+
+	epochStart.Economics = &data.EpochStartEconomics{
+		TotalSupply:                      bigIntToString(economics.GetTotalSupply()),
+		TotalToDistribute:                bigIntToString(economics.GetTotalToDistribute()),
+		TotalNewlyMinted:                 bigIntToString(economics.GetTotalNewlyMinted()),
+		RewardsPerBlock:                  bigIntToString(economics.GetRewardsPerBlock()),
+		RewardsForProtocolSustainability: bigIntToString(economics.GetRewardsForProtocolSustainability()),
+		NodePrice:                        bigIntToString(economics.GetNodePrice()),
+		PrevEpochStartRound:              economics.GetPrevEpochStartRound(),
+		PrevEpochStartHash:               hex.EncodeToString(economics.GetPrevEpochStartHash()),
+	}
+
+	return epochStart
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
 
-	prefix := getPrefixLockerKey(id)
-	key := prefix + blockHash
+// tryCheckProcessedWithRetry reserves the event in the locker, retrying on failures. If the
+// locker is unreachable for the max retry duration, or if the circuit breaker is open, the
+// configured failure policy is applied.
+func (eh *eventsHandler) tryCheckProcessedWithRetry(id, blockHash string) (bool, error) {
+	key := getLockerKey(id, blockHash)
+	startTime := time.Now()
 
 	for {
+		if eh.circuitBreaker.isOpen() {
+			return eh.applyFailurePolicy(id, blockHash, ErrLockerCircuitOpen)
+		}
+
 		t := time.Now()
-		setSuccessful, err = eh.locker.IsEventProcessed(context.Background(), key)
+		setSuccessful, err := eh.locker.ReserveEvent(context.Background(), key)
 		eh.metricsHandler.AddRequest(getRedisOpID(id), time.Since(t))
 
 		if err == nil {
-			break
+			eh.handleLockerSuccess()
+			log.Debug("locker", "event", id, "block hash", blockHash, "succeeded", setSuccessful)
+
+			return setSuccessful, nil
 		}
 
 		log.Error("failed to check event in locker", "error", err.Error())
+		eh.handleLockerFailure()
+
+		retryDuration := setRetryDuration
 		if !eh.locker.HasConnection(context.Background()) {
 			log.Error("failure connecting to locker service")
+			retryDuration = reconnectRetryDuration
+		}
 
-			time.Sleep(reconnectRetryDuration)
-		} else {
-			time.Sleep(setRetryDuration)
+		if eh.maxRetryDuration > 0 && time.Since(startTime)+retryDuration > eh.maxRetryDuration {
+			return eh.applyFailurePolicy(id, blockHash, ErrLockerUnavailable)
 		}
+
+		time.Sleep(retryDuration)
 	}
+}
+
+func (eh *eventsHandler) handleLockerSuccess() {
+	wasOpened := eh.circuitBreaker.recordSuccess()
+	if wasOpened {
+		log.Info("locker service is reachable again, closing circuit breaker")
+		eh.metricsHandler.SetGauge(lockerCircuitOpenMetricID, 0)
+	}
+}
 
-	log.Debug("locker", "event", id, "block hash", blockHash, "succeeded", setSuccessful)
+func (eh *eventsHandler) handleLockerFailure() {
+	eh.metricsHandler.AddRequest(lockerFailureMetricID, 0)
 
-	return setSuccessful
+	opened := eh.circuitBreaker.recordFailure()
+	if opened {
+		log.Warn("locker service failed too many times, opening circuit breaker")
+		eh.metricsHandler.SetGauge(lockerCircuitOpenMetricID, 1)
+	}
+}
+
+func (eh *eventsHandler) applyFailurePolicy(id string, blockHash string, err error) (bool, error) {
+	if eh.failOpen {
+		log.Warn("locker service unavailable, processing events without checking duplicates",
+			"event", id, "block hash", blockHash, "reason", err.Error(),
+		)
+		eh.metricsHandler.AddRequest(lockerFailOpenMetricID, 0)
+
+		return true, nil
+	}
+
+	log.Warn("locker service unavailable, rejecting events",
+		"event", id, "block hash", blockHash, "reason", err.Error(),
+	)
+	eh.metricsHandler.AddRequest(lockerFailClosedMetricID, 0)
+
+	return false, err
+}
+
+// trackDelivery registers the messages which have to be delivered before the reserved
+// locker key is committed
+func (eh *eventsHandler) trackDelivery(id string, blockHash string, streams []string) {
+	if !eh.checkDuplicates {
+		return
+	}
+
+	messageIDs := make([]string, 0, len(streams))
+	for _, stream := range streams {
+		messageIDs = append(messageIDs, common.GetMessageID(blockHash, stream))
+	}
+
+	eh.deliveryTracker.TrackDelivery(getLockerKey(id, blockHash), messageIDs)
+}
+
+func (eh *eventsHandler) getEnabledSaveBlockStreams(header nodeData.HeaderHandler) []string {
+	streams := make([]string, 0, len(saveBlockStreams)+1)
+	for _, stream := range saveBlockStreams {
+		if eh.enabledStreams.IsEnabled(stream) {
+			streams = append(streams, stream)
+		}
+	}
+	if eh.isEpochStartEnabled(header) {
+		streams = append(streams, common.EpochStart)
+	}
+
+	return streams
+}
+
+func getLockerKey(id string, blockHash string) string {
+	return getPrefixLockerKey(id) + blockHash
 }
 
 func getPrefixLockerKey(id string) string {
@@ -337,6 +1366,8 @@ func getPrefixLockerKey(id string) string {
 		return revertKeyPrefix
 	case common.FinalizedBlockEvents:
 		return finalizedKeyPrefix
+	case common.RoundsInfo, common.ValidatorsRating, common.ValidatorsPubKeys, common.TxCompleted:
+		return id + "_"
 	}
 
 	return ""
@@ -350,6 +1381,15 @@ func getRedisOpID(operation string) string {
 	return fmt.Sprintf("%s-%s", redisMetricPrefix, operation)
 }
 
+// Close will stop the blocks ordering gaps checking, if enabled
+func (eh *eventsHandler) Close() error {
+	if eh.blocksOrderer != nil {
+		eh.blocksOrderer.close()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (eh *eventsHandler) IsInterfaceNil() bool {
 	return eh == nil
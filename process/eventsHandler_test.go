@@ -2,15 +2,25 @@ package process_test
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/multiversx/mx-chain-notifier-go/process"
@@ -24,13 +34,17 @@ func createMockEventsHandlerArgs() process.ArgsEventsHandler {
 			HasConnectionCalled: func(ctx context.Context) bool {
 				return true
 			},
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				return true, nil
 			},
 		},
 		Publisher:            &mocks.PublisherStub{},
 		StatusMetricsHandler: &mocks.StatusMetricsStub{},
 		EventsInterceptor:    &mocks.EventsInterceptorStub{},
+		DeliveryTracker:      &mocks.DeliveryTrackerStub{},
+		LeaderElector:        &mocks.LeaderElectorStub{},
+		TxCompletionWatcher:  &mocks.TxCompletionWatcherStub{},
+		BlocksBackfiller:     &mocks.BlocksBackfillerStub{},
 	}
 }
 
@@ -81,6 +95,129 @@ func TestNewEventsHandler(t *testing.T) {
 		require.Nil(t, eventsHandler)
 	})
 
+	t.Run("nil delivery tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.DeliveryTracker = nil
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Equal(t, process.ErrNilDeliveryTracker, err)
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("nil leader elector", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.LeaderElector = nil
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Equal(t, process.ErrNilLeaderElector, err)
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("nil tx completion watcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.TxCompletionWatcher = nil
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Equal(t, process.ErrNilTxCompletionWatcher, err)
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("nil blocks backfiller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.BlocksBackfiller = nil
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Equal(t, process.ErrNilBlocksBackfiller, err)
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid enabled stream", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.PushLogsAndEvents, "invalid"}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, common.ErrInvalidStreamName))
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid locker failure policy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.LockerRetryPolicy.FailurePolicy = "invalid"
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, common.ErrInvalidFailurePolicy))
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid blocks ordering max buffered blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.BlocksOrdering = config.BlocksOrderingConfig{
+			Enabled:           true,
+			MaxBufferedBlocks: 0,
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid blocks ordering gap timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.BlocksOrdering = config.BlocksOrderingConfig{
+			Enabled:           true,
+			MaxBufferedBlocks: 10,
+			GapTimeoutInMs:    0,
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid retracted events max cached blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.RevertBlockEvents, common.RetractedEvents}
+		args.RetractedEvents = config.RetractedEventsConfig{
+			MaxCachedBlocks: 0,
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, eventsHandler)
+	})
+
+	t.Run("invalid leader election max follower buffered events", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.LeaderElection = config.LeaderElectionConfig{
+			Enabled:                   true,
+			MaxFollowerBufferedEvents: 0,
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, eventsHandler)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -101,7 +238,7 @@ func TestHandleSaveBlockEvents(t *testing.T) {
 		args.CheckDuplicates = true
 
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				return false, nil
 			},
 		}
@@ -127,7 +264,7 @@ func TestHandleSaveBlockEvents(t *testing.T) {
 		args.CheckDuplicates = true
 
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				return true, nil
 			},
 		}
@@ -208,12 +345,14 @@ func TestHandleSaveBlockEvents(t *testing.T) {
 		}
 
 		expTxsData := data.BlockTxs{
-			Hash: blockHash,
-			Txs:  expTxs,
+			Hash:    blockHash,
+			ShardID: 2,
+			Txs:     expTxs,
 		}
 		expScrsData := data.BlockScrs{
-			Hash: blockHash,
-			Scrs: expScrs,
+			Hash:    blockHash,
+			ShardID: 2,
+			Scrs:    expScrs,
 		}
 		expLogEvents := data.BlockEvents{
 			Hash:    blockHash,
@@ -297,206 +436,1330 @@ func TestHandleSaveBlockEvents(t *testing.T) {
 	})
 }
 
-func TestShouldProcessSaveBlockEvents(t *testing.T) {
+func TestHandleSaveBlockEvents_DisabledStreams(t *testing.T) {
 	t.Parallel()
 
-	t.Run("should process", func(t *testing.T) {
-		t.Parallel()
+	blockData := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash1"),
+		TransactionsPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				"hash1": {Transaction: &transaction.Transaction{Nonce: 1}},
+			},
+		},
+		Header: &block.HeaderV2{},
+	}
+
+	args := createMockEventsHandlerArgs()
+	args.EnabledStreams = []string{common.PushLogsAndEvents, common.RevertBlockEvents}
+	args.EventsInterceptor = &mocks.EventsInterceptorStub{
+		ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+			return &data.InterceptorBlockData{
+				Hash:   "blockHash1",
+				Header: &block.HeaderV2{Header: &block.Header{}},
+			}, nil
+		},
+	}
+
+	broadcastedStreams := make([]string, 0)
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastCalled: func(events data.BlockEvents) {
+			broadcastedStreams = append(broadcastedStreams, common.PushLogsAndEvents)
+		},
+		BroadcastRevertCalled: func(event data.RevertBlock) {
+			broadcastedStreams = append(broadcastedStreams, common.RevertBlockEvents)
+		},
+		BroadcastFinalizedCalled: func(event data.FinalizedBlock) {
+			require.Fail(t, "finalized stream is disabled")
+		},
+		BroadcastTxsCalled: func(event data.BlockTxs) {
+			require.Fail(t, "block txs stream is disabled")
+		},
+		BroadcastScrsCalled: func(event data.BlockScrs) {
+			require.Fail(t, "block scrs stream is disabled")
+		},
+		BroadcastBlockEventsWithOrderCalled: func(event data.BlockEventsWithOrder) {
+			require.Fail(t, "block events stream is disabled")
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+
+	err = eventsHandler.HandleSaveBlockEvents(blockData)
+	require.Nil(t, err)
+	err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: "blockHash1"})
+	require.Nil(t, err)
+	err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "blockHash1"})
+	require.Nil(t, err)
+
+	require.Equal(t, []string{common.PushLogsAndEvents, common.RevertBlockEvents}, broadcastedStreams)
+}
+
+func TestHandleSaveBlockEvents_EnrichedEvents(t *testing.T) {
+	t.Parallel()
+
+	logEvents := []data.Event{
+		{Address: "addr1", TxHash: "txHash1"},
+	}
+	enrichedLogEvents := []data.Event{
+		{Address: "addr1", TxHash: "txHash1", Context: &data.EventContext{Sender: "sender", BlockNonce: 11}},
+	}
+
+	args := createMockEventsHandlerArgs()
+	args.EnabledStreams = []string{common.PushLogsAndEvents, common.BlockEvents}
+	args.EventsInterceptor = &mocks.EventsInterceptorStub{
+		ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+			return &data.InterceptorBlockData{
+				Hash:              "blockHash",
+				Header:            &block.Header{Nonce: 11},
+				LogEvents:         logEvents,
+				EnrichedLogEvents: enrichedLogEvents,
+			}, nil
+		},
+	}
+
+	var allEvents []data.Event
+	var blockEvents []data.Event
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastCalled: func(events data.BlockEvents) {
+			allEvents = events.Events
+		},
+		BroadcastBlockEventsWithOrderCalled: func(event data.BlockEventsWithOrder) {
+			blockEvents = event.Events
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+
+	err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{HeaderHash: []byte("blockHash")})
+	require.Nil(t, err)
+
+	// only the all events stream holds the events context
+	require.Equal(t, enrichedLogEvents, allEvents)
+	require.Equal(t, logEvents, blockEvents)
+}
+
+func TestHandleSaveBlockEvents_OptInStreams(t *testing.T) {
+	t.Parallel()
+
+	blockData := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash1"),
+		Header:     &block.HeaderV2{},
+	}
+
+	blockHash := "blockHash1"
+	rewards := map[string]*rewardTx.RewardTx{
+		"hash1": {Round: 1, Epoch: 1, RcvAddr: []byte("addr1")},
+	}
+	receipts := map[string]*receipt.Receipt{
+		"hash2": {TxHash: []byte("txHash1"), Data: []byte("refund")},
+	}
+	invalidTxs := map[string]*outport.TxInfo{
+		"hash3": {Transaction: &transaction.Transaction{Nonce: 3}},
+	}
+	alteredAccounts := map[string]*alteredAccount.AlteredAccount{
+		"erd1addr": {Address: "erd1addr", Nonce: 4, Balance: "1000"},
+	}
+	failedExecutions := []data.FailedExecution{
+		{TxHash: "txHash1", OriginalTxHash: "txHash1", Function: "swap", Error: "user error"},
+	}
 
+	createArgs := func() process.ArgsEventsHandler {
 		args := createMockEventsHandlerArgs()
-		args.CheckDuplicates = true
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:             blockHash,
+					Header:           &block.HeaderV2{Header: &block.Header{ShardID: 1, Nonce: 7}},
+					Rewards:          rewards,
+					Receipts:         receipts,
+					InvalidTxs:       invalidTxs,
+					AlteredAccounts:  alteredAccounts,
+					FailedExecutions: failedExecutions,
+				}, nil
+			},
+		}
 
-		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
-				return true, nil
+		return args
+	}
+
+	t.Run("should not broadcast opt-in streams by default", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRewardsCalled: func(event data.BlockRewards) {
+				require.Fail(t, "block rewards stream is not enabled")
+			},
+			BroadcastReceiptsCalled: func(event data.BlockReceipts) {
+				require.Fail(t, "block receipts stream is not enabled")
+			},
+			BroadcastInvalidTxsCalled: func(event data.BlockInvalidTxs) {
+				require.Fail(t, "block invalid txs stream is not enabled")
+			},
+			BroadcastAlteredAccountsCalled: func(event data.BlockAlteredAccounts) {
+				require.Fail(t, "altered accounts stream is not enabled")
+			},
+			BroadcastFailedExecutionsCalled: func(event data.BlockFailedExecutions) {
+				require.Fail(t, "failed executions stream is not enabled")
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		shouldProcess := eventsHandler.ShouldProcessSaveBlockEvents("blockHash1")
-		require.True(t, shouldProcess)
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
 	})
 
-	t.Run("duplicated events, should not process", func(t *testing.T) {
+	t.Run("should broadcast enabled opt-in streams", func(t *testing.T) {
 		t.Parallel()
 
-		args := createMockEventsHandlerArgs()
-		args.CheckDuplicates = true
+		args := createArgs()
+		args.EnabledStreams = []string{
+			common.BlockRewards,
+			common.BlockReceipts,
+			common.BlockInvalidTxs,
+			common.AlteredAccounts,
+			common.FailedExecutions,
+		}
 
-		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
-				return false, nil
+		rewardsWasCalled := false
+		receiptsWasCalled := false
+		invalidTxsWasCalled := false
+		alteredAccountsWasCalled := false
+		failedExecutionsWasCalled := false
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRewardsCalled: func(event data.BlockRewards) {
+				rewardsWasCalled = true
+				require.Equal(t, data.BlockRewards{Hash: blockHash, ShardID: 1, Nonce: 7, Rewards: rewards}, event)
+			},
+			BroadcastReceiptsCalled: func(event data.BlockReceipts) {
+				receiptsWasCalled = true
+				require.Equal(t, data.BlockReceipts{Hash: blockHash, ShardID: 1, Nonce: 7, Receipts: receipts}, event)
+			},
+			BroadcastInvalidTxsCalled: func(event data.BlockInvalidTxs) {
+				invalidTxsWasCalled = true
+				require.Equal(t, data.BlockInvalidTxs{Hash: blockHash, ShardID: 1, Nonce: 7, InvalidTxs: invalidTxs}, event)
+			},
+			BroadcastAlteredAccountsCalled: func(event data.BlockAlteredAccounts) {
+				alteredAccountsWasCalled = true
+				expectedEvent := data.BlockAlteredAccounts{
+					Hash:            blockHash,
+					ShardID:         1,
+					Nonce:           7,
+					AlteredAccounts: alteredAccounts,
+				}
+				require.Equal(t, expectedEvent, event)
+			},
+			BroadcastFailedExecutionsCalled: func(event data.BlockFailedExecutions) {
+				failedExecutionsWasCalled = true
+				expectedEvent := data.BlockFailedExecutions{
+					Hash:             blockHash,
+					ShardID:          1,
+					Nonce:            7,
+					FailedExecutions: failedExecutions,
+				}
+				require.Equal(t, expectedEvent, event)
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		shouldProcess := eventsHandler.ShouldProcessSaveBlockEvents("blockHash1")
-		require.False(t, shouldProcess)
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
+
+		require.True(t, rewardsWasCalled)
+		require.True(t, receiptsWasCalled)
+		require.True(t, invalidTxsWasCalled)
+		require.True(t, alteredAccountsWasCalled)
+		require.True(t, failedExecutionsWasCalled)
 	})
 }
 
-func TestHandlePushEvents(t *testing.T) {
+func TestHandleSaveBlockEvents_BlockHeaders(t *testing.T) {
 	t.Parallel()
 
-	t.Run("empty hash should return error", func(t *testing.T) {
-		t.Parallel()
+	blockData := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash1"),
+		Header:     &block.HeaderV2{},
+	}
+
+	gasConsumption := &outport.HeaderGasConsumption{
+		GasProvided:    100,
+		GasRefunded:    10,
+		GasPenalized:   1,
+		MaxGasPerBlock: 1000,
+	}
+	notarizedHeaders := []string{"shardHeaderHash1", "shardHeaderHash2"}
 
+	createArgs := func(header nodeData.HeaderHandler) process.ArgsEventsHandler {
 		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.BlockHeaders}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:                   "blockHash1",
+					Header:                 header,
+					SignersIndexes:         []uint64{0, 2, 3},
+					NotarizedHeadersHashes: notarizedHeaders,
+					HeaderGasConsumption:   gasConsumption,
+				}, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("shard block header", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.HeaderV2{
+			Header: &block.Header{
+				ShardID:   1,
+				Nonce:     11,
+				Round:     12,
+				Epoch:     2,
+				PrevHash:  []byte("prevHash"),
+				TimeStamp: 1234,
+				TxCount:   5,
+			},
+		}
+
+		var broadcastedHeader data.BlockHeader
+		args := createArgs(header)
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastBlockHeaderCalled: func(event data.BlockHeader) {
+				broadcastedHeader = event
+			},
+		}
+
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		events := data.BlockEvents{
-			Hash:      "",
-			ShardID:   1,
-			TimeStamp: 1234,
-			Events:    []data.Event{},
-		}
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
 
-		err = eventsHandler.HandlePushEvents(events)
-		require.Equal(t, common.ErrReceivedEmptyEvents, err)
+		expectedHeader := data.BlockHeader{
+			Hash:           "blockHash1",
+			ShardID:        1,
+			Nonce:          11,
+			Round:          12,
+			Epoch:          2,
+			PrevHash:       hex.EncodeToString([]byte("prevHash")),
+			TimeStamp:      1234,
+			TxCount:        5,
+			SignersIndexes: []uint64{0, 2, 3},
+			GasConsumption: gasConsumption,
+		}
+		require.Equal(t, expectedHeader, broadcastedHeader)
 	})
 
-	t.Run("broadcast event was called", func(t *testing.T) {
+	t.Run("metachain block header should contain notarized headers", func(t *testing.T) {
 		t.Parallel()
 
-		events := data.BlockEvents{
-			Hash:      "hash1",
-			ShardID:   1,
-			TimeStamp: 1234,
-			Events:    []data.Event{},
+		header := &block.MetaBlock{
+			Nonce:    11,
+			Round:    12,
+			Epoch:    2,
+			PrevHash: []byte("prevHash"),
+			TxCount:  5,
 		}
 
-		wasCalled := false
-		args := createMockEventsHandlerArgs()
+		var broadcastedHeader data.BlockHeader
+		args := createArgs(header)
 		args.Publisher = &mocks.PublisherStub{
-			BroadcastCalled: func(evs data.BlockEvents) {
-				require.Equal(t, events, evs)
-				wasCalled = true
+			BroadcastBlockHeaderCalled: func(event data.BlockHeader) {
+				broadcastedHeader = event
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		err = eventsHandler.HandlePushEvents(events)
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
 		require.Nil(t, err)
-		require.True(t, wasCalled)
+
+		require.Equal(t, core.MetachainShardId, broadcastedHeader.ShardID)
+		require.Equal(t, uint64(11), broadcastedHeader.Nonce)
+		require.Equal(t, notarizedHeaders, broadcastedHeader.NotarizedHeadersHashes)
 	})
 }
 
-func TestHandleRevertEvents(t *testing.T) {
+func TestHandleRoundsInfo(t *testing.T) {
 	t.Parallel()
 
-	t.Run("broadcast event was called", func(t *testing.T) {
+	roundsInfo := data.RoundsInfo{
+		ID:      "1_12",
+		ShardID: 1,
+		RoundsInfo: []*outport.RoundInfo{
+			{Round: 12, ShardId: 1, BlockWasProposed: true},
+		},
+	}
+
+	t.Run("disabled stream should not broadcast", func(t *testing.T) {
 		t.Parallel()
 
-		wasCalled := false
 		args := createMockEventsHandlerArgs()
 		args.Publisher = &mocks.PublisherStub{
-			BroadcastRevertCalled: func(events data.RevertBlock) {
-				wasCalled = true
+			BroadcastRoundsInfoCalled: func(event data.RoundsInfo) {
+				require.Fail(t, "rounds info stream is not enabled")
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		events := data.RevertBlock{
-			Hash:  "hash1",
-			Nonce: 1,
-		}
-
-		eventsHandler.HandleRevertEvents(events)
-		require.True(t, wasCalled)
+		err = eventsHandler.HandleRoundsInfo(roundsInfo)
+		require.Nil(t, err)
 	})
 
-	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+	t.Run("duplicated event should not broadcast", func(t *testing.T) {
 		t.Parallel()
 
-		revertEvents := data.RevertBlock{
-			Hash:  "hash1",
-			Nonce: 1,
-		}
-
-		wasCalled := false
 		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.RoundsInfo}
 		args.CheckDuplicates = true
-		args.Publisher = &mocks.PublisherStub{
-			BroadcastRevertCalled: func(events data.RevertBlock) {
-				require.Equal(t, revertEvents, events)
-				wasCalled = true
-			},
-		}
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				require.Equal(t, "rounds_info_1_12", key)
 				return false, nil
 			},
 		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRoundsInfoCalled: func(event data.RoundsInfo) {
+				require.Fail(t, "duplicated event should not be broadcasted")
+			},
+		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		eventsHandler.HandleRevertEvents(revertEvents)
+		err = eventsHandler.HandleRoundsInfo(roundsInfo)
+		require.Nil(t, err)
+	})
+
+	t.Run("should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.RoundsInfo}
+
+		wasCalled := false
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRoundsInfoCalled: func(event data.RoundsInfo) {
+				wasCalled = true
+				require.Equal(t, roundsInfo, event)
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleRoundsInfo(roundsInfo)
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+}
+
+func TestHandleValidatorsEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventsHandlerArgs()
+	args.EnabledStreams = []string{common.ValidatorsRating, common.ValidatorsPubKeys}
+
+	validatorsRating := data.ValidatorsRating{
+		ID:    "0_3",
+		Epoch: 3,
+		ValidatorsRatingInfo: []*outport.ValidatorRatingInfo{
+			{PublicKey: "pubKey1", Rating: 50},
+		},
+	}
+	validatorsPubKeys := data.ValidatorsPubKeys{
+		ID:    "0_3",
+		Epoch: 3,
+		ValidatorsPubKeys: map[uint32][]string{
+			0: {"pubKey1"},
+		},
+	}
+
+	ratingWasCalled := false
+	pubKeysWasCalled := false
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastValidatorsRatingCalled: func(event data.ValidatorsRating) {
+			ratingWasCalled = true
+			require.Equal(t, validatorsRating, event)
+		},
+		BroadcastValidatorsPubKeysCalled: func(event data.ValidatorsPubKeys) {
+			pubKeysWasCalled = true
+			require.Equal(t, validatorsPubKeys, event)
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+
+	err = eventsHandler.HandleValidatorsRating(validatorsRating)
+	require.Nil(t, err)
+	err = eventsHandler.HandleValidatorsPubKeys(validatorsPubKeys)
+	require.Nil(t, err)
+
+	require.True(t, ratingWasCalled)
+	require.True(t, pubKeysWasCalled)
+}
+
+func TestHandleSaveBlockEvents_EpochStart(t *testing.T) {
+	t.Parallel()
+
+	blockData := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash1"),
+		Header:     &block.HeaderV2{},
+	}
+
+	createArgs := func(header nodeData.HeaderHandler) process.ArgsEventsHandler {
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.EpochStart}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "blockHash1",
+					Header: header,
+				}, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("not an epoch start block should not broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(&block.MetaBlock{Nonce: 10, Epoch: 1})
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastEpochStartCalled: func(event data.EpochStart) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
+	})
+
+	t.Run("shard epoch start block should broadcast without economics", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.HeaderV2{
+			Header: &block.Header{
+				ShardID:            1,
+				Nonce:              100,
+				Round:              110,
+				Epoch:              2,
+				TimeStamp:          1234,
+				EpochStartMetaHash: []byte("metaHash"),
+			},
+		}
+
+		var broadcastedEvent data.EpochStart
+		args := createArgs(header)
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastEpochStartCalled: func(event data.EpochStart) {
+				broadcastedEvent = event
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
+
+		expectedEvent := data.EpochStart{
+			Hash:      "blockHash1",
+			ShardID:   1,
+			Epoch:     2,
+			Round:     110,
+			Nonce:     100,
+			TimeStamp: 1234,
+		}
+		require.Equal(t, expectedEvent, broadcastedEvent)
+	})
+
+	t.Run("metachain epoch start block should broadcast with economics", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.MetaBlock{
+			Nonce:     100,
+			Round:     110,
+			Epoch:     2,
+			TimeStamp: 1234,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{ShardID: 0, Nonce: 99},
+				},
+				Economics: block.Economics{
+					TotalSupply:                      big.NewInt(1000),
+					TotalToDistribute:                big.NewInt(100),
+					TotalNewlyMinted:                 big.NewInt(10),
+					RewardsPerBlock:                  big.NewInt(5),
+					RewardsForProtocolSustainability: big.NewInt(2),
+					NodePrice:                        big.NewInt(2500),
+					PrevEpochStartRound:              50,
+					PrevEpochStartHash:               []byte("prevEpochStartHash"),
+				},
+			},
+		}
+
+		var broadcastedEvent data.EpochStart
+		var trackedMessageIDs []string
+		args := createArgs(header)
+		args.CheckDuplicates = true
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				trackedMessageIDs = messageIDs
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastEpochStartCalled: func(event data.EpochStart) {
+				broadcastedEvent = event
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(blockData)
+		require.Nil(t, err)
+
+		expectedEvent := data.EpochStart{
+			Hash:      "blockHash1",
+			ShardID:   core.MetachainShardId,
+			Epoch:     2,
+			Round:     110,
+			Nonce:     100,
+			TimeStamp: 1234,
+			Economics: &data.EpochStartEconomics{
+				TotalSupply:                      "1000",
+				TotalToDistribute:                "100",
+				TotalNewlyMinted:                 "10",
+				RewardsPerBlock:                  "5",
+				RewardsForProtocolSustainability: "2",
+				NodePrice:                        "2500",
+				PrevEpochStartRound:              50,
+				PrevEpochStartHash:               hex.EncodeToString([]byte("prevEpochStartHash")),
+			},
+		}
+		require.Equal(t, expectedEvent, broadcastedEvent)
+
+		blockHash := hex.EncodeToString([]byte("blockHash1"))
+		require.Equal(t, []string{common.GetMessageID(blockHash, common.EpochStart)}, trackedMessageIDs)
+	})
+}
+
+func TestEventsHandler_TrackDelivery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should track the enabled streams before publishing", func(t *testing.T) {
+		t.Parallel()
+
+		headerHash := []byte("blockHash1")
+		blockHash := hex.EncodeToString(headerHash)
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.EnabledStreams = []string{common.PushLogsAndEvents, common.BlockTxs, common.RevertBlockEvents}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   blockHash,
+					Header: &block.HeaderV2{Header: &block.Header{}},
+				}, nil
+			},
+		}
+
+		trackedKeys := make(map[string][]string)
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				trackedKeys[key] = messageIDs
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastCalled: func(events data.BlockEvents) {
+				require.Contains(t, trackedKeys, blockHash)
+			},
+			BroadcastRevertCalled: func(event data.RevertBlock) {
+				require.Contains(t, trackedKeys, "revert_"+blockHash)
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{HeaderHash: headerHash})
+		require.Nil(t, err)
+		err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: blockHash})
+		require.Nil(t, err)
+
+		expectedTrackedKeys := map[string][]string{
+			blockHash: {
+				common.GetMessageID(blockHash, common.PushLogsAndEvents),
+				common.GetMessageID(blockHash, common.BlockTxs),
+			},
+			"revert_" + blockHash: {
+				common.GetMessageID(blockHash, common.RevertBlockEvents),
+			},
+		}
+		require.Equal(t, expectedTrackedKeys, trackedKeys)
+	})
+
+	t.Run("should not track if duplicates are not checked", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = false
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+	})
+}
+
+func TestEventsHandler_NotLeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventsHandlerArgs()
+	args.CheckDuplicates = true
+	args.LeaderElector = &mocks.LeaderElectorStub{
+		IsLeaderCalled: func() bool {
+			return false
+		},
+	}
+	args.Locker = &mocks.LockerStub{
+		ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+			require.Fail(t, "locker should not be called by follower instances")
+			return false, nil
+		},
+	}
+	args.EventsInterceptor = &mocks.EventsInterceptorStub{
+		ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+			require.Fail(t, "events should not be processed by follower instances")
+			return nil, nil
+		},
+	}
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastRevertCalled: func(event data.RevertBlock) {
+			require.Fail(t, "events should not be published by follower instances")
+		},
+		BroadcastFinalizedCalled: func(event data.FinalizedBlock) {
+			require.Fail(t, "events should not be published by follower instances")
+		},
+	}
+	args.BlocksBackfiller = &mocks.BlocksBackfillerStub{
+		BlockPublishedCalled: func(shardID uint32, nonce uint64) {
+			require.Fail(t, "blocks should not be recorded as published by follower instances")
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+
+	err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{HeaderHash: []byte("hash1")})
+	require.Nil(t, err)
+	err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: "hash1"})
+	require.Nil(t, err)
+	err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+	require.Nil(t, err)
+}
+
+func TestShouldProcessSaveBlockEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should process", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return true, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		shouldProcess, err := eventsHandler.ShouldProcessSaveBlockEvents("blockHash1")
+		require.Nil(t, err)
+		require.True(t, shouldProcess)
+	})
+
+	t.Run("duplicated events, should not process", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		shouldProcess, err := eventsHandler.ShouldProcessSaveBlockEvents("blockHash1")
+		require.Nil(t, err)
+		require.False(t, shouldProcess)
+	})
+}
+
+func TestHandlePushEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty hash should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		events := data.BlockEvents{
+			Hash:      "",
+			ShardID:   1,
+			TimeStamp: 1234,
+			Events:    []data.Event{},
+		}
+
+		err = eventsHandler.HandlePushEvents(events)
+		require.Equal(t, common.ErrReceivedEmptyEvents, err)
+	})
+
+	t.Run("broadcast event was called", func(t *testing.T) {
+		t.Parallel()
+
+		events := data.BlockEvents{
+			Hash:      "hash1",
+			ShardID:   1,
+			TimeStamp: 1234,
+			Events:    []data.Event{},
+		}
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastCalled: func(evs data.BlockEvents) {
+				require.Equal(t, events, evs)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandlePushEvents(events)
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+}
+
+func TestHandleRevertEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("broadcast event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRevertCalled: func(events data.RevertBlock) {
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		events := data.RevertBlock{
+			Hash:  "hash1",
+			Nonce: 1,
+		}
+
+		err = eventsHandler.HandleRevertEvents(events)
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		revertEvents := data.RevertBlock{
+			Hash:  "hash1",
+			Nonce: 1,
+		}
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRevertCalled: func(events data.RevertBlock) {
+				require.Equal(t, revertEvents, events)
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleRevertEvents(revertEvents)
+		require.Nil(t, err)
+		require.False(t, wasCalled)
+	})
+
+	t.Run("retracted events enabled, should broadcast the published payload", func(t *testing.T) {
+		t.Parallel()
+
+		logEvents := []data.Event{
+			{
+				Address:    "addr1",
+				Identifier: "swap",
+				TxHash:     "txHash1",
+			},
+		}
+		txs := map[string]*transaction.Transaction{
+			"txHash1": {
+				Nonce: 1,
+			},
+		}
+		scrs := map[string]*smartContractResult.SmartContractResult{
+			"scrHash1": {
+				Nonce: 2,
+			},
+		}
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.EnabledStreams = []string{
+			common.PushLogsAndEvents,
+			common.BlockTxs,
+			common.BlockScrs,
+			common.RevertBlockEvents,
+			common.RetractedEvents,
+		}
+		args.RetractedEvents = config.RetractedEventsConfig{
+			MaxCachedBlocks: 10,
+		}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:      "hash1",
+					Header:    eventsData.Header,
+					LogEvents: logEvents,
+					Txs:       txs,
+					Scrs:      scrs,
+				}, nil
+			},
+		}
+
+		var trackedMessageIDs []string
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				trackedMessageIDs = messageIDs
+			},
+		}
+
+		var retractedEvents []data.RetractedEvents
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRetractedEventsCalled: func(event data.RetractedEvents) {
+				retractedEvents = append(retractedEvents, event)
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{
+			HeaderHash: []byte("hash1"),
+			Header: &block.Header{
+				ShardID:   1,
+				Nonce:     11,
+				Round:     12,
+				Epoch:     2,
+				TimeStamp: 1234,
+			},
+		})
+		require.Nil(t, err)
+
+		revertBlock := data.RevertBlock{
+			Hash:    "hash1",
+			Nonce:   11,
+			Round:   12,
+			Epoch:   2,
+			ShardID: 1,
+		}
+		err = eventsHandler.HandleRevertEvents(revertBlock)
+		require.Nil(t, err)
+
+		expectedEvent := data.RetractedEvents{
+			Hash:      "hash1",
+			ShardID:   1,
+			Nonce:     11,
+			Round:     12,
+			Epoch:     2,
+			TimeStamp: 1234,
+			Events:    logEvents,
+			Txs:       txs,
+			Scrs:      scrs,
+		}
+		require.Equal(t, []data.RetractedEvents{expectedEvent}, retractedEvents)
+
+		expectedMessageIDs := []string{
+			common.GetMessageID("hash1", common.RevertBlockEvents),
+			common.GetMessageID("hash1", common.RetractedEvents),
+		}
+		require.Equal(t, expectedMessageIDs, trackedMessageIDs)
+
+		// the payload is retracted only once
+		err = eventsHandler.HandleRevertEvents(revertBlock)
+		require.Nil(t, err)
+		require.Len(t, retractedEvents, 1)
+	})
+
+	t.Run("block not published, should not broadcast retracted events", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.RetractedEvents}
+		args.RetractedEvents = config.RetractedEventsConfig{
+			MaxCachedBlocks: 10,
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRevertCalled: func(events data.RevertBlock) {
+				require.Fail(t, "revert stream is not enabled")
+			},
+			BroadcastRetractedEventsCalled: func(event data.RetractedEvents) {
+				require.Fail(t, "block was not published")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: "hash1"})
+		require.Nil(t, err)
+	})
+}
+
+func TestHandleFinalizedEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("broadcast finalized event was called", func(t *testing.T) {
+		t.Parallel()
+
+		finalizedEvents := data.FinalizedBlock{
+			Hash: "hash1",
+		}
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastFinalizedCalled: func(events data.FinalizedBlock) {
+				require.Equal(t, finalizedEvents, events)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleFinalizedEvents(finalizedEvents)
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastFinalizedCalled: func(events data.FinalizedBlock) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		events := data.FinalizedBlock{
+			Hash: "hash1",
+		}
+
+		err = eventsHandler.HandleFinalizedEvents(events)
+		require.Nil(t, err)
 		require.False(t, wasCalled)
 	})
 }
 
-func TestHandleFinalizedEvents(t *testing.T) {
-	t.Parallel()
+func TestHandleTxCompletedEvents(t *testing.T) {
+	t.Parallel()
+
+	completedTxs := []data.TxCompleted{
+		{
+			TxHash:      "txHash1",
+			Status:      common.TxStatusSuccess,
+			BlockHashes: []string{"hash1"},
+		},
+		{
+			TxHash:      "txHash2",
+			Status:      common.TxStatusFail,
+			Reason:      "user error",
+			BlockHashes: []string{"hash1"},
+		},
+	}
+
+	t.Run("tx completed stream enabled", func(t *testing.T) {
+		t.Parallel()
+
+		interceptorBlockData := &data.InterceptorBlockData{
+			Hash:   "hash1",
+			Header: &block.HeaderV2{Header: &block.Header{}},
+		}
+
+		var processedBlock *data.InterceptorBlockData
+		var finalizedBlockHash string
+		broadcastedTxs := make([]data.TxCompleted, 0)
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.TxCompleted}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return interceptorBlockData, nil
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessBlockCalled: func(blockData *data.InterceptorBlockData) []data.TxCompleted {
+				processedBlock = blockData
+				return nil
+			},
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				finalizedBlockHash = blockHash
+				return completedTxs
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxCompletedCalled: func(event data.TxCompleted) {
+				broadcastedTxs = append(broadcastedTxs, event)
+			},
+			BroadcastFinalizedCalled: func(events data.FinalizedBlock) {
+				require.Fail(t, "finalized stream is not enabled")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{HeaderHash: []byte("hash1")})
+		require.Nil(t, err)
+		require.Equal(t, interceptorBlockData, processedBlock)
+
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+		require.Equal(t, "hash1", finalizedBlockHash)
+		require.Equal(t, completedTxs, broadcastedTxs)
+	})
+
+	t.Run("tx completed stream disabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "hash1",
+					Header: &block.HeaderV2{Header: &block.Header{}},
+				}, nil
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessBlockCalled: func(blockData *data.InterceptorBlockData) []data.TxCompleted {
+				require.Fail(t, "should not process block")
+				return nil
+			},
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				require.Fail(t, "should not process finalized block")
+				return nil
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxCompletedCalled: func(event data.TxCompleted) {
+				require.Fail(t, "should not broadcast tx completed")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{HeaderHash: []byte("hash1")})
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+	})
+
+	t.Run("not leader, should not process the finalized block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.TxCompleted}
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				return false
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				require.Fail(t, "should not process finalized block")
+				return nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+	})
+
+	t.Run("duplicated finalized block, should record it without publishing", func(t *testing.T) {
+		t.Parallel()
+
+		wasProcessed := false
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.TxCompleted}
+		args.CheckDuplicates = true
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				return false, nil
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				wasProcessed = true
+				return completedTxs
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxCompletedCalled: func(event data.TxCompleted) {
+				require.Fail(t, "should not broadcast tx completed")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
 
-	t.Run("broadcast finalized event was called", func(t *testing.T) {
-		t.Parallel()
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+		require.True(t, wasProcessed)
+	})
 
-		finalizedEvents := data.FinalizedBlock{
-			Hash: "hash1",
-		}
+	t.Run("locker error, should not process the finalized block", func(t *testing.T) {
+		t.Parallel()
 
-		wasCalled := false
 		args := createMockEventsHandlerArgs()
-		args.Publisher = &mocks.PublisherStub{
-			BroadcastFinalizedCalled: func(events data.FinalizedBlock) {
-				require.Equal(t, finalizedEvents, events)
-				wasCalled = true
+		args.EnabledStreams = []string{common.TxCompleted}
+		args.CheckDuplicates = true
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs: 1,
+			FailurePolicy:        common.FailClosedPolicy,
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				return false, errors.New("locker error")
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				require.Fail(t, "should not process finalized block")
+				return nil
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		eventsHandler.HandleFinalizedEvents(finalizedEvents)
-		require.True(t, wasCalled)
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Equal(t, process.ErrLockerUnavailable, err)
 	})
 
-	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+	t.Run("should reserve the finalized block and track the completed txs delivery", func(t *testing.T) {
 		t.Parallel()
 
-		wasCalled := false
+		var reservedKey, trackedKey string
+		var trackedMessageIDs []string
+		broadcastedTxs := make([]data.TxCompleted, 0)
 		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.TxCompleted}
 		args.CheckDuplicates = true
-		args.Publisher = &mocks.PublisherStub{
-			BroadcastFinalizedCalled: func(events data.FinalizedBlock) {
-				wasCalled = true
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				reservedKey = key
+				return true, nil
 			},
 		}
-		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
-				return false, nil
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				require.Empty(t, broadcastedTxs)
+				trackedKey = key
+				trackedMessageIDs = messageIDs
+			},
+		}
+		args.TxCompletionWatcher = &mocks.TxCompletionWatcherStub{
+			ProcessFinalizedBlockCalled: func(blockHash string) []data.TxCompleted {
+				return completedTxs
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxCompletedCalled: func(event data.TxCompleted) {
+				broadcastedTxs = append(broadcastedTxs, event)
 			},
 		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		events := data.FinalizedBlock{
-			Hash: "hash1",
+		err = eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"})
+		require.Nil(t, err)
+		require.Equal(t, "tx_completed_hash1", reservedKey)
+		require.Equal(t, reservedKey, trackedKey)
+		expectedMessageIDs := []string{
+			common.GetMessageID("txHash1", common.TxCompleted),
+			common.GetMessageID("txHash2", common.TxCompleted),
 		}
-
-		eventsHandler.HandleFinalizedEvents(events)
-		require.False(t, wasCalled)
+		require.Equal(t, expectedMessageIDs, trackedMessageIDs)
+		require.Equal(t, completedTxs, broadcastedTxs)
 	})
 }
 
@@ -611,7 +1874,7 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 		args := createMockEventsHandlerArgs()
 		args.CheckDuplicates = true
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				return false, nil
 			},
 		}
@@ -619,7 +1882,8 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		ok := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Nil(t, err)
 		require.False(t, ok)
 	})
 
@@ -629,7 +1893,7 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 		args := createMockEventsHandlerArgs()
 		args.CheckDuplicates = true
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				return true, nil
 			},
 		}
@@ -637,7 +1901,8 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		ok := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Nil(t, err)
 		require.True(t, ok)
 	})
 
@@ -649,7 +1914,7 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 
 		args := createMockEventsHandlerArgs()
 		args.Locker = &mocks.LockerStub{
-			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
 				if numCallsIsProcessed > 0 {
 					return true, nil
 				}
@@ -668,7 +1933,478 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
-		ok := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Nil(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("locker service unavailable, fail-closed policy should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs: 1,
+			FailurePolicy:        common.FailClosedPolicy,
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return false, errors.New("fail to process")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Equal(t, process.ErrLockerUnavailable, err)
+		require.False(t, ok)
+
+		err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: hash})
+		require.Equal(t, process.ErrLockerUnavailable, err)
+	})
+
+	t.Run("locker service unavailable, fail-open policy should process events", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsHandlerArgs()
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs: 1,
+			FailurePolicy:        common.FailOpenPolicy,
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				return false, errors.New("fail to process")
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Nil(t, err)
 		require.True(t, ok)
 	})
+
+	t.Run("circuit breaker opened, should not call locker service", func(t *testing.T) {
+		t.Parallel()
+
+		numReserveCalls := 0
+		args := createMockEventsHandlerArgs()
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs:           1,
+			FailurePolicy:                  common.FailClosedPolicy,
+			CircuitBreakerThreshold:        1,
+			CircuitBreakerOpenDurationInMs: 60000,
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				numReserveCalls++
+				return false, errors.New("fail to process")
+			},
+		}
+
+		circuitOpenGauge := uint64(0)
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			SetGaugeCalled: func(path string, value uint64) {
+				if path == "Redis-circuit-open" {
+					circuitOpenGauge = value
+				}
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		_, err = eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Equal(t, process.ErrLockerUnavailable, err)
+		require.Equal(t, 1, numReserveCalls)
+		require.Equal(t, uint64(1), circuitOpenGauge)
+
+		ok, err := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
+		require.Equal(t, process.ErrLockerCircuitOpen, err)
+		require.False(t, ok)
+		require.Equal(t, 1, numReserveCalls)
+	})
+}
+
+func TestHandleSaveBlockEvents_BlocksOrdering(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventsHandlerArgs()
+	args.EnabledStreams = []string{common.PushLogsAndEvents, common.GapDetected}
+	args.BlocksOrdering = config.BlocksOrderingConfig{
+		Enabled:           true,
+		MaxBufferedBlocks: 2,
+		GapTimeoutInMs:    60000,
+	}
+	args.EventsInterceptor = &mocks.EventsInterceptorStub{
+		ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+			return &data.InterceptorBlockData{
+				Hash:   string(eventsData.HeaderHash),
+				Header: eventsData.Header,
+			}, nil
+		},
+	}
+
+	publishedHashes := make([]string, 0)
+	var gaps []data.GapDetected
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastCalled: func(events data.BlockEvents) {
+			publishedHashes = append(publishedHashes, events.Hash)
+		},
+		BroadcastGapDetectedCalled: func(event data.GapDetected) {
+			gaps = append(gaps, event)
+		},
+	}
+
+	publishedNonces := make([]uint64, 0)
+	args.BlocksBackfiller = &mocks.BlocksBackfillerStub{
+		BlockPublishedCalled: func(shardID uint32, nonce uint64) {
+			require.Equal(t, uint32(1), shardID)
+			publishedNonces = append(publishedNonces, nonce)
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = eventsHandler.Close()
+	}()
+
+	saveBlock := func(nonce uint64) {
+		err := eventsHandler.HandleSaveBlockEvents(data.ArgsSaveBlockData{
+			HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+			Header: &block.Header{
+				ShardID: 1,
+				Nonce:   nonce,
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	saveBlock(10)
+	saveBlock(12)
+	require.Equal(t, []uint64{10}, publishedNonces)
+
+	saveBlock(11)
+	require.Equal(t, []string{"hash10", "hash11", "hash12"}, publishedHashes)
+	require.Equal(t, []uint64{10, 11, 12}, publishedNonces)
+	require.Empty(t, gaps)
+
+	saveBlock(15)
+	saveBlock(16)
+
+	expectedGap := data.GapDetected{
+		ID:            "1_12_15",
+		ShardID:       1,
+		LastNonce:     12,
+		NextNonce:     15,
+		MissingNonces: 2,
+	}
+	require.Equal(t, []data.GapDetected{expectedGap}, gaps)
+	require.Equal(t, []string{"hash10", "hash11", "hash12", "hash15", "hash16"}, publishedHashes)
+	require.Equal(t, []uint64{10, 11, 12, 15, 16}, publishedNonces)
+}
+
+func TestHandleSaveBlockEvents_BlocksOrderingDuplicatesCheck(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(reservedKeys *[]string, trackedKeys *[]string, lockerErr *error) process.ArgsEventsHandler {
+		args := createMockEventsHandlerArgs()
+		args.CheckDuplicates = true
+		args.EnabledStreams = []string{common.PushLogsAndEvents}
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs: 1,
+			FailurePolicy:        common.FailClosedPolicy,
+		}
+		args.BlocksOrdering = config.BlocksOrderingConfig{
+			Enabled:           true,
+			MaxBufferedBlocks: 10,
+			GapTimeoutInMs:    60000,
+		}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   string(eventsData.HeaderHash),
+					Header: eventsData.Header,
+				}, nil
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				if *lockerErr != nil {
+					return false, *lockerErr
+				}
+				*reservedKeys = append(*reservedKeys, key)
+				return true, nil
+			},
+		}
+		args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+			TrackDeliveryCalled: func(key string, messageIDs []string) {
+				*trackedKeys = append(*trackedKeys, key)
+			},
+		}
+
+		return args
+	}
+
+	createBlock := func(nonce uint64) data.ArgsSaveBlockData {
+		return data.ArgsSaveBlockData{
+			HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+			Header: &block.Header{
+				ShardID: 1,
+				Nonce:   nonce,
+			},
+		}
+	}
+
+	t.Run("buffered block should be reserved and tracked only when published", func(t *testing.T) {
+		t.Parallel()
+
+		reservedKeys := make([]string, 0)
+		trackedKeys := make([]string, 0)
+		var lockerErr error
+		eventsHandler, err := process.NewEventsHandler(createArgs(&reservedKeys, &trackedKeys, &lockerErr))
+		require.Nil(t, err)
+		defer func() {
+			_ = eventsHandler.Close()
+		}()
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(10)))
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(12)))
+		require.Len(t, reservedKeys, 1)
+		require.Len(t, trackedKeys, 1)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(11)))
+		require.Len(t, reservedKeys, 3)
+		require.Equal(t, reservedKeys, trackedKeys)
+	})
+
+	t.Run("failed reservation should be returned and the block published on retry", func(t *testing.T) {
+		t.Parallel()
+
+		reservedKeys := make([]string, 0)
+		trackedKeys := make([]string, 0)
+		lockerErr := errors.New("locker error")
+		publishedHashes := make([]string, 0)
+		args := createArgs(&reservedKeys, &trackedKeys, &lockerErr)
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastCalled: func(events data.BlockEvents) {
+				publishedHashes = append(publishedHashes, events.Hash)
+			},
+		}
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = eventsHandler.Close()
+		}()
+
+		err = eventsHandler.HandleSaveBlockEvents(createBlock(10))
+		require.Equal(t, process.ErrLockerUnavailable, err)
+		require.Empty(t, publishedHashes)
+
+		lockerErr = nil
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(11)))
+		require.Equal(t, []string{"hash10", "hash11"}, publishedHashes)
+		require.Equal(t, reservedKeys, trackedKeys)
+	})
+
+	t.Run("reverted buffered block should not be published", func(t *testing.T) {
+		t.Parallel()
+
+		reservedKeys := make([]string, 0)
+		trackedKeys := make([]string, 0)
+		var lockerErr error
+		publishedHashes := make([]string, 0)
+		revertCalled := false
+		args := createArgs(&reservedKeys, &trackedKeys, &lockerErr)
+		args.EnabledStreams = []string{common.PushLogsAndEvents, common.RevertBlockEvents}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastCalled: func(events data.BlockEvents) {
+				publishedHashes = append(publishedHashes, events.Hash)
+			},
+			BroadcastRevertCalled: func(event data.RevertBlock) {
+				revertCalled = true
+			},
+		}
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = eventsHandler.Close()
+		}()
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(10)))
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(12)))
+
+		err = eventsHandler.HandleRevertEvents(data.RevertBlock{Hash: "hash12", ShardID: 1, Nonce: 12})
+		require.Nil(t, err)
+		require.False(t, revertCalled)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(createBlock(11)))
+		require.Equal(t, []string{"hash10", "hash11"}, publishedHashes)
+		require.Len(t, reservedKeys, 2)
+	})
+}
+
+func TestEventsHandler_FollowerEvents(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(isLeader *bool, published *[]string) process.ArgsEventsHandler {
+		args := createMockEventsHandlerArgs()
+		args.EnabledStreams = []string{common.PushLogsAndEvents, common.FinalizedBlockEvents, common.RoundsInfo}
+		args.LeaderElection = config.LeaderElectionConfig{
+			Enabled:                   true,
+			MaxFollowerBufferedEvents: 10,
+		}
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				return *isLeader
+			},
+		}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   string(eventsData.HeaderHash),
+					Header: &block.Header{},
+				}, nil
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastCalled: func(events data.BlockEvents) {
+				*published = append(*published, events.Hash)
+			},
+			BroadcastFinalizedCalled: func(event data.FinalizedBlock) {
+				*published = append(*published, "finalized_"+event.Hash)
+			},
+			BroadcastRoundsInfoCalled: func(event data.RoundsInfo) {
+				*published = append(*published, "rounds_"+event.ID)
+			},
+		}
+
+		return args
+	}
+
+	saveBlock := func(hash string) data.ArgsSaveBlockData {
+		return data.ArgsSaveBlockData{
+			HeaderHash: []byte(hash),
+			Header:     &block.Header{},
+		}
+	}
+
+	t.Run("follower events should be replayed on takeover, before the new events", func(t *testing.T) {
+		t.Parallel()
+
+		isLeader := false
+		published := make([]string, 0)
+		eventsHandler, err := process.NewEventsHandler(createArgs(&isLeader, &published))
+		require.Nil(t, err)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash1")))
+		require.Nil(t, eventsHandler.HandleFinalizedEvents(data.FinalizedBlock{Hash: "hash1"}))
+		require.Nil(t, eventsHandler.HandleRoundsInfo(data.RoundsInfo{ID: "round1"}))
+		require.Empty(t, published)
+
+		isLeader = true
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash2")))
+		require.Equal(t, []string{"hash1", "finalized_hash1", "rounds_round1", "hash2"}, published)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash3")))
+		require.Equal(t, []string{"hash1", "finalized_hash1", "rounds_round1", "hash2", "hash3"}, published)
+	})
+
+	t.Run("follower events should not be buffered if leader election is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		isLeader := false
+		published := make([]string, 0)
+		args := createArgs(&isLeader, &published)
+		args.LeaderElection = config.LeaderElectionConfig{}
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash1")))
+
+		isLeader = true
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash2")))
+		require.Equal(t, []string{"hash2"}, published)
+	})
+
+	t.Run("committed events should be skipped and reserved events replayed after the reservation TTL", func(t *testing.T) {
+		t.Parallel()
+
+		isLeader := false
+		published := make([]string, 0)
+		args := createArgs(&isLeader, &published)
+		args.CheckDuplicates = true
+		args.LockerReservationTTL = time.Millisecond * 10
+		committedKey := hex.EncodeToString([]byte("hash1"))
+		reservedKey := hex.EncodeToString([]byte("hash2"))
+		numReservedKeyCalls := 0
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				switch key {
+				case committedKey:
+					return false, nil
+				case reservedKey:
+					// the reservation of the previous leader expires after the first call
+					numReservedKeyCalls++
+					return numReservedKeyCalls > 1, nil
+				default:
+					return true, nil
+				}
+			},
+		}
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash1")))
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash2")))
+
+		isLeader = true
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash3")))
+		require.Equal(t, []string{"hash3"}, published)
+
+		time.Sleep(args.LockerReservationTTL * 2)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash4")))
+		require.Equal(t, []string{"hash3", "hash2", "hash4"}, published)
+	})
+
+	t.Run("failed replay should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		isLeader := false
+		published := make([]string, 0)
+		args := createArgs(&isLeader, &published)
+		args.CheckDuplicates = true
+		args.LockerRetryPolicy = config.LockerRetryPolicyConfig{
+			MaxRetryDurationInMs: 1,
+			FailurePolicy:        common.FailClosedPolicy,
+		}
+		failingKey := hex.EncodeToString([]byte("hash1"))
+		numFailingKeyCalls := 0
+		args.Locker = &mocks.LockerStub{
+			ReserveEventCalled: func(ctx context.Context, key string) (bool, error) {
+				if key == failingKey {
+					numFailingKeyCalls++
+					if numFailingKeyCalls == 1 {
+						return false, errors.New("locker error")
+					}
+				}
+				return true, nil
+			},
+		}
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash1")))
+
+		isLeader = true
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash2")))
+		require.Equal(t, []string{"hash2"}, published)
+
+		require.Nil(t, eventsHandler.HandleSaveBlockEvents(saveBlock("hash3")))
+		require.Equal(t, []string{"hash2", "hash1", "hash3"}, published)
+	})
 }
@@ -2,29 +2,49 @@ package process
 
 import (
 	"encoding/hex"
+	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
-// logEvent defines a log event associated with corresponding tx hash
+// logEvent defines a log event associated with corresponding tx hash, and its position within the log
 type logEvent struct {
 	EventHandler nodeData.EventHandler
 	TxHash       string
+	LogIndex     int
 }
 
 // ArgsEventsInterceptor defines the arguments needed for creating an events interceptor instance
 type ArgsEventsInterceptor struct {
 	PubKeyConverter core.PubkeyConverter
+	EventsDecoder   EventsDecoder
+	EnrichEvents    bool
+	EnabledStreams  []string
+}
+
+// buildOptions defines which parts of the block data are needed by the enabled streams
+type buildOptions struct {
+	buildTxs              bool
+	buildScrs             bool
+	buildRewards          bool
+	buildFailedExecutions bool
 }
 
 type eventsInterceptor struct {
 	pubKeyConverter core.PubkeyConverter
+	eventsDecoder   EventsDecoder
+	enrichEvents    bool
+	options         buildOptions
 }
 
 // NewEventsInterceptor creates a new eventsInterceptor instance
@@ -32,12 +52,38 @@ func NewEventsInterceptor(args ArgsEventsInterceptor) (*eventsInterceptor, error
 	if check.IfNil(args.PubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(args.EventsDecoder) {
+		return nil, ErrNilEventsDecoder
+	}
+
+	enabledStreams, err := common.NewEnabledStreams(args.EnabledStreams)
+	if err != nil {
+		return nil, err
+	}
 
 	return &eventsInterceptor{
 		pubKeyConverter: args.PubKeyConverter,
+		eventsDecoder:   args.EventsDecoder,
+		enrichEvents:    args.EnrichEvents,
+		options:         createBuildOptions(enabledStreams),
 	}, nil
 }
 
+// createBuildOptions sets up the block data to be built, based on the streams using it.
+// The txs and scrs are also needed for completing the watched transactions and for
+// pairing the failed executions with their sender and receiver
+func createBuildOptions(enabledStreams common.EnabledStreams) buildOptions {
+	isTxCompletedEnabled := enabledStreams.IsEnabled(common.TxCompleted)
+	isFailedExecutionsEnabled := enabledStreams.IsEnabled(common.FailedExecutions)
+
+	return buildOptions{
+		buildTxs:              enabledStreams.IsEnabled(common.BlockTxs) || isTxCompletedEnabled || isFailedExecutionsEnabled,
+		buildScrs:             enabledStreams.IsEnabled(common.BlockScrs) || isTxCompletedEnabled || isFailedExecutionsEnabled,
+		buildRewards:          enabledStreams.IsEnabled(common.BlockRewards),
+		buildFailedExecutions: isFailedExecutionsEnabled,
+	}
+}
+
 // ProcessBlockEvents will process block events data
 func (ei *eventsInterceptor) ProcessBlockEvents(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
 	if eventsData == nil {
@@ -53,33 +99,84 @@ func (ei *eventsInterceptor) ProcessBlockEvents(eventsData *data.ArgsSaveBlockDa
 		return nil, ErrNilBlockHeader
 	}
 
-	events := ei.getLogEventsFromTransactionsPool(eventsData.TransactionsPool.Logs)
+	events, logIndexes := ei.getLogEventsFromTransactionsPool(eventsData.TransactionsPool.Logs)
+
+	var enrichedEvents []data.Event
+	if ei.enrichEvents {
+		enrichedEvents = ei.getEnrichedEvents(events, logIndexes, eventsData.TransactionsPool, eventsData.Header.GetNonce())
+	}
 
 	txs := make(map[string]*transaction.Transaction)
-	for hash, tx := range eventsData.TransactionsPool.Transactions {
-		txs[hash] = tx.Transaction
+	if ei.options.buildTxs {
+		for hash, tx := range eventsData.TransactionsPool.Transactions {
+			txs[hash] = tx.Transaction
+		}
 	}
 	txsWithOrder := eventsData.TransactionsPool.Transactions
 
 	scrs := make(map[string]*smartContractResult.SmartContractResult)
-	for hash, scr := range eventsData.TransactionsPool.SmartContractResults {
-		scrs[hash] = scr.SmartContractResult
+	if ei.options.buildScrs {
+		for hash, scr := range eventsData.TransactionsPool.SmartContractResults {
+			scrs[hash] = scr.SmartContractResult
+		}
 	}
 	scrsWithOrder := eventsData.TransactionsPool.SmartContractResults
 
+	rewards := make(map[string]*rewardTx.RewardTx)
+	if ei.options.buildRewards {
+		for hash, reward := range eventsData.TransactionsPool.Rewards {
+			if reward == nil {
+				continue
+			}
+			rewards[hash] = reward.Reward
+		}
+	}
+
+	failedExecutions := make([]data.FailedExecution, 0)
+	if ei.options.buildFailedExecutions {
+		failedExecutions = ei.getFailedExecutions(events, txs, scrs)
+	}
+
+	receipts := eventsData.TransactionsPool.Receipts
+	if receipts == nil {
+		receipts = make(map[string]*receipt.Receipt)
+	}
+
+	invalidTxs := eventsData.TransactionsPool.InvalidTxs
+	if invalidTxs == nil {
+		invalidTxs = make(map[string]*outport.TxInfo)
+	}
+
+	alteredAccounts := eventsData.AlteredAccounts
+	if alteredAccounts == nil {
+		alteredAccounts = make(map[string]*alteredAccount.AlteredAccount)
+	}
+
 	return &data.InterceptorBlockData{
-		Hash:          hex.EncodeToString(eventsData.HeaderHash),
-		Body:          eventsData.Body,
-		Header:        eventsData.Header,
-		Txs:           txs,
-		TxsWithOrder:  txsWithOrder,
-		Scrs:          scrs,
-		ScrsWithOrder: scrsWithOrder,
-		LogEvents:     events,
+		Hash:                   hex.EncodeToString(eventsData.HeaderHash),
+		Body:                   eventsData.Body,
+		Header:                 eventsData.Header,
+		Txs:                    txs,
+		TxsWithOrder:           txsWithOrder,
+		Scrs:                   scrs,
+		ScrsWithOrder:          scrsWithOrder,
+		Rewards:                rewards,
+		Receipts:               receipts,
+		InvalidTxs:             invalidTxs,
+		AlteredAccounts:        alteredAccounts,
+		SignersIndexes:         eventsData.SignersIndexes,
+		NotarizedHeadersHashes: eventsData.NotarizedHeadersHashes,
+		HeaderGasConsumption:   eventsData.HeaderGasConsumption,
+		NumberOfShards:         eventsData.NumberOfShards,
+		LogEvents:              events,
+		EnrichedLogEvents:      enrichedEvents,
+		FailedExecutions:       failedExecutions,
 	}, nil
 }
 
-func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*outport.LogData) []data.Event {
+// getLogEventsFromTransactionsPool returns the log events, together with the position of each
+// event within the log of its transaction, computed before skipping any event
+func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*outport.LogData) ([]data.Event, []int) {
 	var logEvents []*logEvent
 	for _, logData := range logs {
 		if logData == nil {
@@ -89,11 +186,12 @@ func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*outport.Lo
 			continue
 		}
 
-		for _, event := range logData.Log.Events {
+		for index, event := range logData.Log.Events {
 
 			le := &logEvent{
 				EventHandler: event,
 				TxHash:       logData.TxHash,
+				LogIndex:     index,
 			}
 
 			logEvents = append(logEvents, le)
@@ -101,10 +199,11 @@ func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*outport.Lo
 	}
 
 	if len(logEvents) == 0 {
-		return make([]data.Event, 0)
+		return make([]data.Event, 0), make([]int, 0)
 	}
 
 	events := make([]data.Event, 0, len(logEvents))
+	logIndexes := make([]int, 0, len(logEvents))
 	for _, event := range logEvents {
 		if event == nil || check.IfNil(event.EventHandler) {
 			continue
@@ -132,16 +231,148 @@ func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*outport.Lo
 			eventData = make([]byte, 0)
 		}
 
-		events = append(events, data.Event{
+		decodedEvent := data.Event{
 			Address:    bech32Address,
 			Identifier: eventIdentifier,
 			Topics:     topics,
 			Data:       eventData,
 			TxHash:     event.TxHash,
-		})
+		}
+		decodedEvent.Decoded = ei.eventsDecoder.DecodeEvent(decodedEvent)
+
+		events = append(events, decodedEvent)
+		logIndexes = append(logIndexes, event.LogIndex)
+	}
+
+	return events, logIndexes
+}
+
+// getEnrichedEvents returns copies of the provided events, each one holding the transaction, or the
+// smart contract result, which generated it. The provided events are shared by the other output
+// streams, so they are not modified.
+func (ei *eventsInterceptor) getEnrichedEvents(
+	events []data.Event,
+	logIndexes []int,
+	pool *outport.TransactionPool,
+	blockNonce uint64,
+) []data.Event {
+	enrichedEvents := make([]data.Event, len(events))
+	for i := range events {
+		eventContext := &data.EventContext{
+			BlockNonce: blockNonce,
+			LogIndex:   logIndexes[i],
+		}
+
+		txHash := events[i].TxHash
+		txInfo, isTx := pool.Transactions[txHash]
+		if !isTx {
+			txInfo, isTx = pool.InvalidTxs[txHash]
+		}
+		if isTx && txInfo != nil && txInfo.Transaction != nil {
+			tx := txInfo.Transaction
+			eventContext.Sender = ei.encodeAddress(tx.SndAddr)
+			eventContext.Receiver = ei.encodeAddress(tx.RcvAddr)
+			eventContext.Value = bigIntToString(tx.Value)
+			eventContext.Function = getFunctionName(tx.Data)
+			eventContext.OriginalTxHash = txHash
+			eventContext.ExecutionOrder = txInfo.ExecutionOrder
+		}
+
+		scrInfo, isScr := pool.SmartContractResults[txHash]
+		if isScr && scrInfo != nil && scrInfo.SmartContractResult != nil {
+			scr := scrInfo.SmartContractResult
+			eventContext.Sender = ei.encodeAddress(scr.SndAddr)
+			eventContext.Receiver = ei.encodeAddress(scr.RcvAddr)
+			eventContext.Value = bigIntToString(scr.Value)
+			eventContext.Function = getFunctionName(scr.Data)
+			eventContext.OriginalTxHash = hex.EncodeToString(scr.OriginalTxHash)
+			eventContext.ExecutionOrder = scrInfo.ExecutionOrder
+		}
+
+		enrichedEvents[i] = events[i]
+		enrichedEvents[i].Context = eventContext
+	}
+
+	return enrichedEvents
+}
+
+// getFailedExecutions pairs the signalError and internalVMErrors events with the transaction
+// or the smart contract result which generated them
+func (ei *eventsInterceptor) getFailedExecutions(
+	events []data.Event,
+	txs map[string]*transaction.Transaction,
+	scrs map[string]*smartContractResult.SmartContractResult,
+) []data.FailedExecution {
+	failedExecutions := make([]data.FailedExecution, 0)
+	for _, event := range events {
+		if !isFailedExecutionEvent(event) {
+			continue
+		}
+
+		failedExecution := data.FailedExecution{
+			TxHash:         event.TxHash,
+			OriginalTxHash: event.TxHash,
+			Identifier:     event.Identifier,
+			Error:          getExecutionErrorMessage(event),
+		}
+
+		tx, ok := txs[event.TxHash]
+		if ok && tx != nil {
+			failedExecution.Sender = ei.encodeAddress(tx.SndAddr)
+			failedExecution.Receiver = ei.encodeAddress(tx.RcvAddr)
+			failedExecution.Function = getFunctionName(tx.Data)
+		}
+
+		scr, ok := scrs[event.TxHash]
+		if ok && scr != nil {
+			failedExecution.OriginalTxHash = hex.EncodeToString(scr.OriginalTxHash)
+			failedExecution.Sender = ei.encodeAddress(scr.SndAddr)
+			failedExecution.Receiver = ei.encodeAddress(scr.RcvAddr)
+			failedExecution.Function = getFunctionName(scr.Data)
+		}
+
+		failedExecutions = append(failedExecutions, failedExecution)
+	}
+
+	return failedExecutions
+}
+
+func (ei *eventsInterceptor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	encodedAddress, err := ei.pubKeyConverter.Encode(address)
+	if err != nil {
+		log.Debug("eventsInterceptor: failed to encode address", "error", err)
+		return ""
+	}
+
+	return encodedAddress
+}
+
+func isFailedExecutionEvent(event data.Event) bool {
+	return event.Identifier == core.SignalErrorOperation || event.Identifier == core.InternalVMErrorsOperation
+}
+
+// getExecutionErrorMessage returns the error message of a signalError or internalVMErrors event
+func getExecutionErrorMessage(event data.Event) string {
+	// the second topic of the signalError event holds the error message
+	if event.Identifier == core.SignalErrorOperation && len(event.Topics) > 1 {
+		return string(event.Topics[1])
+	}
+
+	return strings.TrimSpace(string(event.Data))
+}
+
+// getFunctionName returns the called function from the data field, formatted as
+// <function>@<hex argument>@...
+func getFunctionName(txData []byte) string {
+	if len(txData) == 0 || strings.HasPrefix(string(txData), returnDataSeparator) {
+		return ""
 	}
 
-	return events
+	return strings.Split(string(txData), returnDataSeparator)[0]
 }
 
 // IsInterfaceNil returns whether the interface is nil
@@ -2,13 +2,20 @@ package process_test
 
 import (
 	"encoding/hex"
+	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/multiversx/mx-chain-notifier-go/process"
@@ -18,6 +25,8 @@ import (
 func createMockEventsInterceptorArgs() process.ArgsEventsInterceptor {
 	return process.ArgsEventsInterceptor{
 		PubKeyConverter: &mocks.PubkeyConverterMock{},
+		EventsDecoder:   &mocks.EventsDecoderStub{},
+		EnabledStreams:  common.AllStreams,
 	}
 }
 
@@ -35,6 +44,28 @@ func TestNewEventsInterceptor(t *testing.T) {
 		require.Equal(t, process.ErrNilPubKeyConverter, err)
 	})
 
+	t.Run("nil events decoder", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EventsDecoder = nil
+
+		eventsInterceptor, err := process.NewEventsInterceptor(args)
+		require.Nil(t, eventsInterceptor)
+		require.Equal(t, process.ErrNilEventsDecoder, err)
+	})
+
+	t.Run("invalid stream name", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EnabledStreams = []string{"invalid"}
+
+		eventsInterceptor, err := process.NewEventsInterceptor(args)
+		require.Nil(t, eventsInterceptor)
+		require.True(t, errors.Is(err, common.ErrInvalidStreamName))
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -122,6 +153,29 @@ func TestProcessBlockEvents(t *testing.T) {
 				ExecutionOrder: 1,
 			},
 		}
+		rewards := map[string]*outport.RewardInfo{
+			"hash4": {
+				Reward: &rewardTx.RewardTx{
+					Round: 4,
+				},
+				ExecutionOrder: 1,
+			},
+		}
+		receipts := map[string]*receipt.Receipt{
+			"hash5": {
+				TxHash: []byte("txHash5"),
+			},
+		}
+		invalidTxs := map[string]*outport.TxInfo{
+			"hash6": {
+				Transaction: &transaction.Transaction{
+					Nonce: 6,
+				},
+				FeeInfo: &outport.FeeInfo{
+					GasUsed: 50000,
+				},
+			},
+		}
 		addr := []byte("addr1")
 
 		blockBody := &block.Body{
@@ -147,6 +201,14 @@ func TestProcessBlockEvents(t *testing.T) {
 			},
 		}
 
+		alteredAccounts := map[string]*alteredAccount.AlteredAccount{
+			"erd1addr": {
+				Address: "erd1addr",
+				Nonce:   5,
+				Balance: "1000",
+			},
+		}
+
 		blockHash := []byte("blockHash")
 		blockEvents := data.ArgsSaveBlockData{
 			HeaderHash: blockHash,
@@ -155,8 +217,12 @@ func TestProcessBlockEvents(t *testing.T) {
 			TransactionsPool: &outport.TransactionPool{
 				Transactions:         txs,
 				SmartContractResults: scrs,
+				Rewards:              rewards,
+				Receipts:             receipts,
+				InvalidTxs:           invalidTxs,
 				Logs:                 logs,
 			},
+			AlteredAccounts: alteredAccounts,
 		}
 
 		expTxs := map[string]*transaction.Transaction{
@@ -194,6 +260,14 @@ func TestProcessBlockEvents(t *testing.T) {
 			TxsWithOrder:  expTxsWithOrder,
 			Scrs:          expScrs,
 			ScrsWithOrder: expScrsWithOrder,
+			Rewards: map[string]*rewardTx.RewardTx{
+				"hash4": {
+					Round: 4,
+				},
+			},
+			Receipts:        receipts,
+			InvalidTxs:      invalidTxs,
+			AlteredAccounts: alteredAccounts,
 			LogEvents: []data.Event{
 				{
 					Address:    hex.EncodeToString(addr),
@@ -202,6 +276,7 @@ func TestProcessBlockEvents(t *testing.T) {
 					Topics:     make([][]byte, 0),
 				},
 			},
+			FailedExecutions: make([]data.FailedExecution, 0),
 		}
 
 		events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
@@ -253,11 +328,15 @@ func TestProcessBlockEvents(t *testing.T) {
 		}
 
 		expEvents := &data.InterceptorBlockData{
-			Hash:   hex.EncodeToString(blockHash),
-			Body:   blockBody,
-			Header: blockHeader,
-			Txs:    make(map[string]*transaction.Transaction),
-			Scrs:   make(map[string]*smartContractResult.SmartContractResult),
+			Hash:            hex.EncodeToString(blockHash),
+			Body:            blockBody,
+			Header:          blockHeader,
+			Txs:             make(map[string]*transaction.Transaction),
+			Scrs:            make(map[string]*smartContractResult.SmartContractResult),
+			Rewards:         make(map[string]*rewardTx.RewardTx),
+			Receipts:        make(map[string]*receipt.Receipt),
+			InvalidTxs:      make(map[string]*outport.TxInfo),
+			AlteredAccounts: make(map[string]*alteredAccount.AlteredAccount),
 			LogEvents: []data.Event{
 				{
 					Address:    hex.EncodeToString(addr),
@@ -266,6 +345,7 @@ func TestProcessBlockEvents(t *testing.T) {
 					Topics:     make([][]byte, 0),
 				},
 			},
+			FailedExecutions: make([]data.FailedExecution, 0),
 		}
 
 		events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
@@ -275,6 +355,322 @@ func TestProcessBlockEvents(t *testing.T) {
 
 }
 
+func TestProcessBlockEvents_FailedExecutions(t *testing.T) {
+	t.Parallel()
+
+	eventsInterceptor, _ := process.NewEventsInterceptor(createMockEventsInterceptorArgs())
+
+	sender := []byte("sender")
+	receiver := []byte("receiver")
+	originalTxHash := []byte("originalTxHash")
+
+	txs := map[string]*outport.TxInfo{
+		"txHash1": {
+			Transaction: &transaction.Transaction{
+				SndAddr: sender,
+				RcvAddr: receiver,
+				Data:    []byte("swap@01@02"),
+			},
+		},
+	}
+	scrs := map[string]*outport.SCRInfo{
+		"scrHash1": {
+			SmartContractResult: &smartContractResult.SmartContractResult{
+				SndAddr:        receiver,
+				RcvAddr:        sender,
+				Data:           []byte("claim"),
+				OriginalTxHash: originalTxHash,
+			},
+		},
+	}
+	logs := []*outport.LogData{
+		{
+			TxHash: "txHash1",
+			Log: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    receiver,
+						Identifier: []byte(core.SignalErrorOperation),
+						Topics:     [][]byte{sender, []byte("insufficient funds")},
+					},
+				},
+			},
+		},
+		{
+			TxHash: "scrHash1",
+			Log: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    sender,
+						Identifier: []byte(core.InternalVMErrorsOperation),
+						Data:       []byte("\n\t[out of gas]"),
+					},
+					{
+						Address:    sender,
+						Identifier: []byte("transfer"),
+					},
+				},
+			},
+		},
+	}
+
+	blockEvents := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash"),
+		Body:       &block.Body{},
+		Header:     &block.HeaderV2{Header: &block.Header{}},
+		TransactionsPool: &outport.TransactionPool{
+			Transactions:         txs,
+			SmartContractResults: scrs,
+			Logs:                 logs,
+		},
+	}
+
+	events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
+	require.Nil(t, err)
+
+	expectedFailedExecutions := []data.FailedExecution{
+		{
+			TxHash:         "txHash1",
+			OriginalTxHash: "txHash1",
+			Sender:         hex.EncodeToString(sender),
+			Receiver:       hex.EncodeToString(receiver),
+			Function:       "swap",
+			Identifier:     core.SignalErrorOperation,
+			Error:          "insufficient funds",
+		},
+		{
+			TxHash:         "scrHash1",
+			OriginalTxHash: hex.EncodeToString(originalTxHash),
+			Sender:         hex.EncodeToString(receiver),
+			Receiver:       hex.EncodeToString(sender),
+			Function:       "claim",
+			Identifier:     core.InternalVMErrorsOperation,
+			Error:          "[out of gas]",
+		},
+	}
+	require.Equal(t, expectedFailedExecutions, events.FailedExecutions)
+}
+
+func TestProcessBlockEvents_DisabledStreams(t *testing.T) {
+	t.Parallel()
+
+	blockEvents := data.ArgsSaveBlockData{
+		HeaderHash: []byte("blockHash"),
+		Body:       &block.Body{},
+		Header:     &block.HeaderV2{Header: &block.Header{}},
+		TransactionsPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				"txHash1": {Transaction: &transaction.Transaction{Nonce: 1}},
+			},
+			SmartContractResults: map[string]*outport.SCRInfo{
+				"scrHash1": {SmartContractResult: &smartContractResult.SmartContractResult{Nonce: 2}},
+			},
+			Rewards: map[string]*outport.RewardInfo{
+				"rewardHash1": {Reward: &rewardTx.RewardTx{Round: 3}},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: "txHash1",
+					Log: &transaction.Log{
+						Events: []*transaction.Event{
+							{
+								Address:    []byte("addr"),
+								Identifier: []byte(core.SignalErrorOperation),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("only block events enabled should not build txs, scrs, rewards and failed executions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EnabledStreams = []string{common.BlockEvents}
+		eventsInterceptor, _ := process.NewEventsInterceptor(args)
+
+		events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
+		require.Nil(t, err)
+		require.Empty(t, events.Txs)
+		require.Empty(t, events.Scrs)
+		require.Empty(t, events.Rewards)
+		require.Empty(t, events.FailedExecutions)
+		require.Len(t, events.TxsWithOrder, 1)
+		require.Len(t, events.ScrsWithOrder, 1)
+		require.Len(t, events.LogEvents, 1)
+	})
+
+	t.Run("tx completed enabled should build txs and scrs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EnabledStreams = []string{common.TxCompleted}
+		eventsInterceptor, _ := process.NewEventsInterceptor(args)
+
+		events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
+		require.Nil(t, err)
+		require.Len(t, events.Txs, 1)
+		require.Len(t, events.Scrs, 1)
+		require.Empty(t, events.Rewards)
+		require.Empty(t, events.FailedExecutions)
+	})
+
+	t.Run("failed executions enabled should build txs, scrs and failed executions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EnabledStreams = []string{common.FailedExecutions}
+		eventsInterceptor, _ := process.NewEventsInterceptor(args)
+
+		events, err := eventsInterceptor.ProcessBlockEvents(&blockEvents)
+		require.Nil(t, err)
+		require.Len(t, events.Txs, 1)
+		require.Len(t, events.Scrs, 1)
+		require.Empty(t, events.Rewards)
+		require.Len(t, events.FailedExecutions, 1)
+	})
+}
+
+func TestProcessBlockEvents_EnrichEvents(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	receiver := []byte("receiver")
+	originalTxHash := []byte("originalTxHash")
+
+	txs := map[string]*outport.TxInfo{
+		"txHash1": {
+			Transaction: &transaction.Transaction{
+				SndAddr: sender,
+				RcvAddr: receiver,
+				Value:   big.NewInt(1000),
+				Data:    []byte("swap@01"),
+			},
+			ExecutionOrder: 2,
+		},
+	}
+	scrs := map[string]*outport.SCRInfo{
+		"scrHash1": {
+			SmartContractResult: &smartContractResult.SmartContractResult{
+				SndAddr:        receiver,
+				RcvAddr:        sender,
+				Data:           []byte("claim"),
+				OriginalTxHash: originalTxHash,
+			},
+			ExecutionOrder: 3,
+		},
+	}
+	logs := []*outport.LogData{
+		{
+			TxHash: "txHash1",
+			Log: &transaction.Log{
+				Events: []*transaction.Event{
+					{Address: receiver, Identifier: []byte("swapEvent")},
+					nil,
+					{Address: receiver, Identifier: []byte("transferEvent")},
+				},
+			},
+		},
+		{
+			TxHash: "scrHash1",
+			Log: &transaction.Log{
+				Events: []*transaction.Event{
+					{Address: sender, Identifier: []byte("claimEvent")},
+				},
+			},
+		},
+	}
+
+	createBlockEvents := func() *data.ArgsSaveBlockData {
+		return &data.ArgsSaveBlockData{
+			HeaderHash: []byte("blockHash"),
+			Body:       &block.Body{},
+			Header:     &block.HeaderV2{Header: &block.Header{Nonce: 11}},
+			TransactionsPool: &outport.TransactionPool{
+				Transactions:         txs,
+				SmartContractResults: scrs,
+				Logs:                 logs,
+			},
+		}
+	}
+
+	t.Run("enrichment disabled should not set the events context", func(t *testing.T) {
+		t.Parallel()
+
+		eventsInterceptor, _ := process.NewEventsInterceptor(createMockEventsInterceptorArgs())
+
+		events, err := eventsInterceptor.ProcessBlockEvents(createBlockEvents())
+		require.Nil(t, err)
+		require.Len(t, events.LogEvents, 3)
+		for _, event := range events.LogEvents {
+			require.Nil(t, event.Context)
+		}
+		require.Nil(t, events.EnrichedLogEvents)
+	})
+
+	t.Run("enrichment enabled should set the events context", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsInterceptorArgs()
+		args.EnrichEvents = true
+		eventsInterceptor, _ := process.NewEventsInterceptor(args)
+
+		events, err := eventsInterceptor.ProcessBlockEvents(createBlockEvents())
+		require.Nil(t, err)
+
+		expectedContexts := []*data.EventContext{
+			{
+				Sender:         hex.EncodeToString(sender),
+				Receiver:       hex.EncodeToString(receiver),
+				Value:          "1000",
+				Function:       "swap",
+				OriginalTxHash: "txHash1",
+				BlockNonce:     11,
+				LogIndex:       0,
+				ExecutionOrder: 2,
+			},
+			{
+				Sender:         hex.EncodeToString(sender),
+				Receiver:       hex.EncodeToString(receiver),
+				Value:          "1000",
+				Function:       "swap",
+				OriginalTxHash: "txHash1",
+				BlockNonce:     11,
+				LogIndex:       2,
+				ExecutionOrder: 2,
+			},
+			{
+				Sender:         hex.EncodeToString(receiver),
+				Receiver:       hex.EncodeToString(sender),
+				Value:          "0",
+				Function:       "claim",
+				OriginalTxHash: hex.EncodeToString(originalTxHash),
+				BlockNonce:     11,
+				LogIndex:       0,
+				ExecutionOrder: 3,
+			},
+		}
+		require.Len(t, events.EnrichedLogEvents, 3)
+		require.Equal(t, expectedContexts, []*data.EventContext{
+			events.EnrichedLogEvents[0].Context,
+			events.EnrichedLogEvents[1].Context,
+			events.EnrichedLogEvents[2].Context,
+		})
+
+		// the events shared by the other streams are not enriched
+		require.Len(t, events.LogEvents, 3)
+		for i, event := range events.LogEvents {
+			require.Nil(t, event.Context)
+
+			enrichedEvent := events.EnrichedLogEvents[i]
+			enrichedEvent.Context = nil
+			require.Equal(t, event, enrichedEvent)
+		}
+	})
+}
+
 func TestGetLogEventsFromTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -331,3 +727,49 @@ func TestGetLogEventsFromTransactionsPool(t *testing.T) {
 	require.Equal(t, txHash1, receivedEvents[1].TxHash)
 	require.Equal(t, txHash2, receivedEvents[2].TxHash)
 }
+
+func TestGetLogEventsFromTransactionsPool_DecodedEvents(t *testing.T) {
+	t.Parallel()
+
+	logs := []*outport.LogData{
+		{
+			Log: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("addr1"),
+						Identifier: []byte("ESDTTransfer"),
+						Topics:     [][]byte{[]byte("TKN-123456"), {}, {100}, []byte("receiver")},
+					},
+					{
+						Address:    []byte("addr2"),
+						Identifier: []byte("identifier2"),
+					},
+				},
+			},
+			TxHash: "txHash1",
+		},
+	}
+
+	decodedEvent := &data.DecodedEvent{
+		Sender:   hex.EncodeToString([]byte("addr1")),
+		Receiver: hex.EncodeToString([]byte("receiver")),
+	}
+
+	args := createMockEventsInterceptorArgs()
+	args.EventsDecoder = &mocks.EventsDecoderStub{
+		DecodeEventCalled: func(event data.Event) *data.DecodedEvent {
+			if event.Identifier == "ESDTTransfer" {
+				require.Equal(t, logs[0].Log.Events[0].Topics, event.Topics)
+				return decodedEvent
+			}
+			return nil
+		},
+	}
+	en, _ := process.NewEventsInterceptor(args)
+
+	receivedEvents := en.GetLogEventsFromTransactionsPool(logs)
+	require.Equal(t, 2, len(receivedEvents))
+	require.Equal(t, decodedEvent, receivedEvents[0].Decoded)
+	require.Equal(t, logs[0].Log.Events[0].Topics, receivedEvents[0].Topics)
+	require.Nil(t, receivedEvents[1].Decoded)
+}
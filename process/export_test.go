@@ -1,12 +1,14 @@
 package process
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
 // TryCheckProcessedWithRetry exports internal method for testing
-func (eh *eventsHandler) TryCheckProcessedWithRetry(prefix, blockHash string) bool {
+func (eh *eventsHandler) TryCheckProcessedWithRetry(prefix, blockHash string) (bool, error) {
 	return eh.tryCheckProcessedWithRetry(prefix, blockHash)
 }
 
@@ -31,11 +33,128 @@ func (eh *eventsHandler) HandleBlockEventsWithOrder(blockTxs data.BlockEventsWit
 }
 
 // ShouldProcessSaveBlockEvents -
-func (eh *eventsHandler) ShouldProcessSaveBlockEvents(blockHash string) bool {
+func (eh *eventsHandler) ShouldProcessSaveBlockEvents(blockHash string) (bool, error) {
 	return eh.shouldProcessSaveBlockEvents(blockHash)
 }
 
 // GetLogEventsFromTransactionsPool exports internal method for testing
 func (ei *eventsInterceptor) GetLogEventsFromTransactionsPool(logs []*outport.LogData) []data.Event {
-	return ei.getLogEventsFromTransactionsPool(logs)
+	events, _ := ei.getLogEventsFromTransactionsPool(logs)
+	return events
+}
+
+// NewBlocksOrderer -
+func NewBlocksOrderer(
+	maxBufferedBlocks uint32,
+	gapTimeout time.Duration,
+	publishBlock func(blockData *data.InterceptorBlockData) error,
+	publishGap func(gap data.GapDetected),
+) *blocksOrderer {
+	return newBlocksOrderer(maxBufferedBlocks, gapTimeout, publishBlock, publishGap)
+}
+
+// AddBlock -
+func (bo *blocksOrderer) AddBlock(blockData *data.InterceptorBlockData) error {
+	return bo.addBlock(blockData)
+}
+
+// RemoveBlock -
+func (bo *blocksOrderer) RemoveBlock(hash string, shardID uint32, nonce uint64) bool {
+	return bo.removeBlock(hash, shardID, nonce)
+}
+
+// NumBufferedBlocks -
+func (bo *blocksOrderer) NumBufferedBlocks() int {
+	return bo.numBufferedBlocks()
+}
+
+// Close -
+func (bo *blocksOrderer) Close() {
+	bo.close()
+}
+
+// NewFollowerEventsBuffer -
+func NewFollowerEventsBuffer(capacity uint32, verifyDelay time.Duration) *followerEventsBuffer {
+	return newFollowerEventsBuffer(capacity, verifyDelay)
+}
+
+// Add -
+func (b *followerEventsBuffer) Add(event func() error) int {
+	return b.add(event)
+}
+
+// PutBack -
+func (b *followerEventsBuffer) PutBack(events []func() error) int {
+	followerEvents := make([]followerEvent, 0, len(events))
+	for _, event := range events {
+		followerEvents = append(followerEvents, event)
+	}
+
+	return b.putBack(followerEvents)
+}
+
+// ScheduleVerify -
+func (b *followerEventsBuffer) ScheduleVerify(events []func() error) {
+	followerEvents := make([]followerEvent, 0, len(events))
+	for _, event := range events {
+		followerEvents = append(followerEvents, event)
+	}
+
+	b.scheduleVerify(followerEvents)
+}
+
+// TakeEvents -
+func (b *followerEventsBuffer) TakeEvents() ([]func() error, []func() error) {
+	events, verifyEvents := b.takeEvents()
+
+	return toFuncs(events), toFuncs(verifyEvents)
+}
+
+func toFuncs(events []followerEvent) []func() error {
+	funcs := make([]func() error, 0, len(events))
+	for _, event := range events {
+		funcs = append(funcs, event)
+	}
+
+	return funcs
+}
+
+// Len -
+func (b *followerEventsBuffer) Len() int {
+	return b.len()
+}
+
+// SetTimeHandler -
+func (b *followerEventsBuffer) SetTimeHandler(handler func() time.Time) {
+	b.getTimeHandler = handler
+}
+
+// NewPublishedBlocksCache -
+func NewPublishedBlocksCache(capacity uint32) *publishedBlocksCache {
+	return newPublishedBlocksCache(capacity)
+}
+
+// Put -
+func (c *publishedBlocksCache) Put(payload *data.RetractedEvents) {
+	c.put(payload)
+}
+
+// Pop -
+func (c *publishedBlocksCache) Pop(hash string) (*data.RetractedEvents, bool) {
+	return c.pop(hash)
+}
+
+// Len -
+func (c *publishedBlocksCache) Len() int {
+	return c.len()
+}
+
+// SetTimeHandler -
+func (sm *shardsMonitor) SetTimeHandler(handler func() time.Time) {
+	sm.getTimeHandler = handler
+}
+
+// CheckShards -
+func (sm *shardsMonitor) CheckShards() {
+	sm.checkShards()
 }
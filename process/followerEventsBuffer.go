@@ -0,0 +1,104 @@
+package process
+
+import (
+	"sync"
+	"time"
+)
+
+// followerEvent replays an event received while the instance was a follower
+type followerEvent func() error
+
+// followerEventsBuffer holds the most recent events received while the instance is a follower,
+// so that they can be replayed when it takes over. The oldest events are dropped when the
+// capacity is reached. The replayed events are replayed once more after the verify delay, since
+// they might have been reserved, but not published, by the previous leader.
+type followerEventsBuffer struct {
+	mut            sync.Mutex
+	capacity       int
+	verifyDelay    time.Duration
+	events         []followerEvent
+	verifyEvents   []followerEvent
+	verifyAt       time.Time
+	getTimeHandler func() time.Time
+}
+
+func newFollowerEventsBuffer(capacity uint32, verifyDelay time.Duration) *followerEventsBuffer {
+	return &followerEventsBuffer{
+		capacity:       int(capacity),
+		verifyDelay:    verifyDelay,
+		getTimeHandler: time.Now,
+	}
+}
+
+// add buffers the provided event. It returns the number of dropped events
+func (b *followerEventsBuffer) add(event followerEvent) int {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.events = append(b.events, event)
+
+	return b.dropOldest()
+}
+
+// putBack buffers again the events which could not be replayed, before the newer events
+func (b *followerEventsBuffer) putBack(events []followerEvent) int {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.events = append(append(make([]followerEvent, 0, len(events)+len(b.events)), events...), b.events...)
+
+	return b.dropOldest()
+}
+
+func (b *followerEventsBuffer) dropOldest() int {
+	numDropped := len(b.events) - b.capacity
+	if numDropped <= 0 {
+		return 0
+	}
+
+	b.events = b.events[numDropped:]
+
+	return numDropped
+}
+
+// scheduleVerify keeps the replayed events, to be replayed again after the verify delay
+func (b *followerEventsBuffer) scheduleVerify(events []followerEvent) {
+	if len(events) == 0 || b.verifyDelay <= 0 {
+		return
+	}
+
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.verifyEvents = append(b.verifyEvents, events...)
+	if len(b.verifyEvents) > b.capacity {
+		b.verifyEvents = b.verifyEvents[len(b.verifyEvents)-b.capacity:]
+	}
+	b.verifyAt = b.getTimeHandler().Add(b.verifyDelay)
+}
+
+// takeEvents returns and removes the buffered events, and the events to be verified, if the
+// verify delay has passed
+func (b *followerEventsBuffer) takeEvents() ([]followerEvent, []followerEvent) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	events := b.events
+	b.events = nil
+
+	var verifyEvents []followerEvent
+	if len(b.verifyEvents) > 0 && !b.getTimeHandler().Before(b.verifyAt) {
+		verifyEvents = b.verifyEvents
+		b.verifyEvents = nil
+	}
+
+	return events, verifyEvents
+}
+
+// len returns the number of buffered events
+func (b *followerEventsBuffer) len() int {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	return len(b.events)
+}
@@ -0,0 +1,107 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+type replayRecorder struct {
+	replayed []int
+}
+
+func (r *replayRecorder) event(id int) func() error {
+	return func() error {
+		r.replayed = append(r.replayed, id)
+		return nil
+	}
+}
+
+func replayAll(events []func() error) {
+	for _, event := range events {
+		_ = event()
+	}
+}
+
+func TestFollowerEventsBuffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("take events should return and remove the buffered events, in order", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &replayRecorder{}
+		buffer := process.NewFollowerEventsBuffer(10, 0)
+		require.Equal(t, 0, buffer.Add(recorder.event(1)))
+		require.Equal(t, 0, buffer.Add(recorder.event(2)))
+		require.Equal(t, 2, buffer.Len())
+
+		events, verifyEvents := buffer.TakeEvents()
+		require.Empty(t, verifyEvents)
+		replayAll(events)
+		require.Equal(t, []int{1, 2}, recorder.replayed)
+		require.Equal(t, 0, buffer.Len())
+	})
+
+	t.Run("oldest events should be dropped when full", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &replayRecorder{}
+		buffer := process.NewFollowerEventsBuffer(2, 0)
+		buffer.Add(recorder.event(1))
+		buffer.Add(recorder.event(2))
+		require.Equal(t, 1, buffer.Add(recorder.event(3)))
+
+		events, _ := buffer.TakeEvents()
+		replayAll(events)
+		require.Equal(t, []int{2, 3}, recorder.replayed)
+	})
+
+	t.Run("put back events should be placed before the newer events", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &replayRecorder{}
+		buffer := process.NewFollowerEventsBuffer(10, 0)
+		buffer.Add(recorder.event(3))
+		require.Equal(t, 0, buffer.PutBack([]func() error{recorder.event(1), recorder.event(2)}))
+
+		events, _ := buffer.TakeEvents()
+		replayAll(events)
+		require.Equal(t, []int{1, 2, 3}, recorder.replayed)
+	})
+
+	t.Run("verify events should be returned after the verify delay", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &replayRecorder{}
+		buffer := process.NewFollowerEventsBuffer(10, time.Minute)
+		currentTime := time.Now()
+		buffer.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		buffer.ScheduleVerify([]func() error{recorder.event(1)})
+		_, verifyEvents := buffer.TakeEvents()
+		require.Empty(t, verifyEvents)
+
+		currentTime = currentTime.Add(time.Minute)
+		_, verifyEvents = buffer.TakeEvents()
+		replayAll(verifyEvents)
+		require.Equal(t, []int{1}, recorder.replayed)
+
+		_, verifyEvents = buffer.TakeEvents()
+		require.Empty(t, verifyEvents)
+	})
+
+	t.Run("verify events should not be kept without verify delay", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &replayRecorder{}
+		buffer := process.NewFollowerEventsBuffer(10, 0)
+		buffer.ScheduleVerify([]func() error{recorder.event(1)})
+
+		_, verifyEvents := buffer.TakeEvents()
+		require.Empty(t, verifyEvents)
+	})
+}
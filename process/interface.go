@@ -2,15 +2,37 @@ package process
 
 import (
 	"context"
+	"time"
 
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
 // LockService defines the behaviour of a lock service component.
 // It makes sure that a duplicated entry is not processed multiple times.
+// An entry is reserved for a short period of time, and it is marked as
+// processed only after it is committed.
 type LockService interface {
-	IsEventProcessed(ctx context.Context, blockHash string) (bool, error)
+	ReserveEvent(ctx context.Context, key string) (bool, error)
+	CommitEvent(ctx context.Context, key string) error
 	HasConnection(ctx context.Context) bool
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// LeaderElector defines the behaviour of a leader election component.
+// Only the leader instance publishes the received events.
+type LeaderElector interface {
+	IsLeader() bool
+	FencingToken() uint64
+	IsInterfaceNil() bool
+}
+
+// WatchedTxsRegistry defines the behaviour of a component which shares the watched transactions
+// between the notifier instances
+type WatchedTxsRegistry interface {
+	AddTxs(txHashes []string, expiresAt time.Time) error
+	GetTxs() (map[string]time.Time, error)
+	RemoveTxs(txHashes []string) error
 	IsInterfaceNil() bool
 }
 
@@ -24,6 +46,20 @@ type Publisher interface {
 	BroadcastTxs(event data.BlockTxs)
 	BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder)
 	BroadcastScrs(event data.BlockScrs)
+	BroadcastRewards(event data.BlockRewards)
+	BroadcastReceipts(event data.BlockReceipts)
+	BroadcastInvalidTxs(event data.BlockInvalidTxs)
+	BroadcastAlteredAccounts(event data.BlockAlteredAccounts)
+	BroadcastBlockHeader(event data.BlockHeader)
+	BroadcastRoundsInfo(event data.RoundsInfo)
+	BroadcastValidatorsRating(event data.ValidatorsRating)
+	BroadcastValidatorsPubKeys(event data.ValidatorsPubKeys)
+	BroadcastEpochStart(event data.EpochStart)
+	BroadcastTxCompleted(event data.TxCompleted)
+	BroadcastFailedExecutions(event data.BlockFailedExecutions)
+	BroadcastGapDetected(event data.GapDetected)
+	BroadcastRetractedEvents(event data.RetractedEvents)
+	BroadcastShardStalled(event data.ShardStalled)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -31,8 +67,12 @@ type Publisher interface {
 // EventsHandler defines the behaviour of an events handler component
 type EventsHandler interface {
 	HandleSaveBlockEvents(allEvents data.ArgsSaveBlockData) error
-	HandleRevertEvents(revertBlock data.RevertBlock)
-	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	HandleRevertEvents(revertBlock data.RevertBlock) error
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error
+	HandleRoundsInfo(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRating(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -42,6 +82,12 @@ type EventsInterceptor interface {
 	IsInterfaceNil() bool
 }
 
+// EventsDecoder defines the behaviour of a component which decodes the raw topics of the events
+type EventsDecoder interface {
+	DecodeEvent(event data.Event) *data.DecodedEvent
+	IsInterfaceNil() bool
+}
+
 // WSClient defines what a websocket client should do
 type WSClient interface {
 	Close() error
@@ -52,14 +98,20 @@ type DataProcessor interface {
 	SaveBlock(marshalledData []byte) error
 	RevertIndexedBlock(marshalledData []byte) error
 	FinalizedBlock(marshalledData []byte) error
+	SaveRoundsInfo(marshalledData []byte) error
+	SaveValidatorsRating(marshalledData []byte) error
+	SaveValidatorsPubKeys(marshalledData []byte) error
 	IsInterfaceNil() bool
 }
 
 // EventsFacadeHandler defines the behavior of a facade handler needed for events group
 type EventsFacadeHandler interface {
 	HandlePushEvents(events data.ArgsSaveBlockData) error
-	HandleRevertEvents(revertBlock data.RevertBlock)
-	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	HandleRevertEvents(revertBlock data.RevertBlock) error
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock) error
+	HandleRoundsInfo(roundsInfo data.RoundsInfo) error
+	HandleValidatorsRating(validatorsRating data.ValidatorsRating) error
+	HandleValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) error
 	IsInterfaceNil() bool
 }
 
@@ -71,6 +123,20 @@ type PublisherHandler interface {
 	PublishTxs(blockTxs data.BlockTxs)
 	PublishScrs(blockScrs data.BlockScrs)
 	PublishBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder)
+	PublishRewards(blockRewards data.BlockRewards)
+	PublishReceipts(blockReceipts data.BlockReceipts)
+	PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs)
+	PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts)
+	PublishBlockHeader(blockHeader data.BlockHeader)
+	PublishRoundsInfo(roundsInfo data.RoundsInfo)
+	PublishValidatorsRating(validatorsRating data.ValidatorsRating)
+	PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys)
+	PublishEpochStart(epochStart data.EpochStart)
+	PublishTxCompleted(txCompleted data.TxCompleted)
+	PublishFailedExecutions(failedExecutions data.BlockFailedExecutions)
+	PublishGapDetected(gapDetected data.GapDetected)
+	PublishRetractedEvents(retractedEvents data.RetractedEvents)
+	PublishShardStalled(shardStalled data.ShardStalled)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -89,15 +89,33 @@ func (ph *payloadHandler) finalizedBlock(marshalledData []byte, version uint32)
 }
 
 func (ph *payloadHandler) saveRounds(marshalledData []byte, version uint32) error {
-	return nil
+	dataProcessor, ok := ph.dataProcessors[version]
+	if !ok {
+		log.Warn("invalid provided version", "version", version)
+		return ErrInvalidPayloadType
+	}
+
+	return dataProcessor.SaveRoundsInfo(marshalledData)
 }
 
 func (ph *payloadHandler) saveValidatorsRating(marshalledData []byte, version uint32) error {
-	return nil
+	dataProcessor, ok := ph.dataProcessors[version]
+	if !ok {
+		log.Warn("invalid provided version", "version", version)
+		return ErrInvalidPayloadType
+	}
+
+	return dataProcessor.SaveValidatorsRating(marshalledData)
 }
 
 func (ph *payloadHandler) saveValidatorsPubKeys(marshalledData []byte, version uint32) error {
-	return nil
+	dataProcessor, ok := ph.dataProcessors[version]
+	if !ok {
+		log.Warn("invalid provided version", "version", version)
+		return ErrInvalidPayloadType
+	}
+
+	return dataProcessor.SaveValidatorsPubKeys(marshalledData)
 }
 
 func (ph *payloadHandler) saveAccounts(marshalledData []byte, version uint32) error {
@@ -161,4 +161,44 @@ func TestProcessPayload(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, wasCalled)
 	})
+
+	t.Run("validators and rounds topics", func(t *testing.T) {
+		t.Parallel()
+
+		calledMethods := make([]string, 0)
+		eventsProcessors := make(map[uint32]process.DataProcessor)
+		dp := &mocks.EventsDataProcessorStub{
+			SaveRoundsInfoCalled: func(marshalledData []byte) error {
+				calledMethods = append(calledMethods, outport.TopicSaveRoundsInfo)
+				return nil
+			},
+			SaveValidatorsRatingCalled: func(marshalledData []byte) error {
+				calledMethods = append(calledMethods, outport.TopicSaveValidatorsRating)
+				return nil
+			},
+			SaveValidatorsPubKeysCalled: func(marshalledData []byte) error {
+				calledMethods = append(calledMethods, outport.TopicSaveValidatorsPubKeys)
+				return nil
+			},
+		}
+		eventsProcessors[common.PayloadV1] = dp
+
+		ei, err := process.NewPayloadHandler(eventsProcessors)
+		require.Nil(t, err)
+
+		topics := []string{
+			outport.TopicSaveRoundsInfo,
+			outport.TopicSaveValidatorsRating,
+			outport.TopicSaveValidatorsPubKeys,
+		}
+		for _, topic := range topics {
+			err = ei.ProcessPayload([]byte("payload"), topic, common.PayloadV0)
+			require.Equal(t, process.ErrInvalidPayloadType, err)
+
+			err = ei.ProcessPayload([]byte("payload"), topic, common.PayloadV1)
+			require.Nil(t, err)
+		}
+
+		require.Equal(t, topics, calledMethods)
+	})
 }
@@ -1,13 +1,18 @@
 package preprocess
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/process"
 )
 
@@ -67,6 +72,84 @@ func (bep *baseEventsPreProcessor) getHeaderFromBytes(headerType core.HeaderType
 	return block.GetHeaderFromBytes(bep.marshaller, creator, headerBytes)
 }
 
+// SaveRoundsInfo will handle the rounds info event
+func (bep *baseEventsPreProcessor) SaveRoundsInfo(marshalledData []byte) error {
+	roundsInfo := &outport.RoundsInfo{}
+	err := bep.marshaller.Unmarshal(roundsInfo, marshalledData)
+	if err != nil {
+		return err
+	}
+
+	if len(roundsInfo.RoundsInfo) == 0 {
+		log.Debug("received empty rounds info", "shard", roundsInfo.ShardID)
+		return nil
+	}
+
+	lastRound := uint64(0)
+	for _, roundInfo := range roundsInfo.RoundsInfo {
+		if roundInfo != nil && roundInfo.Round > lastRound {
+			lastRound = roundInfo.Round
+		}
+	}
+
+	roundsData := data.RoundsInfo{
+		ID:         fmt.Sprintf("%d_%d", roundsInfo.ShardID, lastRound),
+		ShardID:    roundsInfo.ShardID,
+		RoundsInfo: roundsInfo.RoundsInfo,
+	}
+
+	return bep.facade.HandleRoundsInfo(roundsData)
+}
+
+// SaveValidatorsRating will handle the validators rating event
+func (bep *baseEventsPreProcessor) SaveValidatorsRating(marshalledData []byte) error {
+	validatorsRating := &outport.ValidatorsRating{}
+	err := bep.marshaller.Unmarshal(validatorsRating, marshalledData)
+	if err != nil {
+		return err
+	}
+
+	ratingData := data.ValidatorsRating{
+		ID:                   fmt.Sprintf("%d_%d", validatorsRating.ShardID, validatorsRating.Epoch),
+		ShardID:              validatorsRating.ShardID,
+		Epoch:                validatorsRating.Epoch,
+		ValidatorsRatingInfo: validatorsRating.ValidatorsRatingInfo,
+	}
+
+	return bep.facade.HandleValidatorsRating(ratingData)
+}
+
+// SaveValidatorsPubKeys will handle the validators public keys event
+func (bep *baseEventsPreProcessor) SaveValidatorsPubKeys(marshalledData []byte) error {
+	validatorsPubKeys := &outport.ValidatorsPubKeys{}
+	err := bep.marshaller.Unmarshal(validatorsPubKeys, marshalledData)
+	if err != nil {
+		return err
+	}
+
+	pubKeys := make(map[uint32][]string, len(validatorsPubKeys.ShardValidatorsPubKeys))
+	for shardID, shardPubKeys := range validatorsPubKeys.ShardValidatorsPubKeys {
+		if shardPubKeys == nil {
+			continue
+		}
+
+		encodedKeys := make([]string, 0, len(shardPubKeys.Keys))
+		for _, key := range shardPubKeys.Keys {
+			encodedKeys = append(encodedKeys, hex.EncodeToString(key))
+		}
+		pubKeys[shardID] = encodedKeys
+	}
+
+	pubKeysData := data.ValidatorsPubKeys{
+		ID:                fmt.Sprintf("%d_%d", validatorsPubKeys.ShardID, validatorsPubKeys.Epoch),
+		ShardID:           validatorsPubKeys.ShardID,
+		Epoch:             validatorsPubKeys.Epoch,
+		ValidatorsPubKeys: pubKeys,
+	}
+
+	return bep.facade.HandleValidatorsPubKeys(pubKeysData)
+}
+
 func createEmptyBlockCreatorContainer() (EmptyBlockCreatorContainer, error) {
 	container := block.NewEmptyBlockCreatorsContainer()
 
@@ -1,10 +1,14 @@
 package preprocess_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/mock"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/multiversx/mx-chain-notifier-go/process/preprocess"
 	"github.com/stretchr/testify/require"
@@ -51,3 +55,140 @@ func TestNewBaseEventsPreProcessor(t *testing.T) {
 		require.NotNil(t, dp)
 	})
 }
+
+func TestBaseEventsPreProcessor_SaveRoundsInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid payload should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := preprocess.NewBaseEventsPreProcessor(createMockEventsDataPreProcessorArgs())
+		require.Nil(t, err)
+
+		err = dp.SaveRoundsInfo([]byte("invalid"))
+		require.NotNil(t, err)
+	})
+
+	t.Run("empty rounds info should not be handled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventsDataPreProcessorArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandleRoundsInfoCalled: func(roundsInfo data.RoundsInfo) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		dp, err := preprocess.NewBaseEventsPreProcessor(args)
+		require.Nil(t, err)
+
+		marshalledData, _ := json.Marshal(&outport.RoundsInfo{ShardID: 1})
+		err = dp.SaveRoundsInfo(marshalledData)
+		require.Nil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		roundsInfo := []*outport.RoundInfo{
+			{Round: 11, SignersIndexes: []uint64{0, 1}, BlockWasProposed: true, ShardId: 1},
+			{Round: 12, SignersIndexes: []uint64{1, 2}, ShardId: 1},
+		}
+
+		var handledRoundsInfo data.RoundsInfo
+		args := createMockEventsDataPreProcessorArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandleRoundsInfoCalled: func(roundsInfo data.RoundsInfo) error {
+				handledRoundsInfo = roundsInfo
+				return nil
+			},
+		}
+
+		dp, err := preprocess.NewBaseEventsPreProcessor(args)
+		require.Nil(t, err)
+
+		marshalledData, _ := json.Marshal(&outport.RoundsInfo{ShardID: 1, RoundsInfo: roundsInfo})
+		err = dp.SaveRoundsInfo(marshalledData)
+		require.Nil(t, err)
+
+		expectedRoundsInfo := data.RoundsInfo{
+			ID:         "1_12",
+			ShardID:    1,
+			RoundsInfo: roundsInfo,
+		}
+		require.Equal(t, expectedRoundsInfo, handledRoundsInfo)
+	})
+}
+
+func TestBaseEventsPreProcessor_SaveValidatorsRating(t *testing.T) {
+	t.Parallel()
+
+	ratingInfo := []*outport.ValidatorRatingInfo{
+		{PublicKey: "pubKey1", Rating: 50},
+		{PublicKey: "pubKey2", Rating: 75.5},
+	}
+
+	var handledRating data.ValidatorsRating
+	args := createMockEventsDataPreProcessorArgs()
+	args.Facade = &mocks.FacadeStub{
+		HandleValidatorsRatingCalled: func(validatorsRating data.ValidatorsRating) error {
+			handledRating = validatorsRating
+			return nil
+		},
+	}
+
+	dp, err := preprocess.NewBaseEventsPreProcessor(args)
+	require.Nil(t, err)
+
+	marshalledData, _ := json.Marshal(&outport.ValidatorsRating{ShardID: 2, Epoch: 3, ValidatorsRatingInfo: ratingInfo})
+	err = dp.SaveValidatorsRating(marshalledData)
+	require.Nil(t, err)
+
+	expectedRating := data.ValidatorsRating{
+		ID:                   "2_3",
+		ShardID:              2,
+		Epoch:                3,
+		ValidatorsRatingInfo: ratingInfo,
+	}
+	require.Equal(t, expectedRating, handledRating)
+}
+
+func TestBaseEventsPreProcessor_SaveValidatorsPubKeys(t *testing.T) {
+	t.Parallel()
+
+	var handledPubKeys data.ValidatorsPubKeys
+	args := createMockEventsDataPreProcessorArgs()
+	args.Facade = &mocks.FacadeStub{
+		HandleValidatorsPubKeysCalled: func(validatorsPubKeys data.ValidatorsPubKeys) error {
+			handledPubKeys = validatorsPubKeys
+			return nil
+		},
+	}
+
+	dp, err := preprocess.NewBaseEventsPreProcessor(args)
+	require.Nil(t, err)
+
+	validatorsPubKeys := &outport.ValidatorsPubKeys{
+		ShardID: 4294967295,
+		Epoch:   3,
+		ShardValidatorsPubKeys: map[uint32]*outport.PubKeys{
+			0: {Keys: [][]byte{[]byte("key1"), []byte("key2")}},
+			1: {Keys: [][]byte{[]byte("key3")}},
+		},
+	}
+	marshalledData, _ := json.Marshal(validatorsPubKeys)
+	err = dp.SaveValidatorsPubKeys(marshalledData)
+	require.Nil(t, err)
+
+	expectedPubKeys := data.ValidatorsPubKeys{
+		ID:      "4294967295_3",
+		ShardID: 4294967295,
+		Epoch:   3,
+		ValidatorsPubKeys: map[uint32][]string{
+			0: {hex.EncodeToString([]byte("key1")), hex.EncodeToString([]byte("key2"))},
+			1: {hex.EncodeToString([]byte("key3"))},
+		},
+	}
+	require.Equal(t, expectedPubKeys, handledPubKeys)
+}
@@ -8,6 +8,7 @@ import (
 	nodeData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/process"
 )
@@ -75,6 +76,9 @@ func (d *eventsPreProcessorV0) parseTransactionsPool(txsPool *data.TransactionsP
 	return &outport.TransactionPool{
 		Transactions:         d.parseTxs(txsPool.Txs),
 		SmartContractResults: d.parseScrs(txsPool.Scrs),
+		Rewards:              d.parseRewards(txsPool.Rewards),
+		Receipts:             d.parseReceipts(txsPool.Receipts),
+		InvalidTxs:           d.parseTxs(txsPool.Invalid),
 		Logs:                 d.parseLogs(txsPool.Logs),
 	}, nil
 }
@@ -113,6 +117,35 @@ func (d *eventsPreProcessorV0) parseScrs(scrs map[string]*data.NodeSmartContract
 	return newScrs
 }
 
+func (d *eventsPreProcessorV0) parseRewards(rewards map[string]*data.NodeRewardTx) map[string]*outport.RewardInfo {
+	newRewards := make(map[string]*outport.RewardInfo)
+	for hash, rewardHandler := range rewards {
+		if rewardHandler == nil {
+			continue
+		}
+
+		newRewards[hash] = &outport.RewardInfo{
+			Reward:         rewardHandler.TransactionHandler,
+			ExecutionOrder: uint32(rewardHandler.ExecutionOrder),
+		}
+	}
+
+	return newRewards
+}
+
+func (d *eventsPreProcessorV0) parseReceipts(receipts map[string]*data.NodeReceipt) map[string]*receipt.Receipt {
+	newReceipts := make(map[string]*receipt.Receipt)
+	for hash, receiptHandler := range receipts {
+		if receiptHandler == nil {
+			continue
+		}
+
+		newReceipts[hash] = receiptHandler.TransactionHandler
+	}
+
+	return newReceipts
+}
+
 func (d *eventsPreProcessorV0) parseLogs(logs []*data.LogData) []*outport.LogData {
 	newLogs := make([]*outport.LogData, len(logs))
 	for _, logHandler := range logs {
@@ -164,9 +197,7 @@ func (d *eventsPreProcessorV0) RevertIndexedBlock(marshalledData []byte) error {
 		return err
 	}
 
-	d.facade.HandleRevertEvents(*revertBlock)
-
-	return nil
+	return d.facade.HandleRevertEvents(*revertBlock)
 }
 
 // FinalizedBlock will handle the finalized block event
@@ -177,9 +208,7 @@ func (d *eventsPreProcessorV0) FinalizedBlock(marshalledData []byte) error {
 		return err
 	}
 
-	d.facade.HandleFinalizedEvents(*finalizedBlock)
-
-	return nil
+	return d.facade.HandleFinalizedEvents(*finalizedBlock)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -110,9 +110,7 @@ func (d *eventsPreProcessorV1) RevertIndexedBlock(marshalledData []byte) error {
 		TimeStamp: header.GetTimeStamp(),
 	}
 
-	d.facade.HandleRevertEvents(*revertData)
-
-	return nil
+	return d.facade.HandleRevertEvents(*revertData)
 }
 
 // FinalizedBlock will handle the finalized block event
@@ -127,9 +125,7 @@ func (d *eventsPreProcessorV1) FinalizedBlock(marshalledData []byte) error {
 		Hash: hex.EncodeToString(finalizedBlock.GetHeaderHash()),
 	}
 
-	d.facade.HandleFinalizedEvents(finalizedData)
-
-	return nil
+	return d.facade.HandleFinalizedEvents(finalizedData)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
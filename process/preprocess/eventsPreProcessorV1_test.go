@@ -174,9 +174,10 @@ func TestPreProcessorV1_RevertIndexerBlock(t *testing.T) {
 
 		revertCalled := false
 		args.Facade = &mocks.FacadeStub{
-			HandleRevertEventsCalled: func(events data.RevertBlock) {
+			HandleRevertEventsCalled: func(events data.RevertBlock) error {
 				revertCalled = true
 				require.Equal(t, expRevertBlock, events)
+				return nil
 			},
 		}
 
@@ -0,0 +1,73 @@
+package process
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// publishedBlocksCache holds the payloads published for the most recent blocks, so that
+// they can be retracted if the blocks are reverted. The oldest blocks are evicted when
+// the capacity is reached.
+type publishedBlocksCache struct {
+	mut      sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+func newPublishedBlocksCache(capacity uint32) *publishedBlocksCache {
+	return &publishedBlocksCache{
+		capacity: int(capacity),
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// put adds the payload published for a block, replacing the previous one, if any
+func (c *publishedBlocksCache) put(payload *data.RetractedEvents) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	element, exists := c.entries[payload.Hash]
+	if exists {
+		element.Value = payload
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[payload.Hash] = c.order.PushFront(payload)
+
+	for c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// pop returns and removes the payload published for the provided block hash
+func (c *publishedBlocksCache) pop(hash string) (*data.RetractedEvents, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	element, exists := c.entries[hash]
+	if !exists {
+		return nil, false
+	}
+
+	c.removeElement(element)
+
+	return element.Value.(*data.RetractedEvents), true
+}
+
+// len returns the number of cached blocks
+func (c *publishedBlocksCache) len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *publishedBlocksCache) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*data.RetractedEvents).Hash)
+}
@@ -0,0 +1,60 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishedBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pop should return and remove the cached payload", func(t *testing.T) {
+		t.Parallel()
+
+		cache := process.NewPublishedBlocksCache(10)
+		payload := &data.RetractedEvents{Hash: "hash1", Nonce: 1}
+		cache.Put(payload)
+		require.Equal(t, 1, cache.Len())
+
+		cachedPayload, ok := cache.Pop("hash1")
+		require.True(t, ok)
+		require.Equal(t, payload, cachedPayload)
+		require.Equal(t, 0, cache.Len())
+
+		_, ok = cache.Pop("hash1")
+		require.False(t, ok)
+	})
+
+	t.Run("put should replace the payload of the same hash", func(t *testing.T) {
+		t.Parallel()
+
+		cache := process.NewPublishedBlocksCache(10)
+		cache.Put(&data.RetractedEvents{Hash: "hash1", Nonce: 1})
+		cache.Put(&data.RetractedEvents{Hash: "hash1", Nonce: 2})
+		require.Equal(t, 1, cache.Len())
+
+		cachedPayload, ok := cache.Pop("hash1")
+		require.True(t, ok)
+		require.Equal(t, uint64(2), cachedPayload.Nonce)
+	})
+
+	t.Run("oldest blocks should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		cache := process.NewPublishedBlocksCache(2)
+		cache.Put(&data.RetractedEvents{Hash: "hash1"})
+		cache.Put(&data.RetractedEvents{Hash: "hash2"})
+		cache.Put(&data.RetractedEvents{Hash: "hash3"})
+		require.Equal(t, 2, cache.Len())
+
+		_, ok := cache.Pop("hash1")
+		require.False(t, ok)
+		_, ok = cache.Pop("hash2")
+		require.True(t, ok)
+		_, ok = cache.Pop("hash3")
+		require.True(t, ok)
+	})
+}
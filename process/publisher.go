@@ -18,9 +18,25 @@ type publisher struct {
 	broadcastTxs                  chan data.BlockTxs
 	broadcastBlockEventsWithOrder chan data.BlockEventsWithOrder
 	broadcastScrs                 chan data.BlockScrs
+	broadcastRewards              chan data.BlockRewards
+	broadcastReceipts             chan data.BlockReceipts
+	broadcastInvalidTxs           chan data.BlockInvalidTxs
+	broadcastAlteredAccounts      chan data.BlockAlteredAccounts
+	broadcastBlockHeader          chan data.BlockHeader
+	broadcastRoundsInfo           chan data.RoundsInfo
+	broadcastValidatorsRating     chan data.ValidatorsRating
+	broadcastValidatorsPubKeys    chan data.ValidatorsPubKeys
+	broadcastEpochStart           chan data.EpochStart
+	broadcastTxCompleted          chan data.TxCompleted
+	broadcastFailedExecutions     chan data.BlockFailedExecutions
+	broadcastGapDetected          chan data.GapDetected
+	broadcastRetractedEvents      chan data.RetractedEvents
+	broadcastShardStalled         chan data.ShardStalled
 
 	cancelFunc func()
 	closeChan  chan struct{}
+	loopClosed chan struct{}
+	closeErr   error
 	mutState   sync.RWMutex
 }
 
@@ -38,7 +54,22 @@ func NewPublisher(handler PublisherHandler) (*publisher, error) {
 		broadcastTxs:                  make(chan data.BlockTxs),
 		broadcastScrs:                 make(chan data.BlockScrs),
 		broadcastBlockEventsWithOrder: make(chan data.BlockEventsWithOrder),
+		broadcastRewards:              make(chan data.BlockRewards),
+		broadcastReceipts:             make(chan data.BlockReceipts),
+		broadcastInvalidTxs:           make(chan data.BlockInvalidTxs),
+		broadcastAlteredAccounts:      make(chan data.BlockAlteredAccounts),
+		broadcastBlockHeader:          make(chan data.BlockHeader),
+		broadcastRoundsInfo:           make(chan data.RoundsInfo),
+		broadcastValidatorsRating:     make(chan data.ValidatorsRating),
+		broadcastValidatorsPubKeys:    make(chan data.ValidatorsPubKeys),
+		broadcastEpochStart:           make(chan data.EpochStart),
+		broadcastTxCompleted:          make(chan data.TxCompleted),
+		broadcastFailedExecutions:     make(chan data.BlockFailedExecutions),
+		broadcastGapDetected:          make(chan data.GapDetected),
+		broadcastRetractedEvents:      make(chan data.RetractedEvents),
+		broadcastShardStalled:         make(chan data.ShardStalled),
 		closeChan:                     make(chan struct{}),
+		loopClosed:                    make(chan struct{}),
 	}
 
 	return p, nil
@@ -65,7 +96,8 @@ func (p *publisher) run(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			p.handler.Close()
+			p.closeErr = p.handler.Close()
+			close(p.loopClosed)
 			return
 		case events := <-p.broadcast:
 			p.handler.Publish(events)
@@ -79,6 +111,34 @@ func (p *publisher) run(ctx context.Context) {
 			p.handler.PublishScrs(blockScrs)
 		case blockEvents := <-p.broadcastBlockEventsWithOrder:
 			p.handler.PublishBlockEventsWithOrder(blockEvents)
+		case blockRewards := <-p.broadcastRewards:
+			p.handler.PublishRewards(blockRewards)
+		case blockReceipts := <-p.broadcastReceipts:
+			p.handler.PublishReceipts(blockReceipts)
+		case blockInvalidTxs := <-p.broadcastInvalidTxs:
+			p.handler.PublishInvalidTxs(blockInvalidTxs)
+		case alteredAccounts := <-p.broadcastAlteredAccounts:
+			p.handler.PublishAlteredAccounts(alteredAccounts)
+		case blockHeader := <-p.broadcastBlockHeader:
+			p.handler.PublishBlockHeader(blockHeader)
+		case roundsInfo := <-p.broadcastRoundsInfo:
+			p.handler.PublishRoundsInfo(roundsInfo)
+		case validatorsRating := <-p.broadcastValidatorsRating:
+			p.handler.PublishValidatorsRating(validatorsRating)
+		case validatorsPubKeys := <-p.broadcastValidatorsPubKeys:
+			p.handler.PublishValidatorsPubKeys(validatorsPubKeys)
+		case epochStart := <-p.broadcastEpochStart:
+			p.handler.PublishEpochStart(epochStart)
+		case txCompleted := <-p.broadcastTxCompleted:
+			p.handler.PublishTxCompleted(txCompleted)
+		case failedExecutions := <-p.broadcastFailedExecutions:
+			p.handler.PublishFailedExecutions(failedExecutions)
+		case gapDetected := <-p.broadcastGapDetected:
+			p.handler.PublishGapDetected(gapDetected)
+		case retractedEvents := <-p.broadcastRetractedEvents:
+			p.handler.PublishRetractedEvents(retractedEvents)
+		case shardStalled := <-p.broadcastShardStalled:
+			p.handler.PublishShardStalled(shardStalled)
 		}
 	}
 }
@@ -131,18 +191,134 @@ func (p *publisher) BroadcastBlockEventsWithOrder(events data.BlockEventsWithOrd
 	}
 }
 
-// Close will close the channels
+// BroadcastRewards will handle the block rewards event pushed by producers
+func (p *publisher) BroadcastRewards(events data.BlockRewards) {
+	select {
+	case p.broadcastRewards <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastReceipts will handle the block receipts event pushed by producers
+func (p *publisher) BroadcastReceipts(events data.BlockReceipts) {
+	select {
+	case p.broadcastReceipts <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastInvalidTxs will handle the block invalid txs event pushed by producers
+func (p *publisher) BroadcastInvalidTxs(events data.BlockInvalidTxs) {
+	select {
+	case p.broadcastInvalidTxs <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastAlteredAccounts will handle the altered accounts event pushed by producers
+func (p *publisher) BroadcastAlteredAccounts(events data.BlockAlteredAccounts) {
+	select {
+	case p.broadcastAlteredAccounts <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastBlockHeader will handle the block headers event pushed by producers
+func (p *publisher) BroadcastBlockHeader(events data.BlockHeader) {
+	select {
+	case p.broadcastBlockHeader <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastRoundsInfo will handle the rounds info event pushed by producers
+func (p *publisher) BroadcastRoundsInfo(events data.RoundsInfo) {
+	select {
+	case p.broadcastRoundsInfo <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastValidatorsRating will handle the validators rating event pushed by producers
+func (p *publisher) BroadcastValidatorsRating(events data.ValidatorsRating) {
+	select {
+	case p.broadcastValidatorsRating <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastValidatorsPubKeys will handle the validators public keys event pushed by producers
+func (p *publisher) BroadcastValidatorsPubKeys(events data.ValidatorsPubKeys) {
+	select {
+	case p.broadcastValidatorsPubKeys <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastEpochStart will handle the epoch start event pushed by producers
+func (p *publisher) BroadcastEpochStart(events data.EpochStart) {
+	select {
+	case p.broadcastEpochStart <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastTxCompleted will handle the transaction completed event pushed by producers
+func (p *publisher) BroadcastTxCompleted(events data.TxCompleted) {
+	select {
+	case p.broadcastTxCompleted <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastFailedExecutions will handle the failed executions event pushed by producers
+func (p *publisher) BroadcastFailedExecutions(events data.BlockFailedExecutions) {
+	select {
+	case p.broadcastFailedExecutions <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastGapDetected will handle the nonce gap detected event pushed by producers
+func (p *publisher) BroadcastGapDetected(events data.GapDetected) {
+	select {
+	case p.broadcastGapDetected <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastRetractedEvents will handle the retracted events pushed by producers
+func (p *publisher) BroadcastRetractedEvents(events data.RetractedEvents) {
+	select {
+	case p.broadcastRetractedEvents <- events:
+	case <-p.closeChan:
+	}
+}
+
+// BroadcastShardStalled will handle the stalled shard event pushed by producers
+func (p *publisher) BroadcastShardStalled(events data.ShardStalled) {
+	select {
+	case p.broadcastShardStalled <- events:
+	case <-p.closeChan:
+	}
+}
+
+// Close will close the channels. If the processing loop was started, it waits for the loop
+// to close the handler and it returns the handler's close error
 func (p *publisher) Close() error {
 	p.mutState.RLock()
 	defer p.mutState.RUnlock()
 
-	if p.cancelFunc != nil {
-		p.cancelFunc()
+	close(p.closeChan)
+
+	if p.cancelFunc == nil {
+		return nil
 	}
 
-	close(p.closeChan)
+	p.cancelFunc()
+	<-p.loopClosed
 
-	return nil
+	return p.closeErr
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
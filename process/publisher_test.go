@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -242,4 +243,71 @@ func TestClose(t *testing.T) {
 
 		require.Equal(t, uint32(0), atomic.LoadUint32(&numCalls))
 	})
+
+	t.Run("should wait for the handler to close and return its error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		handlerClosed := uint32(0)
+		ph := &mocks.PublisherHandlerStub{
+			CloseCalled: func() error {
+				time.Sleep(100 * time.Millisecond)
+				atomic.StoreUint32(&handlerClosed, 1)
+				return expectedErr
+			},
+		}
+
+		p, err := process.NewPublisher(ph)
+		require.Nil(t, err)
+
+		_ = p.Run()
+
+		err = p.Close()
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&handlerClosed))
+	})
+
+	t.Run("not started, should not close the handler", func(t *testing.T) {
+		t.Parallel()
+
+		ph := &mocks.PublisherHandlerStub{
+			CloseCalled: func() error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		p, err := process.NewPublisher(ph)
+		require.Nil(t, err)
+
+		err = p.Close()
+		require.Nil(t, err)
+	})
+}
+
+func TestBroadcastRewards(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	ph := &mocks.PublisherHandlerStub{
+		PublishRewardsCalled: func(blockRewards data.BlockRewards) {
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+		},
+	}
+
+	p, err := process.NewPublisher(ph)
+	require.Nil(t, err)
+
+	_ = p.Run()
+	defer p.Close()
+	wg.Add(1)
+
+	p.BroadcastRewards(data.BlockRewards{})
+
+	wg.Wait()
+
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
 }
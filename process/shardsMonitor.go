@@ -0,0 +1,267 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	livenessMetricPrefix      = "Liveness"
+	shardStalledMetricID      = "Liveness-shard-stalled"
+	sinceLastBlockMetricLabel = "since-last-block-sec"
+	lagMetricLabel            = "lag-ms"
+)
+
+// ArgsShardsMonitor defines the arguments needed for shards monitor creation
+type ArgsShardsMonitor struct {
+	Publisher            Publisher
+	StatusMetricsHandler common.StatusMetricsHandler
+	LeaderElector        LeaderElector
+	StallTimeout         time.Duration
+	CheckInterval        time.Duration
+	PublishStalledShards bool
+}
+
+type shardLiveness struct {
+	lastNonce           uint64
+	lastBlockHash       string
+	lastHeaderTimestamp uint64
+	lastReceivedAt      time.Time
+	lag                 time.Duration
+	stalled             bool
+}
+
+// shardsMonitor keeps track, for each shard, of the last received block and of the lag between
+// the header timestamp and the receive time. A shard is considered stalled if no block is received
+// within the stall timeout, in which case an error is logged and a shard stalled event is published.
+// Only the shards from which at least one block was received are monitored.
+type shardsMonitor struct {
+	publisher            Publisher
+	metricsHandler       common.StatusMetricsHandler
+	leaderElector        LeaderElector
+	stallTimeout         time.Duration
+	checkInterval        time.Duration
+	publishStalledShards bool
+	getTimeHandler       func() time.Time
+
+	mut    sync.RWMutex
+	shards map[uint32]*shardLiveness
+
+	closeChan  chan struct{}
+	loopClosed chan struct{}
+}
+
+// NewShardsMonitor creates a new shards monitor instance
+func NewShardsMonitor(args ArgsShardsMonitor) (*shardsMonitor, error) {
+	err := checkShardsMonitorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sm := &shardsMonitor{
+		publisher:            args.Publisher,
+		metricsHandler:       args.StatusMetricsHandler,
+		leaderElector:        args.LeaderElector,
+		stallTimeout:         args.StallTimeout,
+		checkInterval:        args.CheckInterval,
+		publishStalledShards: args.PublishStalledShards,
+		getTimeHandler:       time.Now,
+		shards:               make(map[uint32]*shardLiveness),
+		closeChan:            make(chan struct{}),
+		loopClosed:           make(chan struct{}),
+	}
+
+	go sm.checkLoop()
+
+	return sm, nil
+}
+
+func checkShardsMonitorArgs(args ArgsShardsMonitor) error {
+	if check.IfNil(args.Publisher) {
+		return ErrNilPublisherService
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.LeaderElector) {
+		return ErrNilLeaderElector
+	}
+	if args.StallTimeout <= 0 {
+		return fmt.Errorf("%w for StallTimeout", ErrInvalidValue)
+	}
+	if args.CheckInterval <= 0 {
+		return fmt.Errorf("%w for CheckInterval", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// BlockReceived registers the provided block as the last one received for its shard
+func (sm *shardsMonitor) BlockReceived(blockData *data.ArgsSaveBlockData) {
+	if blockData == nil || check.IfNil(blockData.Header) {
+		return
+	}
+
+	receivedAt := sm.getTimeHandler()
+	shardID := blockData.Header.GetShardID()
+	headerTimestamp := blockData.Header.GetTimeStamp()
+
+	lag := receivedAt.Sub(time.Unix(int64(headerTimestamp), 0))
+	if lag < 0 {
+		lag = 0
+	}
+
+	sm.mut.Lock()
+	shard, ok := sm.shards[shardID]
+	if !ok {
+		shard = &shardLiveness{}
+		sm.shards[shardID] = shard
+	}
+	if shard.stalled {
+		log.Info("shardsMonitor: blocks received again for stalled shard",
+			"shard", shardID,
+			"nonce", blockData.Header.GetNonce(),
+			"stalled for", receivedAt.Sub(shard.lastReceivedAt),
+		)
+	}
+
+	shard.lastNonce = blockData.Header.GetNonce()
+	shard.lastBlockHash = hex.EncodeToString(blockData.HeaderHash)
+	shard.lastHeaderTimestamp = headerTimestamp
+	shard.lastReceivedAt = receivedAt
+	shard.lag = lag
+	shard.stalled = false
+	sm.mut.Unlock()
+
+	sm.metricsHandler.SetGauge(getShardMetricID(shardID, lagMetricLabel), uint64(lag.Milliseconds()))
+	sm.metricsHandler.SetGauge(getShardMetricID(shardID, sinceLastBlockMetricLabel), 0)
+}
+
+// GetShardsStatus returns the liveness status of the monitored shards, sorted by shard ID
+func (sm *shardsMonitor) GetShardsStatus() []data.ShardStatus {
+	now := sm.getTimeHandler()
+
+	sm.mut.RLock()
+	defer sm.mut.RUnlock()
+
+	statuses := make([]data.ShardStatus, 0, len(sm.shards))
+	for shardID, shard := range sm.shards {
+		statuses = append(statuses, data.ShardStatus{
+			ShardID:               shardID,
+			LastNonce:             shard.lastNonce,
+			LastBlockHash:         shard.lastBlockHash,
+			LastHeaderTimestamp:   shard.lastHeaderTimestamp,
+			LastReceivedTimestamp: shard.lastReceivedAt.Unix(),
+			SinceLastBlockInSec:   uint64(now.Sub(shard.lastReceivedAt).Seconds()),
+			LagInMs:               uint64(shard.lag.Milliseconds()),
+			Stalled:               shard.stalled,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ShardID < statuses[j].ShardID
+	})
+
+	return statuses
+}
+
+func (sm *shardsMonitor) checkLoop() {
+	defer close(sm.loopClosed)
+
+	ticker := time.NewTicker(sm.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sm.closeChan:
+			return
+		case <-ticker.C:
+			sm.checkShards()
+		}
+	}
+}
+
+// checkShards updates the time since the last block metrics, and it reports the newly stalled shards
+func (sm *shardsMonitor) checkShards() {
+	now := sm.getTimeHandler()
+
+	stalledShards := make([]data.ShardStatus, 0)
+	sm.mut.Lock()
+	for shardID, shard := range sm.shards {
+		sinceLastBlock := now.Sub(shard.lastReceivedAt)
+		sm.metricsHandler.SetGauge(getShardMetricID(shardID, sinceLastBlockMetricLabel), uint64(sinceLastBlock.Seconds()))
+
+		if shard.stalled || sinceLastBlock < sm.stallTimeout {
+			continue
+		}
+
+		shard.stalled = true
+		stalledShards = append(stalledShards, data.ShardStatus{
+			ShardID:               shardID,
+			LastNonce:             shard.lastNonce,
+			LastBlockHash:         shard.lastBlockHash,
+			LastReceivedTimestamp: shard.lastReceivedAt.Unix(),
+			SinceLastBlockInSec:   uint64(sinceLastBlock.Seconds()),
+		})
+	}
+	sm.mut.Unlock()
+
+	sort.Slice(stalledShards, func(i, j int) bool {
+		return stalledShards[i].ShardID < stalledShards[j].ShardID
+	})
+
+	for _, status := range stalledShards {
+		sm.handleStalledShard(status)
+	}
+}
+
+func (sm *shardsMonitor) handleStalledShard(status data.ShardStatus) {
+	log.Error("shardsMonitor: no block received for shard within the stall timeout",
+		"shard", status.ShardID,
+		"last nonce", status.LastNonce,
+		"last block hash", status.LastBlockHash,
+		"stalled for (sec)", status.SinceLastBlockInSec,
+	)
+	sm.metricsHandler.AddRequest(shardStalledMetricID, 0)
+
+	if !sm.publishStalledShards || !sm.leaderElector.IsLeader() {
+		return
+	}
+
+	shardStalled := data.ShardStalled{
+		ID:                    fmt.Sprintf("%d_%s", status.ShardID, status.LastBlockHash),
+		ShardID:               status.ShardID,
+		LastNonce:             status.LastNonce,
+		LastBlockHash:         status.LastBlockHash,
+		LastReceivedTimestamp: status.LastReceivedTimestamp,
+		StalledForInSec:       status.SinceLastBlockInSec,
+	}
+
+	t := time.Now()
+	sm.publisher.BroadcastShardStalled(shardStalled)
+	sm.metricsHandler.AddRequest(getRabbitOpID(common.ShardStalled), time.Since(t))
+}
+
+func getShardMetricID(shardID uint32, label string) string {
+	return fmt.Sprintf("%s-shard-%d-%s", livenessMetricPrefix, shardID, label)
+}
+
+// Close will stop the shards checking loop
+func (sm *shardsMonitor) Close() error {
+	close(sm.closeChan)
+	<-sm.loopClosed
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *shardsMonitor) IsInterfaceNil() bool {
+	return sm == nil
+}
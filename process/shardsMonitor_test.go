@@ -0,0 +1,284 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockShardsMonitorArgs() process.ArgsShardsMonitor {
+	return process.ArgsShardsMonitor{
+		Publisher:            &mocks.PublisherStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		LeaderElector:        &mocks.LeaderElectorStub{},
+		StallTimeout:         time.Minute,
+		CheckInterval:        time.Hour,
+		PublishStalledShards: true,
+	}
+}
+
+func createMonitoredBlock(shardID uint32, nonce uint64, hash string, timestamp uint64) *data.ArgsSaveBlockData {
+	return &data.ArgsSaveBlockData{
+		HeaderHash: []byte(hash),
+		Header: &block.Header{
+			ShardID:   shardID,
+			Nonce:     nonce,
+			TimeStamp: timestamp,
+		},
+	}
+}
+
+func TestNewShardsMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil publisher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.Publisher = nil
+
+		sm, err := process.NewShardsMonitor(args)
+		require.Equal(t, process.ErrNilPublisherService, err)
+		require.Nil(t, sm)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.StatusMetricsHandler = nil
+
+		sm, err := process.NewShardsMonitor(args)
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+		require.Nil(t, sm)
+	})
+
+	t.Run("nil leader elector", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.LeaderElector = nil
+
+		sm, err := process.NewShardsMonitor(args)
+		require.Equal(t, process.ErrNilLeaderElector, err)
+		require.Nil(t, sm)
+	})
+
+	t.Run("invalid stall timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.StallTimeout = 0
+
+		sm, err := process.NewShardsMonitor(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, sm)
+	})
+
+	t.Run("invalid check interval", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.CheckInterval = 0
+
+		sm, err := process.NewShardsMonitor(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.Nil(t, sm)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := process.NewShardsMonitor(createMockShardsMonitorArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sm))
+
+		require.Nil(t, sm.Close())
+	})
+}
+
+func TestShardsMonitor_BlockReceived(t *testing.T) {
+	t.Parallel()
+
+	gauges := make(map[string]uint64)
+	args := createMockShardsMonitorArgs()
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		SetGaugeCalled: func(path string, value uint64) {
+			gauges[path] = value
+		},
+	}
+
+	sm, _ := process.NewShardsMonitor(args)
+	defer func() {
+		_ = sm.Close()
+	}()
+
+	currentTime := time.Unix(1000, 0)
+	sm.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	sm.BlockReceived(createMonitoredBlock(1, 10, "hash10", 997))
+	sm.BlockReceived(createMonitoredBlock(0, 20, "hash20", 999))
+
+	currentTime = currentTime.Add(5 * time.Second)
+
+	expectedStatus := []data.ShardStatus{
+		{
+			ShardID:               0,
+			LastNonce:             20,
+			LastBlockHash:         hex.EncodeToString([]byte("hash20")),
+			LastHeaderTimestamp:   999,
+			LastReceivedTimestamp: 1000,
+			SinceLastBlockInSec:   5,
+			LagInMs:               1000,
+		},
+		{
+			ShardID:               1,
+			LastNonce:             10,
+			LastBlockHash:         hex.EncodeToString([]byte("hash10")),
+			LastHeaderTimestamp:   997,
+			LastReceivedTimestamp: 1000,
+			SinceLastBlockInSec:   5,
+			LagInMs:               3000,
+		},
+	}
+	require.Equal(t, expectedStatus, sm.GetShardsStatus())
+	require.Equal(t, uint64(3000), gauges["Liveness-shard-1-lag-ms"])
+	require.Equal(t, uint64(1000), gauges["Liveness-shard-0-lag-ms"])
+
+	sm.CheckShards()
+	require.Equal(t, uint64(5), gauges["Liveness-shard-1-since-last-block-sec"])
+}
+
+func TestShardsMonitor_CheckShards(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stalled shard should be published once", func(t *testing.T) {
+		t.Parallel()
+
+		stalledEvents := make([]data.ShardStalled, 0)
+		args := createMockShardsMonitorArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastShardStalledCalled: func(event data.ShardStalled) {
+				stalledEvents = append(stalledEvents, event)
+			},
+		}
+
+		sm, _ := process.NewShardsMonitor(args)
+		defer func() {
+			_ = sm.Close()
+		}()
+
+		currentTime := time.Unix(1000, 0)
+		sm.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		sm.BlockReceived(createMonitoredBlock(0, 20, "hash20", 1000))
+		sm.BlockReceived(createMonitoredBlock(1, 10, "hash10", 1000))
+
+		currentTime = currentTime.Add(30 * time.Second)
+		sm.BlockReceived(createMonitoredBlock(0, 21, "hash21", 1030))
+
+		currentTime = currentTime.Add(40 * time.Second)
+		sm.CheckShards()
+		sm.CheckShards()
+
+		expectedEvent := data.ShardStalled{
+			ID:                    "1_" + hex.EncodeToString([]byte("hash10")),
+			ShardID:               1,
+			LastNonce:             10,
+			LastBlockHash:         hex.EncodeToString([]byte("hash10")),
+			LastReceivedTimestamp: 1000,
+			StalledForInSec:       70,
+		}
+		require.Equal(t, []data.ShardStalled{expectedEvent}, stalledEvents)
+
+		status := sm.GetShardsStatus()
+		require.False(t, status[0].Stalled)
+		require.True(t, status[1].Stalled)
+
+		// the shard recovers once a new block is received
+		sm.BlockReceived(createMonitoredBlock(1, 11, "hash11", 1070))
+		status = sm.GetShardsStatus()
+		require.False(t, status[1].Stalled)
+	})
+
+	t.Run("stream not enabled, should not publish", func(t *testing.T) {
+		t.Parallel()
+
+		numStalledMetrics := 0
+		args := createMockShardsMonitorArgs()
+		args.PublishStalledShards = false
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastShardStalledCalled: func(event data.ShardStalled) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, duration time.Duration) {
+				if path == "Liveness-shard-stalled" {
+					numStalledMetrics++
+				}
+			},
+		}
+
+		sm, _ := process.NewShardsMonitor(args)
+		defer func() {
+			_ = sm.Close()
+		}()
+
+		currentTime := time.Unix(1000, 0)
+		sm.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		sm.BlockReceived(createMonitoredBlock(0, 20, "hash20", 1000))
+		currentTime = currentTime.Add(time.Minute)
+		sm.CheckShards()
+
+		require.Equal(t, 1, numStalledMetrics)
+	})
+
+	t.Run("not leader, should not publish", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockShardsMonitorArgs()
+		args.LeaderElector = &mocks.LeaderElectorStub{
+			IsLeaderCalled: func() bool {
+				return false
+			},
+		}
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastShardStalledCalled: func(event data.ShardStalled) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+
+		sm, _ := process.NewShardsMonitor(args)
+		defer func() {
+			_ = sm.Close()
+		}()
+
+		currentTime := time.Unix(1000, 0)
+		sm.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		sm.BlockReceived(createMonitoredBlock(0, 20, "hash20", 1000))
+		currentTime = currentTime.Add(time.Minute)
+		sm.CheckShards()
+
+		require.True(t, sm.GetShardsStatus()[0].Stalled)
+	})
+}
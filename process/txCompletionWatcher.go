@@ -0,0 +1,490 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	txHashLength        = 32
+	returnCodeOk        = "ok"
+	invalidTxReason     = "invalid transaction"
+	returnDataSeparator = "@"
+
+	// the finalized blocks are kept for a while, since a block can be finalized before it
+	// is published, while it is buffered by the blocks orderer
+	maxRecentFinalizedBlocks = 1000
+)
+
+// ArgsTxCompletionWatcher defines the arguments needed for tx completion watcher creation
+type ArgsTxCompletionWatcher struct {
+	MaxWatchedTxs uint32
+	WatchExpiry   time.Duration
+	Registry      WatchedTxsRegistry
+}
+
+type watchedTx struct {
+	registeredAt  time.Time
+	seen          bool
+	reason        string
+	failed        bool
+	pendingItems  map[string]struct{}
+	executedItems map[string]struct{}
+	blockHashes   []string
+	pendingBlocks map[string]struct{}
+}
+
+// txCompletionWatcher keeps the registered transactions until they, and all their smart
+// contract results, are executed in finalized blocks. The transactions are also added to the
+// registry, from which the transactions registered on other instances are loaded, before
+// processing the blocks
+type txCompletionWatcher struct {
+	maxWatchedTxs int
+	watchExpiry   time.Duration
+	registry      WatchedTxsRegistry
+
+	mut                  sync.Mutex
+	watchedTxs           map[string]*watchedTx
+	scrsOrigin           map[string]string
+	blocksWatched        map[string]map[string]struct{}
+	finalizedBlocks      map[string]struct{}
+	finalizedBlocksOrder []string
+}
+
+// NewTxCompletionWatcher creates a new tx completion watcher instance
+func NewTxCompletionWatcher(args ArgsTxCompletionWatcher) (*txCompletionWatcher, error) {
+	if args.MaxWatchedTxs == 0 {
+		return nil, fmt.Errorf("%w for MaxWatchedTxs", ErrInvalidValue)
+	}
+	if args.WatchExpiry <= 0 {
+		return nil, fmt.Errorf("%w for WatchExpiry", ErrInvalidValue)
+	}
+	if check.IfNil(args.Registry) {
+		return nil, ErrNilWatchedTxsRegistry
+	}
+
+	return &txCompletionWatcher{
+		maxWatchedTxs:   int(args.MaxWatchedTxs),
+		watchExpiry:     args.WatchExpiry,
+		registry:        args.Registry,
+		watchedTxs:      make(map[string]*watchedTx),
+		scrsOrigin:      make(map[string]string),
+		blocksWatched:   make(map[string]map[string]struct{}),
+		finalizedBlocks: make(map[string]struct{}),
+	}, nil
+}
+
+// WatchTxs registers the provided hex encoded transaction hashes
+func (tw *txCompletionWatcher) WatchTxs(txHashes []string) error {
+	for _, txHash := range txHashes {
+		decoded, err := hex.DecodeString(txHash)
+		if err != nil || len(decoded) != txHashLength {
+			return fmt.Errorf("%w: %s", ErrInvalidTxHash, txHash)
+		}
+	}
+
+	tw.mut.Lock()
+	defer tw.mut.Unlock()
+
+	tw.removeExpired()
+
+	newTxHashes := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txHash = strings.ToLower(txHash)
+		if _, ok := tw.watchedTxs[txHash]; !ok && !containsString(newTxHashes, txHash) {
+			newTxHashes = append(newTxHashes, txHash)
+		}
+	}
+	if len(tw.watchedTxs)+len(newTxHashes) > tw.maxWatchedTxs {
+		return ErrTooManyWatchedTxs
+	}
+	if len(newTxHashes) == 0 {
+		return nil
+	}
+
+	registeredAt := time.Now()
+	err := tw.registry.AddTxs(newTxHashes, registeredAt.Add(tw.watchExpiry))
+	if err != nil {
+		return err
+	}
+
+	for _, txHash := range newTxHashes {
+		tw.addWatchedTx(txHash, registeredAt)
+	}
+
+	log.Debug("watching transactions", "num txs", len(txHashes), "total watched", len(tw.watchedTxs))
+
+	return nil
+}
+
+// ProcessBlock updates the watched transactions based on the transactions, smart contract
+// results and log events of the provided block. It returns the watched transactions which
+// have been completed, if the block has already been finalized
+func (tw *txCompletionWatcher) ProcessBlock(blockData *data.InterceptorBlockData) []data.TxCompleted {
+	if blockData == nil || check.IfNil(blockData.Header) {
+		return nil
+	}
+
+	tw.mut.Lock()
+	defer tw.mut.Unlock()
+
+	tw.loadRegisteredTxs()
+	tw.removeExpired()
+	if len(tw.watchedTxs) == 0 {
+		return nil
+	}
+
+	txHashes := tw.updateWatchedTxs(blockData)
+	if _, isFinalized := tw.finalizedBlocks[blockData.Hash]; !isFinalized {
+		return nil
+	}
+
+	completedTxs := make([]data.TxCompleted, 0)
+	for _, txHash := range txHashes {
+		entry, ok := tw.watchedTxs[txHash]
+		if !ok || !entry.isCompleted() {
+			continue
+		}
+
+		completedTxs = append(completedTxs, entry.toTxCompleted(txHash))
+		tw.removeWatchedTx(txHash)
+	}
+	tw.unregisterCompletedTxs(completedTxs)
+
+	return completedTxs
+}
+
+func (tw *txCompletionWatcher) addWatchedTx(txHash string, registeredAt time.Time) {
+	tw.watchedTxs[txHash] = &watchedTx{
+		registeredAt:  registeredAt,
+		pendingItems:  make(map[string]struct{}),
+		executedItems: make(map[string]struct{}),
+		blockHashes:   make([]string, 0),
+		pendingBlocks: make(map[string]struct{}),
+	}
+}
+
+// loadRegisteredTxs starts watching the transactions registered on the other instances
+func (tw *txCompletionWatcher) loadRegisteredTxs() {
+	registeredTxs, err := tw.registry.GetTxs()
+	if err != nil {
+		log.Warn("could not load the registered transactions", "error", err)
+		return
+	}
+
+	numLoadedTxs := 0
+	for txHash, expiresAt := range registeredTxs {
+		if _, ok := tw.watchedTxs[txHash]; ok {
+			continue
+		}
+		if len(tw.watchedTxs) >= tw.maxWatchedTxs {
+			log.Warn("could not load all the registered transactions", "error", ErrTooManyWatchedTxs)
+			break
+		}
+
+		tw.addWatchedTx(txHash, expiresAt.Add(-tw.watchExpiry))
+		numLoadedTxs++
+	}
+
+	if numLoadedTxs > 0 {
+		log.Debug("loaded registered transactions", "num txs", numLoadedTxs, "total watched", len(tw.watchedTxs))
+	}
+}
+
+// unregisterCompletedTxs removes the completed transactions from the registry, so that they
+// are not loaded again
+func (tw *txCompletionWatcher) unregisterCompletedTxs(completedTxs []data.TxCompleted) {
+	if len(completedTxs) == 0 {
+		return
+	}
+
+	txHashes := make([]string, 0, len(completedTxs))
+	for _, txCompleted := range completedTxs {
+		txHashes = append(txHashes, txCompleted.TxHash)
+	}
+
+	err := tw.registry.RemoveTxs(txHashes)
+	if err != nil {
+		log.Warn("could not remove the completed transactions from the registry", "error", err)
+	}
+}
+
+// updateWatchedTxs returns the hashes of the watched transactions included in the provided block
+func (tw *txCompletionWatcher) updateWatchedTxs(blockData *data.InterceptorBlockData) []string {
+	txHashes := make([]string, 0)
+	includedTxs := make(map[string]struct{})
+	addIncludedTx := func(txHash string) {
+		if _, ok := includedTxs[txHash]; ok {
+			return
+		}
+		includedTxs[txHash] = struct{}{}
+		txHashes = append(txHashes, txHash)
+	}
+
+	shardID := blockData.Header.GetShardID()
+	isExecutedInBlock := func(receiver []byte) bool {
+		receiverShardID, ok := common.ComputeShardID(receiver, blockData.NumberOfShards)
+		return !ok || receiverShardID == shardID
+	}
+
+	for txHash, tx := range blockData.Txs {
+		entry, ok := tw.watchedTxs[txHash]
+		if !ok || tx == nil {
+			continue
+		}
+
+		entry.seen = true
+		tw.markItem(entry, txHash, isExecutedInBlock(tx.RcvAddr))
+		tw.addBlock(entry, txHash, blockData.Hash)
+		addIncludedTx(txHash)
+	}
+
+	for txHash := range blockData.InvalidTxs {
+		entry, ok := tw.watchedTxs[txHash]
+		if !ok {
+			continue
+		}
+
+		entry.seen = true
+		tw.markItem(entry, txHash, true)
+		tw.markFailed(entry, invalidTxReason)
+		tw.addBlock(entry, txHash, blockData.Hash)
+		addIncludedTx(txHash)
+	}
+
+	for scrHash, scr := range blockData.Scrs {
+		if scr == nil {
+			continue
+		}
+
+		txHash := hex.EncodeToString(scr.OriginalTxHash)
+		entry, ok := tw.watchedTxs[txHash]
+		if !ok {
+			continue
+		}
+
+		tw.scrsOrigin[scrHash] = txHash
+		tw.markItem(entry, scrHash, isExecutedInBlock(scr.RcvAddr))
+		tw.addBlock(entry, txHash, blockData.Hash)
+		addIncludedTx(txHash)
+
+		returnCode, isReturnData := getReturnCode(scr.Data)
+		if isReturnData && returnCode != returnCodeOk {
+			reason := string(scr.ReturnMessage)
+			if reason == "" {
+				reason = returnCode
+			}
+			tw.markFailed(entry, reason)
+		}
+	}
+
+	for _, event := range blockData.LogEvents {
+		txHash, entry, ok := tw.getEntryForLogEvent(event)
+		if !ok {
+			continue
+		}
+
+		if isFailedExecutionEvent(event) {
+			tw.markFailed(entry, getExecutionErrorMessage(event))
+		}
+		tw.addBlock(entry, txHash, blockData.Hash)
+		addIncludedTx(txHash)
+	}
+
+	return txHashes
+}
+
+// ProcessFinalizedBlock marks the provided block as finalized, and returns the watched
+// transactions which have been completed
+func (tw *txCompletionWatcher) ProcessFinalizedBlock(blockHash string) []data.TxCompleted {
+	tw.mut.Lock()
+	defer tw.mut.Unlock()
+
+	tw.loadRegisteredTxs()
+	tw.addFinalizedBlock(blockHash)
+
+	txHashes, ok := tw.blocksWatched[blockHash]
+	if !ok {
+		return nil
+	}
+	delete(tw.blocksWatched, blockHash)
+
+	completedTxs := make([]data.TxCompleted, 0)
+	for txHash := range txHashes {
+		entry, ok := tw.watchedTxs[txHash]
+		if !ok {
+			continue
+		}
+
+		delete(entry.pendingBlocks, blockHash)
+		if !entry.isCompleted() {
+			continue
+		}
+
+		completedTxs = append(completedTxs, entry.toTxCompleted(txHash))
+		tw.removeWatchedTx(txHash)
+	}
+	tw.unregisterCompletedTxs(completedTxs)
+
+	return completedTxs
+}
+
+func (tw *txCompletionWatcher) addFinalizedBlock(blockHash string) {
+	if _, ok := tw.finalizedBlocks[blockHash]; ok {
+		return
+	}
+
+	tw.finalizedBlocks[blockHash] = struct{}{}
+	tw.finalizedBlocksOrder = append(tw.finalizedBlocksOrder, blockHash)
+	if len(tw.finalizedBlocksOrder) <= maxRecentFinalizedBlocks {
+		return
+	}
+
+	delete(tw.finalizedBlocks, tw.finalizedBlocksOrder[0])
+	tw.finalizedBlocksOrder = tw.finalizedBlocksOrder[1:]
+}
+
+func (tw *txCompletionWatcher) getEntryForLogEvent(event data.Event) (string, *watchedTx, bool) {
+	txHash := event.TxHash
+	originTxHash, isScr := tw.scrsOrigin[txHash]
+	if isScr {
+		txHash = originTxHash
+	}
+
+	entry, ok := tw.watchedTxs[txHash]
+
+	return txHash, entry, ok
+}
+
+// markItem tracks the transactions and smart contract results until they are seen in a block
+// of the receiver shard. The items can be received from the receiver shard first, since the
+// blocks of different shards are not received in a particular order.
+func (tw *txCompletionWatcher) markItem(entry *watchedTx, itemHash string, executed bool) {
+	if executed {
+		entry.executedItems[itemHash] = struct{}{}
+		delete(entry.pendingItems, itemHash)
+		return
+	}
+
+	if _, ok := entry.executedItems[itemHash]; !ok {
+		entry.pendingItems[itemHash] = struct{}{}
+	}
+}
+
+func (tw *txCompletionWatcher) markFailed(entry *watchedTx, reason string) {
+	if entry.failed {
+		return
+	}
+
+	entry.failed = true
+	entry.reason = reason
+}
+
+// addBlock tracks the blocks of a watched transaction until they are finalized. The blocks
+// finalized before being processed are only recorded
+func (tw *txCompletionWatcher) addBlock(entry *watchedTx, txHash string, blockHash string) {
+	if _, ok := tw.finalizedBlocks[blockHash]; ok {
+		if !containsString(entry.blockHashes, blockHash) {
+			entry.blockHashes = append(entry.blockHashes, blockHash)
+		}
+		return
+	}
+	if _, ok := entry.pendingBlocks[blockHash]; ok {
+		return
+	}
+
+	entry.pendingBlocks[blockHash] = struct{}{}
+	entry.blockHashes = append(entry.blockHashes, blockHash)
+
+	watchedTxs, ok := tw.blocksWatched[blockHash]
+	if !ok {
+		watchedTxs = make(map[string]struct{})
+		tw.blocksWatched[blockHash] = watchedTxs
+	}
+	watchedTxs[txHash] = struct{}{}
+}
+
+func (tw *txCompletionWatcher) removeExpired() {
+	for txHash, entry := range tw.watchedTxs {
+		if time.Since(entry.registeredAt) > tw.watchExpiry {
+			log.Debug("watched transaction expired", "tx hash", txHash)
+			tw.removeWatchedTx(txHash)
+		}
+	}
+}
+
+func (tw *txCompletionWatcher) removeWatchedTx(txHash string) {
+	entry, ok := tw.watchedTxs[txHash]
+	if !ok {
+		return
+	}
+	delete(tw.watchedTxs, txHash)
+
+	for blockHash := range entry.pendingBlocks {
+		delete(tw.blocksWatched[blockHash], txHash)
+		if len(tw.blocksWatched[blockHash]) == 0 {
+			delete(tw.blocksWatched, blockHash)
+		}
+	}
+	for scrHash, originTxHash := range tw.scrsOrigin {
+		if originTxHash == txHash {
+			delete(tw.scrsOrigin, scrHash)
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (wt *watchedTx) isCompleted() bool {
+	return wt.seen && len(wt.pendingItems) == 0 && len(wt.pendingBlocks) == 0
+}
+
+func (wt *watchedTx) toTxCompleted(txHash string) data.TxCompleted {
+	status := common.TxStatusSuccess
+	if wt.failed {
+		status = common.TxStatusFail
+	}
+
+	return data.TxCompleted{
+		TxHash:      txHash,
+		Status:      status,
+		Reason:      wt.reason,
+		BlockHashes: wt.blockHashes,
+	}
+}
+
+// getReturnCode returns the return code of the smart contract results which hold the
+// execution result, with the data field formatted as @<hex return code>[@<hex results>]
+func getReturnCode(scrData []byte) (string, bool) {
+	if !strings.HasPrefix(string(scrData), returnDataSeparator) {
+		return "", false
+	}
+
+	tokens := strings.Split(string(scrData), returnDataSeparator)
+	returnCode, err := hex.DecodeString(tokens[1])
+	if err != nil {
+		return "", false
+	}
+
+	return string(returnCode), true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tw *txCompletionWatcher) IsInterfaceNil() bool {
+	return tw == nil
+}
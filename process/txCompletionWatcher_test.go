@@ -0,0 +1,506 @@
+package process_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+const numberOfShards = 3
+
+var (
+	watchedTxHash = strings.Repeat("ab", 32)
+	scrHash       = strings.Repeat("cd", 32)
+)
+
+func createMockTxCompletionWatcherArgs() process.ArgsTxCompletionWatcher {
+	return process.ArgsTxCompletionWatcher{
+		MaxWatchedTxs: 10,
+		WatchExpiry:   time.Minute,
+		Registry:      &mocks.WatchedTxsRegistryStub{},
+	}
+}
+
+// addressInShard returns an address which is not a system smart contract, assigned to the provided shard
+func addressInShard(shardID byte) []byte {
+	return append(bytes.Repeat([]byte{1}, 31), shardID)
+}
+
+func createBlockData(hash string, shardID uint32) *data.InterceptorBlockData {
+	return &data.InterceptorBlockData{
+		Hash:           hash,
+		Header:         &block.Header{ShardID: shardID},
+		Txs:            make(map[string]*transaction.Transaction),
+		Scrs:           make(map[string]*smartContractResult.SmartContractResult),
+		InvalidTxs:     make(map[string]*outport.TxInfo),
+		NumberOfShards: numberOfShards,
+	}
+}
+
+func createWatcherWithTx(t *testing.T) common.TxCompletionWatcher {
+	watcher, err := process.NewTxCompletionWatcher(createMockTxCompletionWatcherArgs())
+	require.Nil(t, err)
+
+	err = watcher.WatchTxs([]string{watchedTxHash})
+	require.Nil(t, err)
+
+	return watcher
+}
+
+func TestNewTxCompletionWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max watched txs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.MaxWatchedTxs = 0
+
+		watcher, err := process.NewTxCompletionWatcher(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.True(t, check.IfNil(watcher))
+	})
+
+	t.Run("invalid watch expiry", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.WatchExpiry = 0
+
+		watcher, err := process.NewTxCompletionWatcher(args)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+		require.True(t, check.IfNil(watcher))
+	})
+
+	t.Run("nil registry", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.Registry = nil
+
+		watcher, err := process.NewTxCompletionWatcher(args)
+		require.Equal(t, process.ErrNilWatchedTxsRegistry, err)
+		require.True(t, check.IfNil(watcher))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := process.NewTxCompletionWatcher(createMockTxCompletionWatcherArgs())
+		require.Nil(t, err)
+		require.False(t, watcher.IsInterfaceNil())
+	})
+}
+
+func TestTxCompletionWatcher_WatchTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid tx hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, _ := process.NewTxCompletionWatcher(createMockTxCompletionWatcherArgs())
+
+		err := watcher.WatchTxs([]string{"not hex"})
+		require.True(t, errors.Is(err, process.ErrInvalidTxHash))
+
+		err = watcher.WatchTxs([]string{"abcd"})
+		require.True(t, errors.Is(err, process.ErrInvalidTxHash))
+	})
+
+	t.Run("too many watched txs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.MaxWatchedTxs = 1
+		watcher, _ := process.NewTxCompletionWatcher(args)
+
+		err := watcher.WatchTxs([]string{watchedTxHash})
+		require.Nil(t, err)
+
+		// already watched transactions are not counted again
+		err = watcher.WatchTxs([]string{strings.ToUpper(watchedTxHash)})
+		require.Nil(t, err)
+
+		err = watcher.WatchTxs([]string{scrHash})
+		require.Equal(t, process.ErrTooManyWatchedTxs, err)
+	})
+
+	t.Run("expired txs should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.MaxWatchedTxs = 1
+		args.WatchExpiry = time.Millisecond
+		watcher, _ := process.NewTxCompletionWatcher(args)
+
+		err := watcher.WatchTxs([]string{watchedTxHash})
+		require.Nil(t, err)
+
+		time.Sleep(time.Millisecond * 10)
+
+		err = watcher.WatchTxs([]string{scrHash})
+		require.Nil(t, err)
+	})
+
+	t.Run("new txs should be added to the registry", func(t *testing.T) {
+		t.Parallel()
+
+		registeredTxs := make([]string, 0)
+		var expiresAt time.Time
+		args := createMockTxCompletionWatcherArgs()
+		args.Registry = &mocks.WatchedTxsRegistryStub{
+			AddTxsCalled: func(txHashes []string, expiry time.Time) error {
+				registeredTxs = append(registeredTxs, txHashes...)
+				expiresAt = expiry
+				return nil
+			},
+		}
+		watcher, _ := process.NewTxCompletionWatcher(args)
+
+		err := watcher.WatchTxs([]string{strings.ToUpper(watchedTxHash), watchedTxHash})
+		require.Nil(t, err)
+		require.Equal(t, []string{watchedTxHash}, registeredTxs)
+		require.WithinDuration(t, time.Now().Add(args.WatchExpiry), expiresAt, time.Second)
+
+		err = watcher.WatchTxs([]string{watchedTxHash})
+		require.Nil(t, err)
+		require.Equal(t, []string{watchedTxHash}, registeredTxs)
+	})
+
+	t.Run("registry error should not watch the txs", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockTxCompletionWatcherArgs()
+		args.Registry = &mocks.WatchedTxsRegistryStub{
+			AddTxsCalled: func(txHashes []string, expiresAt time.Time) error {
+				return expectedErr
+			},
+		}
+		watcher, _ := process.NewTxCompletionWatcher(args)
+
+		err := watcher.WatchTxs([]string{watchedTxHash})
+		require.Equal(t, expectedErr, err)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		watcher.ProcessBlock(blockData)
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+	})
+}
+
+func TestTxCompletionWatcher_ProcessFinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("intra shard tx should complete with success", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		watcher.ProcessBlock(blockData)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		expectedTxs := []data.TxCompleted{
+			{
+				TxHash:      watchedTxHash,
+				Status:      common.TxStatusSuccess,
+				BlockHashes: []string{"hash1"},
+			},
+		}
+		require.Equal(t, expectedTxs, completedTxs)
+
+		// the completed tx is not watched anymore
+		watcher.ProcessBlock(blockData)
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+	})
+
+	t.Run("cross shard tx should complete after executed in destination shard", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		tx := &transaction.Transaction{RcvAddr: addressInShard(1)}
+		sourceBlock := createBlockData("hash1", 0)
+		sourceBlock.Txs[watchedTxHash] = tx
+		watcher.ProcessBlock(sourceBlock)
+
+		require.Empty(t, watcher.ProcessFinalizedBlock("hash1"))
+
+		destinationBlock := createBlockData("hash2", 1)
+		destinationBlock.Txs[watchedTxHash] = tx
+		watcher.ProcessBlock(destinationBlock)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash2")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusSuccess, completedTxs[0].Status)
+		require.Equal(t, []string{"hash1", "hash2"}, completedTxs[0].BlockHashes)
+	})
+
+	t.Run("cross shard tx received first from destination shard", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		tx := &transaction.Transaction{RcvAddr: addressInShard(1)}
+		destinationBlock := createBlockData("hash2", 1)
+		destinationBlock.Txs[watchedTxHash] = tx
+		watcher.ProcessBlock(destinationBlock)
+
+		sourceBlock := createBlockData("hash1", 0)
+		sourceBlock.Txs[watchedTxHash] = tx
+		watcher.ProcessBlock(sourceBlock)
+
+		require.Empty(t, watcher.ProcessFinalizedBlock("hash2"))
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusSuccess, completedTxs[0].Status)
+	})
+
+	t.Run("tx should wait for cross shard smart contract results", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		txHashBytes, _ := hex.DecodeString(watchedTxHash)
+		scr := &smartContractResult.SmartContractResult{
+			RcvAddr:        addressInShard(1),
+			OriginalTxHash: txHashBytes,
+			Data:           []byte("@" + hex.EncodeToString([]byte("ok"))),
+		}
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		blockData.Scrs[scrHash] = scr
+		watcher.ProcessBlock(blockData)
+
+		require.Empty(t, watcher.ProcessFinalizedBlock("hash1"))
+
+		destinationBlock := createBlockData("hash2", 1)
+		destinationBlock.Scrs[scrHash] = scr
+		watcher.ProcessBlock(destinationBlock)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash2")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusSuccess, completedTxs[0].Status)
+	})
+
+	t.Run("smart contract result with error return code should fail", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		txHashBytes, _ := hex.DecodeString(watchedTxHash)
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		blockData.Scrs[scrHash] = &smartContractResult.SmartContractResult{
+			RcvAddr:        addressInShard(0),
+			OriginalTxHash: txHashBytes,
+			Data:           []byte("@" + hex.EncodeToString([]byte("user error"))),
+			ReturnMessage:  []byte("insufficient funds"),
+		}
+		watcher.ProcessBlock(blockData)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusFail, completedTxs[0].Status)
+		require.Equal(t, "insufficient funds", completedTxs[0].Reason)
+	})
+
+	t.Run("signal error event should fail", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		blockData.LogEvents = []data.Event{
+			{
+				Identifier: core.SignalErrorOperation,
+				Topics:     [][]byte{addressInShard(0), []byte("execution failed")},
+				TxHash:     watchedTxHash,
+			},
+		}
+		watcher.ProcessBlock(blockData)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusFail, completedTxs[0].Status)
+		require.Equal(t, "execution failed", completedTxs[0].Reason)
+	})
+
+	t.Run("internal vm errors event should fail", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		blockData.LogEvents = []data.Event{
+			{
+				Identifier: core.InternalVMErrorsOperation,
+				Data:       []byte("out of gas"),
+				TxHash:     watchedTxHash,
+			},
+		}
+		watcher.ProcessBlock(blockData)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusFail, completedTxs[0].Status)
+		require.Equal(t, "out of gas", completedTxs[0].Reason)
+	})
+
+	t.Run("invalid tx should fail", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.InvalidTxs[watchedTxHash] = &outport.TxInfo{}
+		watcher.ProcessBlock(blockData)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, common.TxStatusFail, completedTxs[0].Status)
+	})
+
+	t.Run("block finalized before being processed should complete the tx", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		completedTxs := watcher.ProcessBlock(blockData)
+		expectedTxs := []data.TxCompleted{
+			{
+				TxHash:      watchedTxHash,
+				Status:      common.TxStatusSuccess,
+				BlockHashes: []string{"hash1"},
+			},
+		}
+		require.Equal(t, expectedTxs, completedTxs)
+	})
+
+	t.Run("cross shard tx with source block finalized before being processed", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+
+		sourceBlock := createBlockData("hash1", 0)
+		sourceBlock.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(1)}
+		require.Empty(t, watcher.ProcessBlock(sourceBlock))
+
+		destinationBlock := createBlockData("hash2", 1)
+		destinationBlock.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(1)}
+		require.Empty(t, watcher.ProcessBlock(destinationBlock))
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash2")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, []string{"hash1", "hash2"}, completedTxs[0].BlockHashes)
+	})
+
+	t.Run("not watched block should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createWatcherWithTx(t)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[scrHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		watcher.ProcessBlock(blockData)
+
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+	})
+}
+
+func TestTxCompletionWatcher_RegisteredTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("txs registered on other instances should be watched and removed once completed", func(t *testing.T) {
+		t.Parallel()
+
+		removedTxs := make([]string, 0)
+		args := createMockTxCompletionWatcherArgs()
+		args.Registry = &mocks.WatchedTxsRegistryStub{
+			GetTxsCalled: func() (map[string]time.Time, error) {
+				return map[string]time.Time{watchedTxHash: time.Now().Add(time.Minute)}, nil
+			},
+			RemoveTxsCalled: func(txHashes []string) error {
+				removedTxs = append(removedTxs, txHashes...)
+				return nil
+			},
+		}
+		watcher, _ := process.NewTxCompletionWatcher(args)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		require.Empty(t, watcher.ProcessBlock(blockData))
+		require.Empty(t, removedTxs)
+
+		completedTxs := watcher.ProcessFinalizedBlock("hash1")
+		require.Len(t, completedTxs, 1)
+		require.Equal(t, watchedTxHash, completedTxs[0].TxHash)
+		require.Equal(t, []string{watchedTxHash}, removedTxs)
+	})
+
+	t.Run("registry error should keep watching the local txs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.Registry = &mocks.WatchedTxsRegistryStub{
+			GetTxsCalled: func() (map[string]time.Time, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		watcher, _ := process.NewTxCompletionWatcher(args)
+		err := watcher.WatchTxs([]string{watchedTxHash})
+		require.Nil(t, err)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[watchedTxHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		watcher.ProcessBlock(blockData)
+		require.Len(t, watcher.ProcessFinalizedBlock("hash1"), 1)
+	})
+
+	t.Run("registered txs should not exceed the max watched txs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxCompletionWatcherArgs()
+		args.MaxWatchedTxs = 1
+		args.Registry = &mocks.WatchedTxsRegistryStub{
+			GetTxsCalled: func() (map[string]time.Time, error) {
+				return map[string]time.Time{scrHash: time.Now().Add(time.Minute)}, nil
+			},
+		}
+		watcher, _ := process.NewTxCompletionWatcher(args)
+		err := watcher.WatchTxs([]string{watchedTxHash})
+		require.Nil(t, err)
+
+		blockData := createBlockData("hash1", 0)
+		blockData.Txs[scrHash] = &transaction.Transaction{RcvAddr: addressInShard(0)}
+		watcher.ProcessBlock(blockData)
+		require.Nil(t, watcher.ProcessFinalizedBlock("hash1"))
+	})
+}
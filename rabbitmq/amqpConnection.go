@@ -0,0 +1,28 @@
+package rabbitmq
+
+import "github.com/streadway/amqp"
+
+// amqpConnectionWrapper adapts an amqp connection to the amqpConnection interface
+type amqpConnectionWrapper struct {
+	*amqp.Connection
+}
+
+// dialAMQP will return a rabbitMq connection
+func dialAMQP(url string) (amqpConnection, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &amqpConnectionWrapper{Connection: conn}, nil
+}
+
+// Channel opens a new channel on the connection
+func (acw *amqpConnectionWrapper) Channel() (amqpChannel, error) {
+	ch, err := acw.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	return ch, nil
+}
@@ -0,0 +1,293 @@
+package rabbitmq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/streadway/amqp"
+)
+
+const (
+	outboxDataFileName   = "outbox.data"
+	outboxOffsetFileName = "outbox.offset"
+	outboxFilesPerm      = 0644
+	outboxDirPerm        = 0755
+	recordLengthSize     = 4
+	bytesInMegabyte      = 1024 * 1024
+
+	outboxMessagesMetricID = "RabbitMQ-outbox-messages"
+	outboxSizeMetricID     = "RabbitMQ-outbox-size"
+)
+
+// OutboxMessage defines a message persisted in the outbox, waiting to be published
+type OutboxMessage struct {
+	Exchange  string
+	Key       string
+	Mandatory bool
+	Immediate bool
+	Msg       amqp.Publishing
+}
+
+// ArgsDiskOutbox defines the arguments needed for disk outbox creation
+type ArgsDiskOutbox struct {
+	Path                 string
+	MaxSizeInMB          uint32
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+// diskOutbox is an append-only file based queue. The read position is persisted
+// separately, and the files are truncated once all the messages have been drained.
+type diskOutbox struct {
+	mut            sync.Mutex
+	dataFile       *os.File
+	offsetFilePath string
+	maxSize        int64
+	readOffset     int64
+	writeOffset    int64
+	numMessages    int
+	metricsHandler common.StatusMetricsHandler
+}
+
+// NewDiskOutbox creates a new disk outbox instance. Messages persisted by a previous run
+// are loaded, so they will be drained first.
+func NewDiskOutbox(args ArgsDiskOutbox) (*diskOutbox, error) {
+	err := checkDiskOutboxArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.Path, outboxDirPerm)
+	if err != nil {
+		return nil, err
+	}
+
+	dataFile, err := os.OpenFile(filepath.Join(args.Path, outboxDataFileName), os.O_RDWR|os.O_CREATE, outboxFilesPerm)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &diskOutbox{
+		dataFile:       dataFile,
+		offsetFilePath: filepath.Join(args.Path, outboxOffsetFileName),
+		maxSize:        int64(args.MaxSizeInMB) * bytesInMegabyte,
+		metricsHandler: args.StatusMetricsHandler,
+	}
+
+	err = o.loadState()
+	if err != nil {
+		_ = dataFile.Close()
+		return nil, err
+	}
+
+	if o.numMessages > 0 {
+		log.Info("loaded rabbitMQ outbox backlog", "num messages", o.numMessages)
+	}
+	o.updateMetrics()
+
+	return o, nil
+}
+
+func checkDiskOutboxArgs(args ArgsDiskOutbox) error {
+	if len(args.Path) == 0 {
+		return ErrInvalidOutboxPath
+	}
+	if args.MaxSizeInMB == 0 {
+		return fmt.Errorf("%w for outbox max size in MB", ErrZeroValueReceived)
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+
+	return nil
+}
+
+func (o *diskOutbox) loadState() error {
+	offsetBytes, err := os.ReadFile(o.offsetFilePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(offsetBytes) > 0 {
+		o.readOffset, err = strconv.ParseInt(strings.TrimSpace(string(offsetBytes)), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	info, err := o.dataFile.Stat()
+	if err != nil {
+		return err
+	}
+	if o.readOffset > info.Size() {
+		o.readOffset = info.Size()
+	}
+
+	// count the remaining messages and drop a partially written record, if any
+	offset := o.readOffset
+	for {
+		_, recordSize, errRead := o.readRecordAt(offset)
+		if errRead != nil {
+			break
+		}
+		offset += recordSize
+		o.numMessages++
+	}
+	o.writeOffset = offset
+
+	if offset < info.Size() {
+		log.Warn("truncating incomplete rabbitMQ outbox record", "offset", offset)
+		return o.dataFile.Truncate(offset)
+	}
+
+	return nil
+}
+
+// Append will persist the message at the end of the outbox
+func (o *diskOutbox) Append(message OutboxMessage) error {
+	buff := bytes.Buffer{}
+	err := gob.NewEncoder(&buff).Encode(&message)
+	if err != nil {
+		return err
+	}
+
+	record := make([]byte, recordLengthSize+buff.Len())
+	binary.BigEndian.PutUint32(record, uint32(buff.Len()))
+	copy(record[recordLengthSize:], buff.Bytes())
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if o.writeOffset-o.readOffset+int64(len(record)) > o.maxSize {
+		return ErrOutboxFull
+	}
+
+	_, err = o.dataFile.WriteAt(record, o.writeOffset)
+	if err != nil {
+		return err
+	}
+	err = o.dataFile.Sync()
+	if err != nil {
+		return err
+	}
+
+	o.writeOffset += int64(len(record))
+	o.numMessages++
+	o.updateMetrics()
+
+	return nil
+}
+
+// Peek returns the oldest message from the outbox, without removing it
+func (o *diskOutbox) Peek() (*OutboxMessage, error) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if o.numMessages == 0 {
+		return nil, ErrEmptyOutbox
+	}
+
+	message, _, err := o.readRecordAt(o.readOffset)
+	return message, err
+}
+
+// Remove will remove the oldest message from the outbox
+func (o *diskOutbox) Remove() error {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if o.numMessages == 0 {
+		return ErrEmptyOutbox
+	}
+
+	_, recordSize, err := o.readRecordAt(o.readOffset)
+	if err != nil {
+		return err
+	}
+
+	o.readOffset += recordSize
+	o.numMessages--
+	defer o.updateMetrics()
+
+	if o.numMessages == 0 {
+		return o.reset()
+	}
+
+	return o.saveReadOffset()
+}
+
+func (o *diskOutbox) reset() error {
+	err := o.dataFile.Truncate(0)
+	if err != nil {
+		return err
+	}
+
+	o.readOffset = 0
+	o.writeOffset = 0
+
+	return o.saveReadOffset()
+}
+
+func (o *diskOutbox) saveReadOffset() error {
+	return os.WriteFile(o.offsetFilePath, []byte(strconv.FormatInt(o.readOffset, 10)), outboxFilesPerm)
+}
+
+func (o *diskOutbox) readRecordAt(offset int64) (*OutboxMessage, int64, error) {
+	lengthBytes := make([]byte, recordLengthSize)
+	_, err := o.dataFile.ReadAt(lengthBytes, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	recordBytes := make([]byte, binary.BigEndian.Uint32(lengthBytes))
+	_, err = o.dataFile.ReadAt(recordBytes, offset+recordLengthSize)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+
+	message := &OutboxMessage{}
+	err = gob.NewDecoder(bytes.NewReader(recordBytes)).Decode(message)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return message, int64(recordLengthSize + len(recordBytes)), nil
+}
+
+// Len returns the number of messages waiting in the outbox
+func (o *diskOutbox) Len() int {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	return o.numMessages
+}
+
+func (o *diskOutbox) updateMetrics() {
+	o.metricsHandler.SetGauge(outboxMessagesMetricID, uint64(o.numMessages))
+	o.metricsHandler.SetGauge(outboxSizeMetricID, uint64(o.writeOffset-o.readOffset))
+}
+
+// Close will close the outbox file
+func (o *diskOutbox) Close() error {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	return o.dataFile.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (o *diskOutbox) IsInterfaceNil() bool {
+	return o == nil
+}
@@ -0,0 +1,176 @@
+package rabbitmq_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsDiskOutbox(t *testing.T) rabbitmq.ArgsDiskOutbox {
+	return rabbitmq.ArgsDiskOutbox{
+		Path:                 t.TempDir(),
+		MaxSizeInMB:          1,
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+func createOutboxMessage(id string) rabbitmq.OutboxMessage {
+	return rabbitmq.OutboxMessage{
+		Exchange:  "exchange",
+		Mandatory: true,
+		Msg: amqp.Publishing{
+			Headers:   amqp.Table{"eventType": "type", "nonce": int64(1)},
+			MessageId: id,
+			Body:      []byte("payload " + id),
+		},
+	}
+}
+
+func TestNewDiskOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskOutbox(t)
+		args.Path = ""
+
+		outbox, err := rabbitmq.NewDiskOutbox(args)
+		require.True(t, check.IfNil(outbox))
+		require.Equal(t, rabbitmq.ErrInvalidOutboxPath, err)
+	})
+
+	t.Run("zero max size", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskOutbox(t)
+		args.MaxSizeInMB = 0
+
+		outbox, err := rabbitmq.NewDiskOutbox(args)
+		require.True(t, check.IfNil(outbox))
+		require.True(t, errors.Is(err, rabbitmq.ErrZeroValueReceived))
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskOutbox(t)
+		args.StatusMetricsHandler = nil
+
+		outbox, err := rabbitmq.NewDiskOutbox(args)
+		require.True(t, check.IfNil(outbox))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, err := rabbitmq.NewDiskOutbox(createMockArgsDiskOutbox(t))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(outbox))
+		require.Equal(t, 0, outbox.Len())
+		require.Nil(t, outbox.Close())
+	})
+}
+
+func TestDiskOutbox_AppendPeekRemove(t *testing.T) {
+	t.Parallel()
+
+	gauges := make(map[string]uint64)
+	args := createMockArgsDiskOutbox(t)
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		SetGaugeCalled: func(path string, value uint64) {
+			gauges[path] = value
+		},
+	}
+
+	outbox, err := rabbitmq.NewDiskOutbox(args)
+	require.Nil(t, err)
+
+	_, err = outbox.Peek()
+	require.Equal(t, rabbitmq.ErrEmptyOutbox, err)
+	require.Equal(t, rabbitmq.ErrEmptyOutbox, outbox.Remove())
+
+	require.Nil(t, outbox.Append(createOutboxMessage("id1")))
+	require.Nil(t, outbox.Append(createOutboxMessage("id2")))
+	require.Equal(t, 2, outbox.Len())
+	require.Equal(t, uint64(2), gauges["RabbitMQ-outbox-messages"])
+	require.NotZero(t, gauges["RabbitMQ-outbox-size"])
+
+	message, err := outbox.Peek()
+	require.Nil(t, err)
+	require.Equal(t, createOutboxMessage("id1"), *message)
+
+	require.Nil(t, outbox.Remove())
+	message, err = outbox.Peek()
+	require.Nil(t, err)
+	require.Equal(t, "id2", message.Msg.MessageId)
+
+	require.Nil(t, outbox.Remove())
+	require.Equal(t, 0, outbox.Len())
+	require.Equal(t, uint64(0), gauges["RabbitMQ-outbox-messages"])
+	require.Equal(t, uint64(0), gauges["RabbitMQ-outbox-size"])
+
+	require.Nil(t, outbox.Close())
+}
+
+func TestDiskOutbox_ShouldLoadBacklogAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDiskOutbox(t)
+
+	outbox, err := rabbitmq.NewDiskOutbox(args)
+	require.Nil(t, err)
+	require.Nil(t, outbox.Append(createOutboxMessage("id1")))
+	require.Nil(t, outbox.Append(createOutboxMessage("id2")))
+	require.Nil(t, outbox.Append(createOutboxMessage("id3")))
+	require.Nil(t, outbox.Remove())
+	require.Nil(t, outbox.Close())
+
+	// simulate a partially written record
+	dataFile, err := os.OpenFile(filepath.Join(args.Path, "outbox.data"), os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, err = dataFile.Write([]byte{0, 0, 1})
+	require.Nil(t, err)
+	require.Nil(t, dataFile.Close())
+
+	outbox, err = rabbitmq.NewDiskOutbox(args)
+	require.Nil(t, err)
+	require.Equal(t, 2, outbox.Len())
+
+	message, err := outbox.Peek()
+	require.Nil(t, err)
+	require.Equal(t, "id2", message.Msg.MessageId)
+
+	require.Nil(t, outbox.Append(createOutboxMessage("id4")))
+	require.Nil(t, outbox.Remove())
+	require.Nil(t, outbox.Remove())
+
+	message, err = outbox.Peek()
+	require.Nil(t, err)
+	require.Equal(t, "id4", message.Msg.MessageId)
+	require.Nil(t, outbox.Close())
+}
+
+func TestDiskOutbox_AppendShouldErrWhenFull(t *testing.T) {
+	t.Parallel()
+
+	outbox, err := rabbitmq.NewDiskOutbox(createMockArgsDiskOutbox(t))
+	require.Nil(t, err)
+
+	message := createOutboxMessage("id1")
+	message.Msg.Body = make([]byte, 1024*1024)
+
+	err = outbox.Append(message)
+	require.Equal(t, rabbitmq.ErrOutboxFull, err)
+	require.Equal(t, 0, outbox.Len())
+	require.Nil(t, outbox.Close())
+}
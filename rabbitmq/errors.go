@@ -10,3 +10,37 @@ var ErrInvalidRabbitMqExchangeName = errors.New("invalid rabbitmq exchange name"
 
 // ErrInvalidRabbitMqExchangeType signals that an empty rabbitmq exchange type has been provided
 var ErrInvalidRabbitMqExchangeType = errors.New("invalid rabbitmq exchange type")
+
+// ErrRabbitMqClientClosed signals that the rabbitmq client has been closed
+var ErrRabbitMqClientClosed = errors.New("rabbitmq client closed")
+
+// ErrTooManyUnconfirmedDeliveries signals that the confirmations buffer of the channel could not
+// hold the confirmation of another delivery
+var ErrTooManyUnconfirmedDeliveries = errors.New("too many unconfirmed deliveries")
+
+// ErrPublishTimeout signals that a message could not be published in the configured time
+var ErrPublishTimeout = errors.New("publish timeout")
+
+// ErrNilOutbox signals that a nil outbox has been provided
+var ErrNilOutbox = errors.New("nil outbox")
+
+// ErrInvalidOutboxPath signals that an invalid outbox path has been provided
+var ErrInvalidOutboxPath = errors.New("invalid outbox path")
+
+// ErrOutboxFull signals that the outbox reached its maximum size
+var ErrOutboxFull = errors.New("outbox is full")
+
+// ErrEmptyOutbox signals that the outbox has no messages
+var ErrEmptyOutbox = errors.New("empty outbox")
+
+// ErrZeroValueReceived signals that a zero value has been received
+var ErrZeroValueReceived = errors.New("zero value received")
+
+// ErrNilEnvelopeHandler signals that a nil envelope handler has been provided
+var ErrNilEnvelopeHandler = errors.New("nil envelope handler")
+
+// ErrNilFencingTokenHandler signals that a nil fencing token handler has been provided
+var ErrNilFencingTokenHandler = errors.New("nil fencing token handler")
+
+// ErrNilDeliveryTracker signals that a nil delivery tracker has been provided
+var ErrNilDeliveryTracker = errors.New("nil delivery tracker")
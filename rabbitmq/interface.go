@@ -27,6 +27,56 @@ type PublisherService interface {
 	BroadcastTxs(event data.BlockTxs)
 	BroadcastScrs(event data.BlockScrs)
 	BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder)
+	BroadcastRewards(event data.BlockRewards)
+	BroadcastReceipts(event data.BlockReceipts)
+	BroadcastInvalidTxs(event data.BlockInvalidTxs)
+	BroadcastAlteredAccounts(event data.BlockAlteredAccounts)
+	BroadcastBlockHeader(event data.BlockHeader)
+	BroadcastRoundsInfo(event data.RoundsInfo)
+	BroadcastValidatorsRating(event data.ValidatorsRating)
+	BroadcastValidatorsPubKeys(event data.ValidatorsPubKeys)
+	BroadcastEpochStart(event data.EpochStart)
+	BroadcastTxCompleted(event data.TxCompleted)
+	BroadcastFailedExecutions(event data.BlockFailedExecutions)
+	BroadcastGapDetected(event data.GapDetected)
+	BroadcastRetractedEvents(event data.RetractedEvents)
+	BroadcastShardStalled(event data.ShardStalled)
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// Outbox defines the behaviour of a persistent queue which holds the messages that
+// could not be published to the broker
+type Outbox interface {
+	Append(message OutboxMessage) error
+	Peek() (*OutboxMessage, error)
+	Remove() error
+	Len() int
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// FencingTokenHandler defines the behaviour of a component which provides the fencing
+// token of the current leadership term
+type FencingTokenHandler interface {
+	FencingToken() uint64
+	IsInterfaceNil() bool
+}
+
+// amqpConnection defines the behaviour of the rabbitMQ connection used by the client
+type amqpConnection interface {
+	Channel() (amqpChannel, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	IsClosed() bool
+	Close() error
+}
+
+// amqpChannel defines the behaviour of the rabbitMQ channel used by the client
+type amqpChannel interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation
+	Confirm(noWait bool) error
+	Close() error
+}
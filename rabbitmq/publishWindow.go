@@ -0,0 +1,108 @@
+package rabbitmq
+
+import (
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// pendingMessage holds a published message which was not yet confirmed by the broker
+type pendingMessage struct {
+	exchange    string
+	key         string
+	mandatory   bool
+	immediate   bool
+	msg         amqp.Publishing
+	deliveryTag uint64
+	sentTime    time.Time
+}
+
+// publishWindow keeps track of the in-flight messages, in the order they have
+// been published. It is not concurrent safe, the caller has to protect it.
+type publishWindow struct {
+	messages []*pendingMessage
+}
+
+func newPublishWindow() *publishWindow {
+	return &publishWindow{
+		messages: make([]*pendingMessage, 0),
+	}
+}
+
+// add will append a message at the end of the window
+func (pw *publishWindow) add(message *pendingMessage) {
+	pw.messages = append(pw.messages, message)
+}
+
+// remove will remove the message with the provided delivery tag from the window.
+// Confirmations usually arrive in publishing order, so the search starts from the
+// oldest message.
+func (pw *publishWindow) remove(deliveryTag uint64) (*pendingMessage, bool) {
+	if deliveryTag == 0 {
+		return nil, false
+	}
+
+	for i, message := range pw.messages {
+		if message.deliveryTag != deliveryTag {
+			continue
+		}
+
+		pw.messages = append(pw.messages[:i], pw.messages[i+1:]...)
+		return message, true
+	}
+
+	return nil, false
+}
+
+// indexOf returns the position in the window of the message with the provided delivery tag,
+// or -1 if there is no such message
+func (pw *publishWindow) indexOf(deliveryTag uint64) int {
+	if deliveryTag == 0 {
+		return -1
+	}
+
+	for i, message := range pw.messages {
+		if message.deliveryTag == deliveryTag {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// firstUnsentIndex returns the position of the oldest message which is not currently sent
+// on the channel, or -1 if all the messages are sent
+func (pw *publishWindow) firstUnsentIndex() int {
+	for i, message := range pw.messages {
+		if message.deliveryTag == 0 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// from returns the in-flight messages starting with the provided position, in publishing order
+func (pw *publishWindow) from(index int) []*pendingMessage {
+	if index < 0 || index >= len(pw.messages) {
+		return make([]*pendingMessage, 0)
+	}
+
+	messages := make([]*pendingMessage, len(pw.messages)-index)
+	copy(messages, pw.messages[index:])
+
+	return messages
+}
+
+// all returns the in-flight messages, in publishing order
+func (pw *publishWindow) all() []*pendingMessage {
+	messages := make([]*pendingMessage, len(pw.messages))
+	copy(messages, pw.messages)
+
+	return messages
+}
+
+// len returns the number of in-flight messages
+func (pw *publishWindow) len() int {
+	return len(pw.messages)
+}
@@ -0,0 +1,85 @@
+package rabbitmq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishWindow_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	pw := newPublishWindow()
+	require.Equal(t, 0, pw.len())
+
+	msg1 := &pendingMessage{exchange: "ex1", deliveryTag: 1}
+	msg2 := &pendingMessage{exchange: "ex2", deliveryTag: 2}
+	msg3 := &pendingMessage{exchange: "ex3", deliveryTag: 3}
+	pw.add(msg1)
+	pw.add(msg2)
+	pw.add(msg3)
+	require.Equal(t, 3, pw.len())
+
+	removed, ok := pw.remove(2)
+	require.True(t, ok)
+	require.Equal(t, msg2, removed)
+	require.Equal(t, []*pendingMessage{msg1, msg3}, pw.all())
+
+	removed, ok = pw.remove(2)
+	require.False(t, ok)
+	require.Nil(t, removed)
+
+	removed, ok = pw.remove(1)
+	require.True(t, ok)
+	require.Equal(t, msg1, removed)
+	require.Equal(t, 1, pw.len())
+}
+
+func TestPublishWindow_RemoveUnsentMessage(t *testing.T) {
+	t.Parallel()
+
+	pw := newPublishWindow()
+	pw.add(&pendingMessage{exchange: "ex1"})
+
+	removed, ok := pw.remove(0)
+	require.False(t, ok)
+	require.Nil(t, removed)
+	require.Equal(t, 1, pw.len())
+}
+
+func TestPublishWindow_IndexOf(t *testing.T) {
+	t.Parallel()
+
+	pw := newPublishWindow()
+
+	msg1 := &pendingMessage{exchange: "ex1", deliveryTag: 1}
+	msg2 := &pendingMessage{exchange: "ex2", deliveryTag: 2}
+	pw.add(msg1)
+	pw.add(msg2)
+
+	require.Equal(t, 0, pw.indexOf(1))
+	require.Equal(t, 1, pw.indexOf(2))
+	require.Equal(t, -1, pw.indexOf(3))
+	require.Equal(t, -1, pw.indexOf(0))
+}
+
+func TestPublishWindow_UnsentMessages(t *testing.T) {
+	t.Parallel()
+
+	pw := newPublishWindow()
+
+	msg1 := &pendingMessage{exchange: "ex1", deliveryTag: 1}
+	msg2 := &pendingMessage{exchange: "ex2"}
+	msg3 := &pendingMessage{exchange: "ex3", deliveryTag: 3}
+	pw.add(msg1)
+	require.Equal(t, -1, pw.firstUnsentIndex())
+
+	pw.add(msg2)
+	pw.add(msg3)
+	require.Equal(t, 1, pw.firstUnsentIndex())
+
+	require.Equal(t, []*pendingMessage{msg2, msg3}, pw.from(1))
+	require.Equal(t, []*pendingMessage{msg1, msg2, msg3}, pw.from(0))
+	require.Empty(t, pw.from(3))
+	require.Empty(t, pw.from(-1))
+}
@@ -1,9 +1,13 @@
 package rabbitmq
 
 import (
+	"context"
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
@@ -12,21 +16,55 @@ import (
 
 const (
 	emptyStr = ""
+
+	eventTypeHeader      = "eventType"
+	shardIDHeader        = "shardId"
+	nonceHeader          = "nonce"
+	payloadVersionHeader = "payloadVersion"
+	fencingTokenHeader   = "fencingToken"
+
+	cloudEventsHeaderPrefix = "cloudEvents:"
+
+	outboxDrainRetryInterval = time.Second
 )
 
 var log = logger.GetOrCreate("rabbitmq")
 
 // ArgsRabbitMqPublisher defines the arguments needed for rabbitmq publisher creation
 type ArgsRabbitMqPublisher struct {
-	Client     RabbitMqClient
-	Config     config.RabbitMQConfig
-	Marshaller marshal.Marshalizer
+	Client              RabbitMqClient
+	Config              config.RabbitMQConfig
+	Marshaller          marshal.Marshalizer
+	Outbox              Outbox
+	EnabledStreams      []string
+	EnvelopeHandler     cloudevents.EnvelopeHandler
+	FencingTokenHandler FencingTokenHandler
 }
 
 type rabbitMqPublisher struct {
-	client     RabbitMqClient
-	marshaller marshal.Marshalizer
-	cfg        config.RabbitMQConfig
+	client          RabbitMqClient
+	marshaller      marshal.Marshalizer
+	cfg             config.RabbitMQConfig
+	contentType     string
+	contentEncoding string
+	enabledStreams  common.EnabledStreams
+	envelopeHandler cloudevents.EnvelopeHandler
+	fencingHandler  FencingTokenHandler
+
+	outbox          Outbox
+	outboxNotifyCh  chan struct{}
+	cancelFunc      func()
+	drainLoopClosed chan struct{}
+}
+
+// messageInfo holds the block related info attached to a published message
+type messageInfo struct {
+	eventType  string
+	hash       string
+	shardID    uint32
+	nonce      uint64
+	hasShardID bool
+	hasNonce   bool
 }
 
 // NewRabbitMqPublisher creates a new rabbitMQ publisher instance
@@ -36,17 +74,38 @@ func NewRabbitMqPublisher(args ArgsRabbitMqPublisher) (*rabbitMqPublisher, error
 		return nil, err
 	}
 
-	rp := &rabbitMqPublisher{
-		cfg:        args.Config,
-		client:     args.Client,
-		marshaller: args.Marshaller,
+	enabledStreams, err := common.NewEnabledStreams(args.EnabledStreams)
+	if err != nil {
+		return nil, err
 	}
 
+	err = checkExchangesConfig(args.Config, enabledStreams)
+	if err != nil {
+		return nil, err
+	}
+
+	rp := &rabbitMqPublisher{
+		cfg:             args.Config,
+		client:          args.Client,
+		marshaller:      args.Marshaller,
+		enabledStreams:  enabledStreams,
+		envelopeHandler: args.EnvelopeHandler,
+		fencingHandler:  args.FencingTokenHandler,
+		outbox:          args.Outbox,
+		outboxNotifyCh:  make(chan struct{}, 1),
+		drainLoopClosed: make(chan struct{}),
+	}
+	rp.contentType, rp.contentEncoding = common.GetContentTypeAndEncoding(args.Marshaller)
+
 	err = rp.createExchanges()
 	if err != nil {
 		return nil, err
 	}
 
+	var ctx context.Context
+	ctx, rp.cancelFunc = context.WithCancel(context.Background())
+	go rp.drainOutbox(ctx)
+
 	return rp, nil
 }
 
@@ -57,72 +116,77 @@ func checkArgs(args ArgsRabbitMqPublisher) error {
 	if check.IfNil(args.Marshaller) {
 		return common.ErrNilMarshaller
 	}
-
-	if args.Config.EventsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
-	}
-	if args.Config.EventsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
-	}
-	if args.Config.RevertEventsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
-	}
-	if args.Config.RevertEventsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
-	}
-	if args.Config.FinalizedEventsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
+	if check.IfNil(args.Outbox) {
+		return ErrNilOutbox
 	}
-	if args.Config.FinalizedEventsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
+	if check.IfNil(args.EnvelopeHandler) {
+		return ErrNilEnvelopeHandler
 	}
-	if args.Config.BlockTxsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
+	if check.IfNil(args.FencingTokenHandler) {
+		return ErrNilFencingTokenHandler
 	}
-	if args.Config.BlockTxsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
-	}
-	if args.Config.BlockScrsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
-	}
-	if args.Config.BlockScrsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
-	}
-	if args.Config.BlockEventsExchange.Name == "" {
-		return ErrInvalidRabbitMqExchangeName
+
+	return nil
+}
+
+func getExchangesConfig(cfg config.RabbitMQConfig) map[string]config.RabbitMQExchangeConfig {
+	return map[string]config.RabbitMQExchangeConfig{
+		common.PushLogsAndEvents:    cfg.EventsExchange,
+		common.RevertBlockEvents:    cfg.RevertEventsExchange,
+		common.FinalizedBlockEvents: cfg.FinalizedEventsExchange,
+		common.BlockTxs:             cfg.BlockTxsExchange,
+		common.BlockScrs:            cfg.BlockScrsExchange,
+		common.BlockEvents:          cfg.BlockEventsExchange,
+		common.BlockRewards:         cfg.BlockRewardsExchange,
+		common.BlockReceipts:        cfg.BlockReceiptsExchange,
+		common.BlockInvalidTxs:      cfg.BlockInvalidTxsExchange,
+		common.AlteredAccounts:      cfg.AlteredAccountsExchange,
+		common.BlockHeaders:         cfg.BlockHeadersExchange,
+		common.RoundsInfo:           cfg.RoundsInfoExchange,
+		common.ValidatorsRating:     cfg.ValidatorsRatingExchange,
+		common.ValidatorsPubKeys:    cfg.ValidatorsPubKeysExchange,
+		common.EpochStart:           cfg.EpochStartExchange,
+		common.TxCompleted:          cfg.TxCompletedExchange,
+		common.FailedExecutions:     cfg.FailedExecutionsExchange,
+		common.GapDetected:          cfg.GapDetectedExchange,
+		common.RetractedEvents:      cfg.RetractedEventsExchange,
+		common.ShardStalled:         cfg.ShardStalledExchange,
 	}
-	if args.Config.BlockEventsExchange.Type == "" {
-		return ErrInvalidRabbitMqExchangeType
+}
+
+// checkExchangesConfig checks the exchanges config only for the enabled streams,
+// since the exchanges of the disabled streams are not used
+func checkExchangesConfig(cfg config.RabbitMQConfig, enabledStreams common.EnabledStreams) error {
+	exchanges := getExchangesConfig(cfg)
+	for _, stream := range common.AllStreams {
+		if !enabledStreams.IsEnabled(stream) {
+			continue
+		}
+
+		exchange := exchanges[stream]
+		if exchange.Name == "" {
+			return ErrInvalidRabbitMqExchangeName
+		}
+		if exchange.Type == "" {
+			return ErrInvalidRabbitMqExchangeType
+		}
 	}
 
 	return nil
 }
 
-// checkAndCreateExchanges creates exchanges if they are not existing already
+// createExchanges creates the exchanges of the enabled streams, if they are not existing already
 func (rp *rabbitMqPublisher) createExchanges() error {
-	err := rp.createExchange(rp.cfg.EventsExchange)
-	if err != nil {
-		return err
-	}
-	err = rp.createExchange(rp.cfg.RevertEventsExchange)
-	if err != nil {
-		return err
-	}
-	err = rp.createExchange(rp.cfg.FinalizedEventsExchange)
-	if err != nil {
-		return err
-	}
-	err = rp.createExchange(rp.cfg.BlockTxsExchange)
-	if err != nil {
-		return err
-	}
-	err = rp.createExchange(rp.cfg.BlockScrsExchange)
-	if err != nil {
-		return err
-	}
-	err = rp.createExchange(rp.cfg.BlockEventsExchange)
-	if err != nil {
-		return err
+	exchanges := getExchangesConfig(rp.cfg)
+	for _, stream := range common.AllStreams {
+		if !rp.enabledStreams.IsEnabled(stream) {
+			continue
+		}
+
+		err := rp.createExchange(exchanges[stream])
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -147,7 +211,14 @@ func (rp *rabbitMqPublisher) Publish(events data.BlockEvents) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.EventsExchange.Name, eventsBytes)
+	err = rp.publishFanout(rp.cfg.EventsExchange.Name, messageInfo{
+		eventType:  common.PushLogsAndEvents,
+		hash:       events.Hash,
+		shardID:    events.ShardID,
+		nonce:      events.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, eventsBytes)
 	if err != nil {
 		log.Error("failed to publish events to rabbitMQ", "err", err.Error())
 	}
@@ -161,7 +232,14 @@ func (rp *rabbitMqPublisher) PublishRevert(revertBlock data.RevertBlock) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.RevertEventsExchange.Name, revertBlockBytes)
+	err = rp.publishFanout(rp.cfg.RevertEventsExchange.Name, messageInfo{
+		eventType:  common.RevertBlockEvents,
+		hash:       revertBlock.Hash,
+		shardID:    revertBlock.ShardID,
+		nonce:      revertBlock.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, revertBlockBytes)
 	if err != nil {
 		log.Error("failed to publish revert event to rabbitMQ", "err", err.Error())
 	}
@@ -175,7 +253,10 @@ func (rp *rabbitMqPublisher) PublishFinalized(finalizedBlock data.FinalizedBlock
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.FinalizedEventsExchange.Name, finalizedBlockBytes)
+	err = rp.publishFanout(rp.cfg.FinalizedEventsExchange.Name, messageInfo{
+		eventType: common.FinalizedBlockEvents,
+		hash:      finalizedBlock.Hash,
+	}, finalizedBlockBytes)
 	if err != nil {
 		log.Error("failed to publish finalized event to rabbitMQ", "err", err.Error())
 	}
@@ -189,7 +270,14 @@ func (rp *rabbitMqPublisher) PublishTxs(blockTxs data.BlockTxs) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockTxsExchange.Name, txsBlockBytes)
+	err = rp.publishFanout(rp.cfg.BlockTxsExchange.Name, messageInfo{
+		eventType:  common.BlockTxs,
+		hash:       blockTxs.Hash,
+		shardID:    blockTxs.ShardID,
+		nonce:      blockTxs.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, txsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish block txs event to rabbitMQ", "err", err.Error())
 	}
@@ -203,7 +291,14 @@ func (rp *rabbitMqPublisher) PublishScrs(blockScrs data.BlockScrs) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockScrsExchange.Name, scrsBlockBytes)
+	err = rp.publishFanout(rp.cfg.BlockScrsExchange.Name, messageInfo{
+		eventType:  common.BlockScrs,
+		hash:       blockScrs.Hash,
+		shardID:    blockScrs.ShardID,
+		nonce:      blockScrs.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, scrsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish block scrs event to rabbitMQ", "err", err.Error())
 	}
@@ -217,28 +312,459 @@ func (rp *rabbitMqPublisher) PublishBlockEventsWithOrder(blockTxs data.BlockEven
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockEventsExchange.Name, txsBlockBytes)
+	err = rp.publishFanout(rp.cfg.BlockEventsExchange.Name, messageInfo{
+		eventType:  common.BlockEvents,
+		hash:       blockTxs.Hash,
+		shardID:    blockTxs.ShardID,
+		nonce:      blockTxs.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, txsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish full block events to rabbitMQ", "err", err.Error())
 	}
 }
 
-func (rp *rabbitMqPublisher) publishFanout(exchangeName string, payload []byte) error {
+// PublishRewards will publish block rewards event to rabbitmq
+func (rp *rabbitMqPublisher) PublishRewards(blockRewards data.BlockRewards) {
+	blockRewardsBytes, err := rp.marshaller.Marshal(blockRewards)
+	if err != nil {
+		log.Error("could not marshal block rewards event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.BlockRewardsExchange.Name, messageInfo{
+		eventType:  common.BlockRewards,
+		hash:       blockRewards.Hash,
+		shardID:    blockRewards.ShardID,
+		nonce:      blockRewards.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, blockRewardsBytes)
+	if err != nil {
+		log.Error("failed to publish block rewards event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishReceipts will publish block receipts event to rabbitmq
+func (rp *rabbitMqPublisher) PublishReceipts(blockReceipts data.BlockReceipts) {
+	blockReceiptsBytes, err := rp.marshaller.Marshal(blockReceipts)
+	if err != nil {
+		log.Error("could not marshal block receipts event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.BlockReceiptsExchange.Name, messageInfo{
+		eventType:  common.BlockReceipts,
+		hash:       blockReceipts.Hash,
+		shardID:    blockReceipts.ShardID,
+		nonce:      blockReceipts.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, blockReceiptsBytes)
+	if err != nil {
+		log.Error("failed to publish block receipts event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishInvalidTxs will publish block invalid txs event to rabbitmq
+func (rp *rabbitMqPublisher) PublishInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	blockInvalidTxsBytes, err := rp.marshaller.Marshal(blockInvalidTxs)
+	if err != nil {
+		log.Error("could not marshal block invalid txs event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.BlockInvalidTxsExchange.Name, messageInfo{
+		eventType:  common.BlockInvalidTxs,
+		hash:       blockInvalidTxs.Hash,
+		shardID:    blockInvalidTxs.ShardID,
+		nonce:      blockInvalidTxs.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, blockInvalidTxsBytes)
+	if err != nil {
+		log.Error("failed to publish block invalid txs event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishAlteredAccounts will publish altered accounts event to rabbitmq
+func (rp *rabbitMqPublisher) PublishAlteredAccounts(alteredAccounts data.BlockAlteredAccounts) {
+	alteredAccountsBytes, err := rp.marshaller.Marshal(alteredAccounts)
+	if err != nil {
+		log.Error("could not marshal altered accounts event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.AlteredAccountsExchange.Name, messageInfo{
+		eventType:  common.AlteredAccounts,
+		hash:       alteredAccounts.Hash,
+		shardID:    alteredAccounts.ShardID,
+		nonce:      alteredAccounts.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, alteredAccountsBytes)
+	if err != nil {
+		log.Error("failed to publish altered accounts event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishBlockHeader will publish block headers event to rabbitmq
+func (rp *rabbitMqPublisher) PublishBlockHeader(blockHeader data.BlockHeader) {
+	blockHeaderBytes, err := rp.marshaller.Marshal(blockHeader)
+	if err != nil {
+		log.Error("could not marshal block headers event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.BlockHeadersExchange.Name, messageInfo{
+		eventType:  common.BlockHeaders,
+		hash:       blockHeader.Hash,
+		shardID:    blockHeader.ShardID,
+		nonce:      blockHeader.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, blockHeaderBytes)
+	if err != nil {
+		log.Error("failed to publish block headers event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishRoundsInfo will publish rounds info event to rabbitmq
+func (rp *rabbitMqPublisher) PublishRoundsInfo(roundsInfo data.RoundsInfo) {
+	roundsInfoBytes, err := rp.marshaller.Marshal(roundsInfo)
+	if err != nil {
+		log.Error("could not marshal rounds info event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.RoundsInfoExchange.Name, messageInfo{
+		eventType:  common.RoundsInfo,
+		hash:       roundsInfo.ID,
+		shardID:    roundsInfo.ShardID,
+		hasShardID: true,
+	}, roundsInfoBytes)
+	if err != nil {
+		log.Error("failed to publish rounds info event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishValidatorsRating will publish validators rating event to rabbitmq
+func (rp *rabbitMqPublisher) PublishValidatorsRating(validatorsRating data.ValidatorsRating) {
+	validatorsRatingBytes, err := rp.marshaller.Marshal(validatorsRating)
+	if err != nil {
+		log.Error("could not marshal validators rating event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.ValidatorsRatingExchange.Name, messageInfo{
+		eventType:  common.ValidatorsRating,
+		hash:       validatorsRating.ID,
+		shardID:    validatorsRating.ShardID,
+		hasShardID: true,
+	}, validatorsRatingBytes)
+	if err != nil {
+		log.Error("failed to publish validators rating event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishValidatorsPubKeys will publish validators public keys event to rabbitmq
+func (rp *rabbitMqPublisher) PublishValidatorsPubKeys(validatorsPubKeys data.ValidatorsPubKeys) {
+	validatorsPubKeysBytes, err := rp.marshaller.Marshal(validatorsPubKeys)
+	if err != nil {
+		log.Error("could not marshal validators public keys event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.ValidatorsPubKeysExchange.Name, messageInfo{
+		eventType:  common.ValidatorsPubKeys,
+		hash:       validatorsPubKeys.ID,
+		shardID:    validatorsPubKeys.ShardID,
+		hasShardID: true,
+	}, validatorsPubKeysBytes)
+	if err != nil {
+		log.Error("failed to publish validators public keys event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishEpochStart will publish epoch start event to rabbitmq
+func (rp *rabbitMqPublisher) PublishEpochStart(epochStart data.EpochStart) {
+	epochStartBytes, err := rp.marshaller.Marshal(epochStart)
+	if err != nil {
+		log.Error("could not marshal epoch start event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.EpochStartExchange.Name, messageInfo{
+		eventType:  common.EpochStart,
+		hash:       epochStart.Hash,
+		shardID:    epochStart.ShardID,
+		nonce:      epochStart.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, epochStartBytes)
+	if err != nil {
+		log.Error("failed to publish epoch start event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishTxCompleted will publish transaction completed event to rabbitmq
+func (rp *rabbitMqPublisher) PublishTxCompleted(txCompleted data.TxCompleted) {
+	txCompletedBytes, err := rp.marshaller.Marshal(txCompleted)
+	if err != nil {
+		log.Error("could not marshal transaction completed event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.TxCompletedExchange.Name, messageInfo{
+		eventType: common.TxCompleted,
+		hash:      txCompleted.TxHash,
+	}, txCompletedBytes)
+	if err != nil {
+		log.Error("failed to publish transaction completed event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishFailedExecutions will publish failed executions event to rabbitmq
+func (rp *rabbitMqPublisher) PublishFailedExecutions(failedExecutions data.BlockFailedExecutions) {
+	failedExecutionsBytes, err := rp.marshaller.Marshal(failedExecutions)
+	if err != nil {
+		log.Error("could not marshal failed executions event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.FailedExecutionsExchange.Name, messageInfo{
+		eventType:  common.FailedExecutions,
+		hash:       failedExecutions.Hash,
+		shardID:    failedExecutions.ShardID,
+		nonce:      failedExecutions.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, failedExecutionsBytes)
+	if err != nil {
+		log.Error("failed to publish failed executions event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishGapDetected will publish nonce gap detected event to rabbitmq
+func (rp *rabbitMqPublisher) PublishGapDetected(gapDetected data.GapDetected) {
+	gapDetectedBytes, err := rp.marshaller.Marshal(gapDetected)
+	if err != nil {
+		log.Error("could not marshal nonce gap detected event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.GapDetectedExchange.Name, messageInfo{
+		eventType:  common.GapDetected,
+		hash:       gapDetected.ID,
+		shardID:    gapDetected.ShardID,
+		hasShardID: true,
+	}, gapDetectedBytes)
+	if err != nil {
+		log.Error("failed to publish nonce gap detected event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishRetractedEvents will publish retracted events to rabbitmq
+func (rp *rabbitMqPublisher) PublishRetractedEvents(retractedEvents data.RetractedEvents) {
+	retractedEventsBytes, err := rp.marshaller.Marshal(retractedEvents)
+	if err != nil {
+		log.Error("could not marshal retracted events", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.RetractedEventsExchange.Name, messageInfo{
+		eventType:  common.RetractedEvents,
+		hash:       retractedEvents.Hash,
+		shardID:    retractedEvents.ShardID,
+		nonce:      retractedEvents.Nonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, retractedEventsBytes)
+	if err != nil {
+		log.Error("failed to publish retracted events to rabbitMQ", "err", err.Error())
+	}
+}
+
+// PublishShardStalled will publish stalled shard event to rabbitmq
+func (rp *rabbitMqPublisher) PublishShardStalled(shardStalled data.ShardStalled) {
+	shardStalledBytes, err := rp.marshaller.Marshal(shardStalled)
+	if err != nil {
+		log.Error("could not marshal stalled shard event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(rp.cfg.ShardStalledExchange.Name, messageInfo{
+		eventType:  common.ShardStalled,
+		hash:       shardStalled.ID,
+		shardID:    shardStalled.ShardID,
+		nonce:      shardStalled.LastNonce,
+		hasShardID: true,
+		hasNonce:   true,
+	}, shardStalledBytes)
+	if err != nil {
+		log.Error("failed to publish stalled shard event to rabbitMQ", "err", err.Error())
+	}
+}
+
+// publishFanout will publish the message to the broker. If there are messages waiting
+// in the outbox, the new message is appended to the outbox as well, in order to keep
+// the publishing order. A message which could not be published is persisted in the outbox.
+func (rp *rabbitMqPublisher) publishFanout(exchangeName string, info messageInfo, payload []byte) error {
+	msg, err := rp.createMessage(info, payload)
+	if err != nil {
+		return err
+	}
+
+	message := OutboxMessage{
+		Exchange:  exchangeName,
+		Key:       emptyStr,
+		Mandatory: true,
+		Immediate: false,
+		Msg:       msg,
+	}
+
+	if rp.outbox.Len() > 0 {
+		return rp.appendToOutbox(message)
+	}
+
+	err = rp.publishMessage(message)
+	if err == nil {
+		return nil
+	}
+
+	log.Warn("could not publish message, will persist it in outbox", "exchange", exchangeName, "err", err.Error())
+
+	return rp.appendToOutbox(message)
+}
+
+func (rp *rabbitMqPublisher) publishMessage(message OutboxMessage) error {
 	return rp.client.Publish(
-		exchangeName,
-		emptyStr,
-		true,  // mandatory
-		false, // immediate
-		amqp.Publishing{
-			Body: payload,
-		},
+		message.Exchange,
+		message.Key,
+		message.Mandatory,
+		message.Immediate,
+		message.Msg,
 	)
 }
 
+func (rp *rabbitMqPublisher) appendToOutbox(message OutboxMessage) error {
+	err := rp.outbox.Append(message)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case rp.outboxNotifyCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// drainOutbox will publish, in order, the messages from the outbox. A message is removed
+// from the outbox only after it has been published successfully.
+func (rp *rabbitMqPublisher) drainOutbox(ctx context.Context) {
+	defer close(rp.drainLoopClosed)
+
+	for {
+		for rp.outbox.Len() > 0 {
+			err := rp.publishNextFromOutbox()
+			if err != nil {
+				log.Debug("could not publish message from outbox, will retry", "err", err.Error())
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-rp.outboxNotifyCh:
+		case <-time.After(outboxDrainRetryInterval):
+		}
+	}
+}
+
+func (rp *rabbitMqPublisher) publishNextFromOutbox() error {
+	message, err := rp.outbox.Peek()
+	if err != nil {
+		return err
+	}
+
+	err = rp.publishMessage(*message)
+	if err != nil {
+		return err
+	}
+
+	return rp.outbox.Remove()
+}
+
+func (rp *rabbitMqPublisher) createMessage(info messageInfo, payload []byte) (amqp.Publishing, error) {
+	headers := amqp.Table{
+		eventTypeHeader:      info.eventType,
+		payloadVersionHeader: int64(common.PublishedPayloadVersion),
+	}
+	if info.hasShardID {
+		headers[shardIDHeader] = int64(info.shardID)
+	}
+	if info.hasNonce {
+		headers[nonceHeader] = int64(info.nonce)
+	}
+	// the consumers can reject the messages of a deposed leader, which carry a lower token
+	fencingToken := rp.fencingHandler.FencingToken()
+	if fencingToken > 0 {
+		headers[fencingTokenHeader] = int64(fencingToken)
+	}
+
+	msg := amqp.Publishing{
+		Headers:         headers,
+		ContentType:     rp.contentType,
+		ContentEncoding: rp.contentEncoding,
+		DeliveryMode:    amqp.Persistent,
+		MessageId:       common.GetMessageID(info.hash, info.eventType),
+		Timestamp:       time.Now(),
+		Body:            payload,
+	}
+
+	if !rp.envelopeHandler.IsEnabled() {
+		return msg, nil
+	}
+
+	eventInfo := cloudevents.EventInfo{
+		EventType:  info.eventType,
+		Hash:       info.hash,
+		ShardID:    info.shardID,
+		HasShardID: info.hasShardID,
+	}
+
+	// binary content mode, as defined by the CloudEvents AMQP protocol binding
+	if rp.envelopeHandler.IsBinaryMode() {
+		for name, value := range rp.envelopeHandler.CreateBinaryAttributes(eventInfo) {
+			headers[cloudEventsHeaderPrefix+name] = value
+		}
+
+		return msg, nil
+	}
+
+	envelope, err := rp.envelopeHandler.CreateStructuredEnvelope(eventInfo, payload)
+	if err != nil {
+		return amqp.Publishing{}, err
+	}
+
+	msg.ContentType = cloudevents.StructuredContentType
+	msg.ContentEncoding = common.UTF8ContentEncoding
+	msg.Body = envelope
+
+	return msg, nil
+}
+
 // Close will trigger to close rabbitmq client
 func (rp *rabbitMqPublisher) Close() error {
+	rp.cancelFunc()
 	rp.client.Close()
-	return nil
+	<-rp.drainLoopClosed
+
+	return rp.outbox.Close()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,14 +1,20 @@
 package rabbitmq_test
 
 import (
+	"encoding/json"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/mock"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
 	"github.com/streadway/amqp"
@@ -43,8 +49,15 @@ func createMockArgsRabbitMqPublisher() rabbitmq.ArgsRabbitMqPublisher {
 				Name: "blockeventswithorder",
 				Type: "fanout",
 			},
+			BlockRewardsExchange: config.RabbitMQExchangeConfig{
+				Name: "blockrewards",
+				Type: "fanout",
+			},
 		},
-		Marshaller: &mock.MarshalizerMock{},
+		Marshaller:          &mock.MarshalizerMock{},
+		Outbox:              &disabled.Outbox{},
+		EnvelopeHandler:     &disabled.EnvelopeHandler{},
+		FencingTokenHandler: &disabled.LeaderElector{},
 	}
 }
 
@@ -73,6 +86,39 @@ func TestRabbitMqPublisher(t *testing.T) {
 		require.Equal(t, common.ErrNilMarshaller, err)
 	})
 
+	t.Run("nil outbox", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Outbox = nil
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, rabbitmq.ErrNilOutbox, err)
+	})
+
+	t.Run("nil envelope handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.EnvelopeHandler = nil
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, rabbitmq.ErrNilEnvelopeHandler, err)
+	})
+
+	t.Run("nil fencing token handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.FencingTokenHandler = nil
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, rabbitmq.ErrNilFencingTokenHandler, err)
+	})
+
 	t.Run("invalid events exchange name", func(t *testing.T) {
 		t.Parallel()
 
@@ -156,6 +202,41 @@ func TestRabbitMqPublisher(t *testing.T) {
 		require.True(t, errors.Is(err, rabbitmq.ErrInvalidRabbitMqExchangeType))
 	})
 
+	t.Run("invalid enabled stream", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.EnabledStreams = []string{"invalid"}
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.True(t, errors.Is(err, common.ErrInvalidStreamName))
+	})
+
+	t.Run("exchanges of disabled streams are not required", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.EnabledStreams = []string{common.PushLogsAndEvents, common.RevertBlockEvents}
+		args.Config.FinalizedEventsExchange = config.RabbitMQExchangeConfig{}
+		args.Config.BlockTxsExchange = config.RabbitMQExchangeConfig{}
+		args.Config.BlockScrsExchange = config.RabbitMQExchangeConfig{}
+		args.Config.BlockEventsExchange = config.RabbitMQExchangeConfig{}
+
+		declaredExchanges := make([]string, 0)
+		args.Client = &mocks.RabbitClientStub{
+			ExchangeDeclareCalled: func(name, kind string) error {
+				declaredExchanges = append(declaredExchanges, name)
+				return nil
+			},
+		}
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+		require.Equal(t, []string{"allevents", "revert"}, declaredExchanges)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -198,6 +279,331 @@ func TestPublish(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestPublish_WithOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed publish should persist the message and drain it later", func(t *testing.T) {
+		t.Parallel()
+
+		mut := sync.Mutex{}
+		brokerAvailable := false
+		publishedIDs := make([]string, 0)
+		client := &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				mut.Lock()
+				defer mut.Unlock()
+
+				if !brokerAvailable {
+					return rabbitmq.ErrPublishTimeout
+				}
+				publishedIDs = append(publishedIDs, msg.MessageId)
+				return nil
+			},
+		}
+
+		outbox, err := rabbitmq.NewDiskOutbox(createMockArgsDiskOutbox(t))
+		require.Nil(t, err)
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = client
+		args.Outbox = outbox
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		publisher.Publish(data.BlockEvents{Hash: "hash1"})
+		publisher.PublishRevert(data.RevertBlock{Hash: "hash2"})
+		require.Equal(t, 2, outbox.Len())
+
+		mut.Lock()
+		brokerAvailable = true
+		mut.Unlock()
+
+		// new messages should wait in the outbox, after the older ones
+		publisher.PublishFinalized(data.FinalizedBlock{Hash: "hash3"})
+
+		require.Eventually(t, func() bool {
+			return outbox.Len() == 0
+		}, time.Second*5, time.Millisecond*10)
+
+		mut.Lock()
+		expectedIDs := []string{
+			"hash1_" + common.PushLogsAndEvents,
+			"hash2_" + common.RevertBlockEvents,
+			"hash3_" + common.FinalizedBlockEvents,
+		}
+		require.Equal(t, expectedIDs, publishedIDs)
+		mut.Unlock()
+
+		require.Nil(t, publisher.Close())
+	})
+
+	t.Run("successful publish should not use the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, err := rabbitmq.NewDiskOutbox(createMockArgsDiskOutbox(t))
+		require.Nil(t, err)
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Outbox = outbox
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		publisher.Publish(data.BlockEvents{Hash: "hash1"})
+		require.Equal(t, 0, outbox.Len())
+
+		require.Nil(t, publisher.Close())
+	})
+}
+
+func TestPublish_MessageProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("json marshaller, block events", func(t *testing.T) {
+		t.Parallel()
+
+		var publishedMsg amqp.Publishing
+		args := createMockArgsRabbitMqPublisher()
+		args.Marshaller = &marshal.JsonMarshalizer{}
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedMsg = msg
+				return nil
+			},
+		}
+
+		rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		rabbitmq.Publish(data.BlockEvents{
+			Hash:    "hash1",
+			ShardID: 2,
+			Nonce:   10,
+		})
+
+		require.Equal(t, "application/json", publishedMsg.ContentType)
+		require.Equal(t, "utf-8", publishedMsg.ContentEncoding)
+		require.Equal(t, amqp.Persistent, publishedMsg.DeliveryMode)
+		require.Equal(t, "hash1_"+common.PushLogsAndEvents, publishedMsg.MessageId)
+		require.False(t, publishedMsg.Timestamp.IsZero())
+
+		expectedHeaders := amqp.Table{
+			"eventType":      common.PushLogsAndEvents,
+			"shardId":        int64(2),
+			"nonce":          int64(10),
+			"payloadVersion": int64(common.PublishedPayloadVersion),
+		}
+		require.Equal(t, expectedHeaders, publishedMsg.Headers)
+	})
+
+	t.Run("leader instance should add the fencing token header", func(t *testing.T) {
+		t.Parallel()
+
+		var publishedMsg amqp.Publishing
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedMsg = msg
+				return nil
+			},
+		}
+		args.FencingTokenHandler = &mocks.LeaderElectorStub{
+			FencingTokenCalled: func() uint64 {
+				return 7
+			},
+		}
+
+		rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		rabbitmq.Publish(data.BlockEvents{
+			Hash:    "hash1",
+			ShardID: 2,
+			Nonce:   10,
+		})
+
+		expectedHeaders := amqp.Table{
+			"eventType":      common.PushLogsAndEvents,
+			"shardId":        int64(2),
+			"nonce":          int64(10),
+			"payloadVersion": int64(common.PublishedPayloadVersion),
+			"fencingToken":   int64(7),
+		}
+		require.Equal(t, expectedHeaders, publishedMsg.Headers)
+	})
+
+	t.Run("unknown marshaller, finalized block", func(t *testing.T) {
+		t.Parallel()
+
+		var publishedMsg amqp.Publishing
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedMsg = msg
+				return nil
+			},
+		}
+
+		rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		rabbitmq.PublishFinalized(data.FinalizedBlock{
+			Hash: "hash1",
+		})
+
+		require.Equal(t, "application/octet-stream", publishedMsg.ContentType)
+		require.Equal(t, "", publishedMsg.ContentEncoding)
+		require.Equal(t, "hash1_"+common.FinalizedBlockEvents, publishedMsg.MessageId)
+
+		expectedHeaders := amqp.Table{
+			"eventType":      common.FinalizedBlockEvents,
+			"payloadVersion": int64(common.PublishedPayloadVersion),
+		}
+		require.Equal(t, expectedHeaders, publishedMsg.Headers)
+	})
+
+	t.Run("block txs, scrs, rewards, receipts and invalid txs should have shard and nonce headers", func(t *testing.T) {
+		t.Parallel()
+
+		publishedHeaders := make([]amqp.Table, 0)
+		args := createMockArgsRabbitMqPublisher()
+		args.EnabledStreams = []string{
+			common.BlockTxs,
+			common.BlockScrs,
+			common.BlockRewards,
+			common.BlockReceipts,
+			common.BlockInvalidTxs,
+		}
+		args.Config.BlockReceiptsExchange = config.RabbitMQExchangeConfig{Name: "blockreceipts", Type: "fanout"}
+		args.Config.BlockInvalidTxsExchange = config.RabbitMQExchangeConfig{Name: "blockinvalidtxs", Type: "fanout"}
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedHeaders = append(publishedHeaders, msg.Headers)
+				return nil
+			},
+		}
+
+		rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		rabbitmq.PublishTxs(data.BlockTxs{Hash: "hash1", ShardID: 2, Nonce: 10})
+		rabbitmq.PublishScrs(data.BlockScrs{Hash: "hash1", ShardID: 2, Nonce: 10})
+		rabbitmq.PublishRewards(data.BlockRewards{Hash: "hash1", ShardID: 2, Nonce: 10})
+		rabbitmq.PublishReceipts(data.BlockReceipts{Hash: "hash1", ShardID: 2, Nonce: 10})
+		rabbitmq.PublishInvalidTxs(data.BlockInvalidTxs{Hash: "hash1", ShardID: 2, Nonce: 10})
+
+		expectedEventTypes := []string{
+			common.BlockTxs,
+			common.BlockScrs,
+			common.BlockRewards,
+			common.BlockReceipts,
+			common.BlockInvalidTxs,
+		}
+		require.Equal(t, len(expectedEventTypes), len(publishedHeaders))
+		for i, eventType := range expectedEventTypes {
+			expectedHeaders := amqp.Table{
+				"eventType":      eventType,
+				"shardId":        int64(2),
+				"nonce":          int64(10),
+				"payloadVersion": int64(common.PublishedPayloadVersion),
+			}
+			require.Equal(t, expectedHeaders, publishedHeaders[i])
+		}
+	})
+}
+
+func TestPublish_CloudEventsEnvelope(t *testing.T) {
+	t.Parallel()
+
+	createEnvelopeHandler := func(mode string) cloudevents.EnvelopeHandler {
+		envelopeHandler, err := cloudevents.NewEnvelopeHandler(cloudevents.ArgsEnvelopeHandler{
+			Config: config.CloudEventsConfig{
+				Enabled:    true,
+				Mode:       mode,
+				Source:     "multiversx/testnet",
+				TypePrefix: "com.multiversx.notifier",
+			},
+			Marshaller: &marshal.JsonMarshalizer{},
+		})
+		require.Nil(t, err)
+
+		return envelopeHandler
+	}
+
+	t.Run("structured mode", func(t *testing.T) {
+		t.Parallel()
+
+		var publishedMsg amqp.Publishing
+		args := createMockArgsRabbitMqPublisher()
+		args.Marshaller = &marshal.JsonMarshalizer{}
+		args.EnvelopeHandler = createEnvelopeHandler(cloudevents.StructuredMode)
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedMsg = msg
+				return nil
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		revertBlock := data.RevertBlock{
+			Hash:    "hash1",
+			ShardID: 2,
+			Nonce:   10,
+		}
+		publisher.PublishRevert(revertBlock)
+
+		require.Equal(t, "application/cloudevents+json", publishedMsg.ContentType)
+		require.Equal(t, "hash1_"+common.RevertBlockEvents, publishedMsg.MessageId)
+
+		envelope := &cloudevents.Envelope{}
+		err = json.Unmarshal(publishedMsg.Body, envelope)
+		require.Nil(t, err)
+
+		revertBlockBytes, _ := json.Marshal(revertBlock)
+		require.Equal(t, "hash1_"+common.RevertBlockEvents, envelope.ID)
+		require.Equal(t, "multiversx/testnet/shard/2", envelope.Source)
+		require.Equal(t, "com.multiversx.notifier."+common.RevertBlockEvents, envelope.Type)
+		require.Equal(t, json.RawMessage(revertBlockBytes), envelope.Data)
+	})
+
+	t.Run("binary mode", func(t *testing.T) {
+		t.Parallel()
+
+		var publishedMsg amqp.Publishing
+		args := createMockArgsRabbitMqPublisher()
+		args.Marshaller = &marshal.JsonMarshalizer{}
+		args.EnvelopeHandler = createEnvelopeHandler(cloudevents.BinaryMode)
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				publishedMsg = msg
+				return nil
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		finalizedBlock := data.FinalizedBlock{
+			Hash: "hash1",
+		}
+		publisher.PublishFinalized(finalizedBlock)
+
+		finalizedBlockBytes, _ := json.Marshal(finalizedBlock)
+		require.Equal(t, "application/json", publishedMsg.ContentType)
+		require.Equal(t, finalizedBlockBytes, publishedMsg.Body)
+
+		require.Equal(t, "1.0", publishedMsg.Headers["cloudEvents:specversion"])
+		require.Equal(t, "hash1_"+common.FinalizedBlockEvents, publishedMsg.Headers["cloudEvents:id"])
+		require.Equal(t, "multiversx/testnet", publishedMsg.Headers["cloudEvents:source"])
+		require.Equal(t, "com.multiversx.notifier."+common.FinalizedBlockEvents, publishedMsg.Headers["cloudEvents:type"])
+		require.NotEmpty(t, publishedMsg.Headers["cloudEvents:time"])
+		require.Equal(t, common.FinalizedBlockEvents, publishedMsg.Headers["eventType"])
+	})
+}
+
 func TestPublishRevert(t *testing.T) {
 	t.Parallel()
 
@@ -264,6 +670,29 @@ func TestBroadcastTxs(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestBroadcastRewards(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			wasCalled = true
+			require.Equal(t, "blockrewards", exchange)
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.PublishRewards(data.BlockRewards{})
+
+	require.True(t, wasCalled)
+}
+
 func TestBroadcastScrs(t *testing.T) {
 	t.Parallel()
 
@@ -1,14 +1,30 @@
 package rabbitmq
 
 import (
+	"context"
 	"sync"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/streadway/amqp"
 )
 
 const (
-	reconnectRetryMs = 500
+	reconnectRetryMs  = 500
+	unsentRetryPeriod = time.Millisecond * 500
+
+	defaultInFlightWindowSize = 1
+	closeConfirmsTimeout      = time.Second * 5
+	closeConfirmsCheckPeriod  = time.Millisecond * 100
+
+	// the messages sent again after a nack are confirmed with new delivery tags, while the
+	// confirmations of their previous deliveries may still be pending on the same channel
+	confirmsBufferFactor = 2
+
+	confirmMetricID  = "RabbitMQ-confirm"
+	nackMetricID     = "RabbitMQ-nack"
+	inFlightMetricID = "RabbitMQ-inflight"
 
 	// ExchangeDeclare constants
 	isDurable  = true
@@ -17,36 +33,99 @@ const (
 	noWait     = false
 )
 
+// ArgsRabbitMqClient defines the arguments needed for rabbitMQ client creation
+type ArgsRabbitMqClient struct {
+	Url                  string
+	MaxInFlightMessages  uint32
+	PublishTimeoutInSec  uint32
+	StatusMetricsHandler common.StatusMetricsHandler
+	DeliveryTracker      common.DeliveryTracker
+	Outbox               Outbox
+}
+
 type rabbitMqClient struct {
-	url    string
-	pubMut sync.Mutex
+	url             string
+	windowSize      int
+	publishTimeout  time.Duration
+	metricsHandler  common.StatusMetricsHandler
+	deliveryTracker common.DeliveryTracker
+	outbox          Outbox
+	dialHandler     func(url string) (amqpConnection, error)
 
-	conn *amqp.Connection
-	ch   *amqp.Channel
+	pubMut             sync.Mutex
+	windowCond         *sync.Cond
+	window             *publishWindow
+	nextDeliveryTag    uint64
+	numUnconfirmed     int
+	confirmsBufferSize int
+	closed             bool
+
+	conn amqpConnection
+	ch   amqpChannel
+
+	connErrCh  chan *amqp.Error
+	chanErr    chan *amqp.Error
+	confirmsCh chan amqp.Confirmation
+
+	cancelFunc func()
+	closeChan  chan struct{}
+}
 
-	connErrCh chan *amqp.Error
-	chanErr   chan *amqp.Error
-	ackCh     chan uint64
-	nackCh    chan uint64
+// NewRabbitMQClient creates a new rabbitMQ client instance. Published messages are
+// tracked in a window of in-flight messages, and the broker confirmations are
+// processed asynchronously
+func NewRabbitMQClient(args ArgsRabbitMqClient) (*rabbitMqClient, error) {
+	return newRabbitMQClient(args, dialAMQP)
 }
 
-// NewRabbitMQClient creates a new rabbitMQ client instance
-func NewRabbitMQClient(url string) (*rabbitMqClient, error) {
+func newRabbitMQClient(args ArgsRabbitMqClient, dialHandler func(url string) (amqpConnection, error)) (*rabbitMqClient, error) {
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.DeliveryTracker) {
+		return nil, ErrNilDeliveryTracker
+	}
+	if check.IfNil(args.Outbox) {
+		return nil, ErrNilOutbox
+	}
+
+	windowSize := int(args.MaxInFlightMessages)
+	if windowSize == 0 {
+		windowSize = defaultInFlightWindowSize
+	}
+
 	rc := &rabbitMqClient{
-		url:    url,
-		pubMut: sync.Mutex{},
+		url:                args.Url,
+		windowSize:         windowSize,
+		publishTimeout:     time.Duration(args.PublishTimeoutInSec) * time.Second,
+		metricsHandler:     args.StatusMetricsHandler,
+		deliveryTracker:    args.DeliveryTracker,
+		outbox:             args.Outbox,
+		dialHandler:        dialHandler,
+		pubMut:             sync.Mutex{},
+		window:             newPublishWindow(),
+		confirmsBufferSize: windowSize * confirmsBufferFactor,
+		closeChan:          make(chan struct{}),
 	}
+	rc.windowCond = sync.NewCond(&rc.pubMut)
 
 	err := rc.connect()
 	if err != nil {
 		return nil, err
 	}
 
+	var ctx context.Context
+	ctx, rc.cancelFunc = context.WithCancel(context.Background())
+	go rc.processLoop(ctx)
+
 	return rc, nil
 }
 
 // ExchangeDeclare will declare an exchange
 func (rc *rabbitMqClient) ExchangeDeclare(name, kind string) error {
+	rc.pubMut.Lock()
+	defer rc.pubMut.Unlock()
+
 	return rc.ch.ExchangeDeclare(
 		name,
 		kind,
@@ -58,53 +137,259 @@ func (rc *rabbitMqClient) ExchangeDeclare(name, kind string) error {
 	)
 }
 
-// Publish will publich an item on the rabbitMq channel
+// Publish will publish an item on the rabbitMq channel. It will block only if
+// the window of in-flight messages is full, the broker confirmation being
+// handled asynchronously. If a publish timeout is configured, it will return
+// ErrPublishTimeout if the window did not have room for the message in time.
 func (rc *rabbitMqClient) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
 	rc.pubMut.Lock()
 	defer rc.pubMut.Unlock()
 
-	// In order to avoid losing any event, check rabbitmq ack event for the
-	// published message. If not-acknowledged, check if there is a connection or
-	// channel issue, and after that is solved try again.  This was done to
-	// make sure no event is lost, for example, if rabbitmq connection is not
-	// closing gracefully (port disabled from firewall), it may happen that the
-	// main loop will not catch the conn err event, and it will still try to
-	// publish the message.
-	for {
-		err := rc.ch.Publish(
-			exchange,
-			key,
-			mandatory,
-			immediate,
-			msg,
-		)
+	timedOut := false
+	if rc.publishTimeout > 0 && rc.window.len() >= rc.windowSize {
+		timer := time.AfterFunc(rc.publishTimeout, func() {
+			rc.pubMut.Lock()
+			timedOut = true
+			rc.windowCond.Broadcast()
+			rc.pubMut.Unlock()
+		})
+		defer timer.Stop()
+	}
+
+	for rc.window.len() >= rc.windowSize && !rc.closed && !timedOut {
+		rc.windowCond.Wait()
+	}
+	if rc.closed {
+		return ErrRabbitMqClientClosed
+	}
+	if timedOut {
+		return ErrPublishTimeout
+	}
+
+	pending := &pendingMessage{
+		exchange:  exchange,
+		key:       key,
+		mandatory: mandatory,
+		immediate: immediate,
+		msg:       msg,
+	}
+	// if an older message is waiting to be sent again, the new message is sent after it, in order
+	hasUnsentMessages := rc.window.firstUnsentIndex() >= 0
+	rc.window.add(pending)
+	if !hasUnsentMessages {
+		_ = rc.publishPendingMessage(pending)
+	}
+	rc.updateInFlightMetric()
+
+	return nil
+}
+
+// publishPendingMessage will send the message on the current channel. In order
+// to avoid losing any event, a message that could not be sent remains in the
+// window and it will be sent again by the retry loop, or after the channel is reopened.
+// A message is not sent while the confirmations buffer could not hold its confirmation,
+// so that the confirm listener of the channel never blocks.
+func (rc *rabbitMqClient) publishPendingMessage(pending *pendingMessage) error {
+	pending.deliveryTag = 0
+	pending.sentTime = time.Now()
+
+	if rc.numUnconfirmed >= rc.confirmsBufferSize {
+		log.Debug("too many unconfirmed deliveries, will retry", "exchange", pending.exchange, "num unconfirmed", rc.numUnconfirmed)
+		return ErrTooManyUnconfirmedDeliveries
+	}
+
+	err := rc.ch.Publish(
+		pending.exchange,
+		pending.key,
+		pending.mandatory,
+		pending.immediate,
+		pending.msg,
+	)
+	if err != nil {
+		log.Error("failed to publish message, will retry", "exchange", pending.exchange, "error", err.Error())
+		return err
+	}
+
+	rc.nextDeliveryTag++
+	rc.numUnconfirmed++
+	pending.deliveryTag = rc.nextDeliveryTag
+
+	return nil
+}
+
+// republishFrom will send again, in window order, the in-flight messages starting with the
+// provided position. The messages which were already sent after that position are sent again
+// as well, so that the broker receives them in order, the consumers being able to drop the
+// duplicates based on the message id. It stops at the first message which could not be sent.
+func (rc *rabbitMqClient) republishFrom(index int) {
+	for _, pending := range rc.window.from(index) {
+		err := rc.publishPendingMessage(pending)
 		if err != nil {
-			log.Error("failed to publish message", "exchange", exchange, "error", err.Error())
+			return
+		}
+	}
+}
+
+// retryUnsentMessages will send again, in order, the messages which could not be sent
+func (rc *rabbitMqClient) retryUnsentMessages() {
+	rc.pubMut.Lock()
+	defer rc.pubMut.Unlock()
+
+	rc.republishUnsentMessages()
+}
+
+func (rc *rabbitMqClient) processLoop(ctx context.Context) {
+	for {
+		rc.pubMut.Lock()
+		confirmsCh, connErrCh, chanErrCh := rc.confirmsCh, rc.connErrCh, rc.chanErr
+		rc.pubMut.Unlock()
+
+		rc.processNotifications(ctx, confirmsCh, connErrCh, chanErrCh)
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
 		}
+	}
+}
+
+// processNotifications handles the notifications for the current channel, and it returns
+// after a connection or channel failure has been handled
+func (rc *rabbitMqClient) processNotifications(
+	ctx context.Context,
+	confirmsCh chan amqp.Confirmation,
+	connErrCh chan *amqp.Error,
+	chanErrCh chan *amqp.Error,
+) {
+	retryTicker := time.NewTicker(unsentRetryPeriod)
+	defer retryTicker.Stop()
 
+	for {
 		select {
-		case deliveryTag := <-rc.ackCh:
-			log.Debug("Publish: published message ack", "deliveryTag", deliveryTag)
-			return err
-		case deliveryTag := <-rc.nackCh:
-			log.Debug("Publish: published message nack, will retry to publish message", "deliveryTag", deliveryTag)
-		case err := <-rc.connErrCh:
-			if err != nil {
-				log.Error("rabbitMQ connection failure", "err", err.Error())
-				rc.Reconnect()
+		case <-ctx.Done():
+			return
+		case <-retryTicker.C:
+			rc.retryUnsentMessages()
+		case confirmation, ok := <-confirmsCh:
+			if !ok {
+				confirmsCh = nil
+				continue
+			}
+			rc.handleConfirmation(confirmation)
+		case err, ok := <-connErrCh:
+			if !ok {
+				connErrCh = nil
+				continue
 			}
-		case err := <-rc.chanErr:
-			if err != nil {
-				log.Error("rabbitMQ channel failure", "err", err.Error())
-				rc.ReopenChannel()
+			log.Error("rabbitMQ connection failure", "err", err.Error())
+			rc.Reconnect()
+			return
+		case err, ok := <-chanErrCh:
+			if !ok {
+				chanErrCh = nil
+				continue
 			}
+			log.Error("rabbitMQ channel failure", "err", err.Error())
+			rc.ReopenChannel()
+			return
 		}
 	}
 }
 
-// dial will return a rabbitMq connection
-func (rc *rabbitMqClient) dial(url string) (*amqp.Connection, error) {
-	return amqp.Dial(url)
+func (rc *rabbitMqClient) handleConfirmation(confirmation amqp.Confirmation) {
+	messageID, delivered := rc.processConfirmation(confirmation)
+	if !delivered {
+		return
+	}
+
+	// notified outside the publish lock, so that publishing is not blocked by the delivery tracker
+	rc.deliveryTracker.ConfirmDelivery(messageID)
+}
+
+// processConfirmation returns the id of the message and true if the message was delivered
+func (rc *rabbitMqClient) processConfirmation(confirmation amqp.Confirmation) (string, bool) {
+	rc.pubMut.Lock()
+	defer rc.pubMut.Unlock()
+
+	if rc.numUnconfirmed > 0 {
+		rc.numUnconfirmed--
+	}
+
+	if !confirmation.Ack {
+		rc.processNack(confirmation.DeliveryTag)
+		return "", false
+	}
+
+	pending, ok := rc.window.remove(confirmation.DeliveryTag)
+	if !ok {
+		// the messages sent again after a nack are confirmed with their new delivery tag
+		log.Debug("received confirmation for unknown or republished message", "deliveryTag", confirmation.DeliveryTag)
+		rc.republishUnsentMessages()
+		return "", false
+	}
+
+	log.Trace("published message ack", "deliveryTag", confirmation.DeliveryTag)
+	rc.metricsHandler.AddRequest(confirmMetricID, time.Since(pending.sentTime))
+
+	rc.updateInFlightMetric()
+	rc.windowCond.Broadcast()
+	rc.republishUnsentMessages()
+
+	return pending.msg.MessageId, true
+}
+
+// republishUnsentMessages will send again, in order, the messages which could not be sent,
+// or which were held back while the confirmations buffer was full
+func (rc *rabbitMqClient) republishUnsentMessages() {
+	if rc.closed {
+		return
+	}
+
+	index := rc.window.firstUnsentIndex()
+	if index < 0 {
+		return
+	}
+
+	log.Debug("retrying to publish unsent messages", "num messages", rc.window.len()-index)
+	rc.republishFrom(index)
+}
+
+// processNack keeps the nacked message in its slot, and it sends it again together
+// with the messages published after it, in window order
+func (rc *rabbitMqClient) processNack(deliveryTag uint64) {
+	index := rc.window.indexOf(deliveryTag)
+	if index < 0 {
+		log.Debug("received nack for unknown or republished message", "deliveryTag", deliveryTag)
+		return
+	}
+
+	pending := rc.window.from(index)[0]
+	log.Debug("published message nack, will retry to publish messages in order", "deliveryTag", deliveryTag)
+	rc.metricsHandler.AddRequest(nackMetricID, time.Since(pending.sentTime))
+
+	rc.republishFrom(index)
+}
+
+// republishPendingMessages will publish again, in order, all the in-flight messages.
+// It has to be called after a new channel has been opened, since the delivery tags are
+// specific to a channel.
+func (rc *rabbitMqClient) republishPendingMessages() {
+	pendingMessages := rc.window.all()
+	if len(pendingMessages) > 0 {
+		log.Debug("republishing in-flight messages", "num messages", len(pendingMessages))
+	}
+
+	// the delivery tags of the previous channel are not valid anymore
+	for _, pending := range pendingMessages {
+		pending.deliveryTag = 0
+	}
+
+	rc.republishFrom(0)
+}
+
+func (rc *rabbitMqClient) updateInFlightMetric() {
+	rc.metricsHandler.SetGauge(inFlightMetricID, uint64(rc.window.len()))
 }
 
 // ConnErrChan will return connection error channel
@@ -118,13 +403,14 @@ func (rc *rabbitMqClient) CloseErrChan() chan *amqp.Error {
 }
 
 func (rc *rabbitMqClient) connect() error {
-	conn, err := rc.dial(rc.url)
+	conn, err := rc.dialHandler(rc.url)
 	if err != nil {
 		return err
 	}
 	rc.conn = conn
 
-	rc.connErrCh = make(chan *amqp.Error)
+	// buffered, so that the library does not block when notifying a failure
+	rc.connErrCh = make(chan *amqp.Error, 1)
 	rc.conn.NotifyClose(rc.connErrCh)
 
 	err = rc.openChannel()
@@ -142,9 +428,11 @@ func (rc *rabbitMqClient) openChannel() error {
 	}
 	rc.ch = ch
 
-	rc.chanErr = make(chan *amqp.Error)
+	rc.chanErr = make(chan *amqp.Error, 1)
 	rc.ch.NotifyClose(rc.chanErr)
-	rc.ackCh, rc.nackCh = rc.ch.NotifyConfirm(make(chan uint64), make(chan uint64))
+	rc.confirmsCh = rc.ch.NotifyPublish(make(chan amqp.Confirmation, rc.confirmsBufferSize))
+	rc.nextDeliveryTag = 0
+	rc.numUnconfirmed = 0
 
 	return rc.ch.Confirm(false)
 }
@@ -152,43 +440,148 @@ func (rc *rabbitMqClient) openChannel() error {
 // Reconnect will try to reconnect to rabbitmq
 func (rc *rabbitMqClient) Reconnect() {
 	for {
+		if rc.isClosing() {
+			return
+		}
 		time.Sleep(time.Millisecond * reconnectRetryMs)
 
+		rc.pubMut.Lock()
+		if rc.closed {
+			rc.pubMut.Unlock()
+			return
+		}
+
 		err := rc.connect()
 		if err != nil {
+			rc.pubMut.Unlock()
 			log.Debug("could not reconnect", "err", err.Error())
-		} else {
-			log.Debug("connection established after reconnect attempts")
-			break
+			continue
 		}
+
+		rc.republishPendingMessages()
+		rc.pubMut.Unlock()
+
+		log.Debug("connection established after reconnect attempts")
+		return
 	}
 }
 
 // ReopenChannel will try to reopen communication channel
 func (rc *rabbitMqClient) ReopenChannel() {
 	for {
+		if rc.isClosing() {
+			return
+		}
 		time.Sleep(time.Millisecond * reconnectRetryMs)
 
-		err := rc.openChannel()
+		rc.pubMut.Lock()
+		if rc.closed {
+			rc.pubMut.Unlock()
+			return
+		}
+
+		var err error
+		if rc.conn.IsClosed() {
+			err = rc.connect()
+		} else {
+			err = rc.openChannel()
+		}
 		if err != nil {
+			rc.pubMut.Unlock()
 			log.Debug("could not re-open channel", "err", err.Error())
-		} else {
-			log.Debug("channel opened after reconnect attempts")
-			break
+			continue
 		}
+
+		rc.republishPendingMessages()
+		rc.pubMut.Unlock()
+
+		log.Debug("channel opened after reconnect attempts")
+		return
 	}
 }
 
-// Close will close rabbitMq client connection
+func (rc *rabbitMqClient) isClosing() bool {
+	select {
+	case <-rc.closeChan:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitForPendingConfirms will wait, for a limited time, for the in-flight messages to be confirmed
+func (rc *rabbitMqClient) waitForPendingConfirms() {
+	timeout := time.After(closeConfirmsTimeout)
+	for {
+		rc.pubMut.Lock()
+		numPending := rc.window.len()
+		rc.pubMut.Unlock()
+
+		if numPending == 0 {
+			return
+		}
+
+		select {
+		case <-timeout:
+			log.Warn("closing rabbitMQ client with unconfirmed messages", "num messages", numPending)
+			return
+		case <-time.After(closeConfirmsCheckPeriod):
+		}
+	}
+}
+
+// persistUnconfirmedMessages appends to the outbox, in order, the messages which were not
+// confirmed by the broker, so that they are published again once the broker is available.
+// They are appended after the messages already waiting in the outbox, if any.
+func (rc *rabbitMqClient) persistUnconfirmedMessages(messages []*pendingMessage) {
+	for i, pending := range messages {
+		err := rc.outbox.Append(OutboxMessage{
+			Exchange:  pending.exchange,
+			Key:       pending.key,
+			Mandatory: pending.mandatory,
+			Immediate: pending.immediate,
+			Msg:       pending.msg,
+		})
+		if err != nil {
+			log.Error("could not persist unconfirmed messages in outbox, the messages are lost",
+				"num messages", len(messages)-i,
+				"err", err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(messages) > 0 {
+		log.Info("persisted unconfirmed messages in outbox", "num messages", len(messages))
+	}
+}
+
+// Close will close rabbitMq client connection. The messages which are still unconfirmed
+// after the close timeout are persisted in the outbox.
 func (rc *rabbitMqClient) Close() {
+	rc.waitForPendingConfirms()
+
+	rc.pubMut.Lock()
+	rc.closed = true
+	rc.windowCond.Broadcast()
+	unconfirmedMessages := rc.window.all()
+
+	// closed under the publish lock, since a reconnect replaces the channel and the connection
+	// under the same lock, and it does not open new ones once the client is closed
 	err := rc.ch.Close()
 	if err != nil {
 		log.Error("failed to close rabbitMQ channel", "err", err.Error())
 	}
 	err = rc.conn.Close()
 	if err != nil {
-		log.Error("failed to close rabbitMQ channel", "err", err.Error())
+		log.Error("failed to close rabbitMQ connection", "err", err.Error())
 	}
+	rc.pubMut.Unlock()
+
+	rc.persistUnconfirmedMessages(unconfirmedMessages)
+
+	close(rc.closeChan)
+	rc.cancelFunc()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
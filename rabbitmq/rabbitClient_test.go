@@ -0,0 +1,655 @@
+package rabbitmq
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/mock"
+	"github.com/multiversx/mx-chain-notifier-go/cloudevents"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second * 5
+
+type channelMock struct {
+	mut        sync.Mutex
+	published  []string
+	publishErr error
+	confirmsCh chan amqp.Confirmation
+	closeCh    chan *amqp.Error
+}
+
+func (cm *channelMock) Publish(_, _ string, _, _ bool, msg amqp.Publishing) error {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	if cm.publishErr != nil {
+		return cm.publishErr
+	}
+	cm.published = append(cm.published, msg.MessageId)
+
+	return nil
+}
+
+func (cm *channelMock) ExchangeDeclare(_, _ string, _, _, _, _ bool, _ amqp.Table) error {
+	return nil
+}
+
+func (cm *channelMock) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	cm.closeCh = receiver
+	return receiver
+}
+
+func (cm *channelMock) NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation {
+	cm.confirmsCh = confirm
+	return confirm
+}
+
+func (cm *channelMock) Confirm(_ bool) error {
+	return nil
+}
+
+func (cm *channelMock) Close() error {
+	return nil
+}
+
+func (cm *channelMock) setPublishErr(err error) {
+	cm.mut.Lock()
+	cm.publishErr = err
+	cm.mut.Unlock()
+}
+
+func (cm *channelMock) getPublished() []string {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	return append([]string{}, cm.published...)
+}
+
+type connectionMock struct {
+	mut      sync.Mutex
+	channels []*channelMock
+}
+
+func (cm *connectionMock) Channel() (amqpChannel, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	ch := &channelMock{}
+	cm.channels = append(cm.channels, ch)
+
+	return ch, nil
+}
+
+func (cm *connectionMock) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	return receiver
+}
+
+func (cm *connectionMock) IsClosed() bool {
+	return false
+}
+
+func (cm *connectionMock) Close() error {
+	return nil
+}
+
+func (cm *connectionMock) getChannel(index int) *channelMock {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	if index >= len(cm.channels) {
+		return nil
+	}
+
+	return cm.channels[index]
+}
+
+func (cm *connectionMock) numChannels() int {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	return len(cm.channels)
+}
+
+type outboxMock struct {
+	mut       sync.Mutex
+	messages  []OutboxMessage
+	appendErr error
+}
+
+func (om *outboxMock) Append(message OutboxMessage) error {
+	om.mut.Lock()
+	defer om.mut.Unlock()
+
+	if om.appendErr != nil {
+		return om.appendErr
+	}
+	om.messages = append(om.messages, message)
+
+	return nil
+}
+
+func (om *outboxMock) Peek() (*OutboxMessage, error) {
+	return nil, nil
+}
+
+func (om *outboxMock) Remove() error {
+	return nil
+}
+
+func (om *outboxMock) Len() int {
+	om.mut.Lock()
+	defer om.mut.Unlock()
+
+	return len(om.messages)
+}
+
+func (om *outboxMock) Close() error {
+	return nil
+}
+
+func (om *outboxMock) IsInterfaceNil() bool {
+	return om == nil
+}
+
+func (om *outboxMock) getMessageIDs() []string {
+	om.mut.Lock()
+	defer om.mut.Unlock()
+
+	ids := make([]string, 0, len(om.messages))
+	for _, message := range om.messages {
+		ids = append(ids, message.Msg.MessageId)
+	}
+
+	return ids
+}
+
+type envelopeHandlerMock struct{}
+
+func (ehm *envelopeHandlerMock) CreateStructuredEnvelope(_ cloudevents.EventInfo, payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+func (ehm *envelopeHandlerMock) CreateBinaryAttributes(_ cloudevents.EventInfo) map[string]string {
+	return make(map[string]string)
+}
+
+func (ehm *envelopeHandlerMock) IsEnabled() bool {
+	return false
+}
+
+func (ehm *envelopeHandlerMock) IsBinaryMode() bool {
+	return false
+}
+
+func (ehm *envelopeHandlerMock) IsInterfaceNil() bool {
+	return ehm == nil
+}
+
+type confirmedMessages struct {
+	mut sync.Mutex
+	ids []string
+}
+
+func (cm *confirmedMessages) add(id string) {
+	cm.mut.Lock()
+	cm.ids = append(cm.ids, id)
+	cm.mut.Unlock()
+}
+
+func (cm *confirmedMessages) get() []string {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	return append([]string{}, cm.ids...)
+}
+
+func createMockRabbitMqClientArgs() ArgsRabbitMqClient {
+	return ArgsRabbitMqClient{
+		Url:                  "amqp://localhost",
+		MaxInFlightMessages:  10,
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		DeliveryTracker:      &mocks.DeliveryTrackerStub{},
+		Outbox:               &outboxMock{},
+	}
+}
+
+func createTestClient(t *testing.T, args ArgsRabbitMqClient) (*rabbitMqClient, *connectionMock) {
+	conn := &connectionMock{}
+	rc, err := newRabbitMQClient(args, func(url string) (amqpConnection, error) {
+		return conn, nil
+	})
+	require.Nil(t, err)
+
+	return rc, conn
+}
+
+func publishTestMessage(rc *rabbitMqClient, id string) error {
+	return rc.Publish("exchange", "", true, false, amqp.Publishing{MessageId: id})
+}
+
+func sendConfirmation(ch *channelMock, deliveryTag uint64, ack bool) {
+	ch.confirmsCh <- amqp.Confirmation{DeliveryTag: deliveryTag, Ack: ack}
+}
+
+func getWindowMessageIDs(rc *rabbitMqClient) []string {
+	rc.pubMut.Lock()
+	defer rc.pubMut.Unlock()
+
+	ids := make([]string, 0, rc.window.len())
+	for _, pending := range rc.window.all() {
+		ids = append(ids, pending.msg.MessageId)
+	}
+
+	return ids
+}
+
+func TestNewRabbitMQClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRabbitMqClientArgs()
+		args.StatusMetricsHandler = nil
+
+		rc, err := NewRabbitMQClient(args)
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+		require.Nil(t, rc)
+	})
+
+	t.Run("nil delivery tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRabbitMqClientArgs()
+		args.DeliveryTracker = nil
+
+		rc, err := NewRabbitMQClient(args)
+		require.Equal(t, ErrNilDeliveryTracker, err)
+		require.Nil(t, rc)
+	})
+
+	t.Run("nil outbox", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRabbitMqClientArgs()
+		args.Outbox = nil
+
+		rc, err := NewRabbitMQClient(args)
+		require.Equal(t, ErrNilOutbox, err)
+		require.Nil(t, rc)
+	})
+
+	t.Run("dial error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		rc, err := newRabbitMQClient(createMockRabbitMqClientArgs(), func(url string) (amqpConnection, error) {
+			return nil, expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, rc)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, _ := createTestClient(t, createMockRabbitMqClientArgs())
+		require.False(t, check.IfNil(rc))
+
+		rc.Close()
+	})
+}
+
+func TestRabbitMqClient_Ack(t *testing.T) {
+	t.Parallel()
+
+	confirmed := &confirmedMessages{}
+	args := createMockRabbitMqClientArgs()
+	args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+		ConfirmDeliveryCalled: confirmed.add,
+	}
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+	require.Nil(t, publishTestMessage(rc, "msg2"))
+
+	ch := conn.getChannel(0)
+	require.Equal(t, []string{"msg1", "msg2"}, ch.getPublished())
+	require.Equal(t, []string{"msg1", "msg2"}, getWindowMessageIDs(rc))
+
+	sendConfirmation(ch, 1, true)
+	require.Eventually(t, func() bool {
+		return len(confirmed.get()) == 1
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1"}, confirmed.get())
+	require.Equal(t, []string{"msg2"}, getWindowMessageIDs(rc))
+
+	sendConfirmation(ch, 2, true)
+	require.Eventually(t, func() bool {
+		return len(confirmed.get()) == 2
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1", "msg2"}, confirmed.get())
+	require.Empty(t, getWindowMessageIDs(rc))
+}
+
+func TestRabbitMqClient_NackShouldRepublishInOrder(t *testing.T) {
+	t.Parallel()
+
+	confirmed := &confirmedMessages{}
+	args := createMockRabbitMqClientArgs()
+	args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+		ConfirmDeliveryCalled: confirmed.add,
+	}
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+	require.Nil(t, publishTestMessage(rc, "msg2"))
+	require.Nil(t, publishTestMessage(rc, "msg3"))
+
+	ch := conn.getChannel(0)
+	sendConfirmation(ch, 1, false)
+
+	// the nacked message and the ones published after it are sent again, in order
+	require.Eventually(t, func() bool {
+		return len(ch.getPublished()) == 6
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1", "msg2", "msg3", "msg1", "msg2", "msg3"}, ch.getPublished())
+	require.Equal(t, []string{"msg1", "msg2", "msg3"}, getWindowMessageIDs(rc))
+
+	// the confirmations for the previous delivery tags are ignored
+	sendConfirmation(ch, 2, true)
+	sendConfirmation(ch, 3, true)
+	sendConfirmation(ch, 4, true)
+	sendConfirmation(ch, 5, true)
+	sendConfirmation(ch, 6, true)
+
+	require.Eventually(t, func() bool {
+		return len(confirmed.get()) == 3
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1", "msg2", "msg3"}, confirmed.get())
+	require.Empty(t, getWindowMessageIDs(rc))
+}
+
+func TestRabbitMqClient_NackShouldNotExceedTheConfirmationsBuffer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRabbitMqClientArgs()
+	args.MaxInFlightMessages = 2
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+	require.Nil(t, publishTestMessage(rc, "msg2"))
+
+	// each nack sends again both messages, while the confirmations of their previous
+	// deliveries are still pending
+	ch := conn.getChannel(0)
+	require.Equal(t, 4, cap(ch.confirmsCh))
+	sendConfirmation(ch, 1, false)
+	sendConfirmation(ch, 3, false)
+	sendConfirmation(ch, 5, false)
+
+	require.Eventually(t, func() bool {
+		return len(ch.getPublished()) == 7
+	}, waitTimeout, time.Millisecond*10)
+
+	// the second message is held back, since the buffer could not hold its confirmation
+	rc.pubMut.Lock()
+	require.Equal(t, 4, rc.numUnconfirmed)
+	require.Equal(t, 1, rc.window.firstUnsentIndex())
+	rc.pubMut.Unlock()
+
+	// a pending confirmation frees a slot, so the held back message is sent
+	sendConfirmation(ch, 2, true)
+	require.Eventually(t, func() bool {
+		return len(ch.getPublished()) == 8
+	}, waitTimeout, time.Millisecond*10)
+
+	expectedPublished := []string{"msg1", "msg2", "msg1", "msg2", "msg1", "msg2", "msg1", "msg2"}
+	require.Equal(t, expectedPublished, ch.getPublished())
+}
+
+func TestRabbitMqClient_ReconnectAfterCloseShouldNotDial(t *testing.T) {
+	t.Parallel()
+
+	numDials := 0
+	conn := &connectionMock{}
+	rc, err := newRabbitMQClient(createMockRabbitMqClientArgs(), func(url string) (amqpConnection, error) {
+		numDials++
+		return conn, nil
+	})
+	require.Nil(t, err)
+	defer rc.Close()
+
+	// the client is closed while the reconnect is waiting for its next attempt
+	rc.pubMut.Lock()
+	rc.closed = true
+	rc.pubMut.Unlock()
+
+	rc.Reconnect()
+	rc.ReopenChannel()
+
+	require.Equal(t, 1, numDials)
+	require.Equal(t, 1, conn.numChannels())
+}
+
+func TestRabbitMqClient_SendFailureShouldBeRetriedInOrder(t *testing.T) {
+	t.Parallel()
+
+	rc, conn := createTestClient(t, createMockRabbitMqClientArgs())
+	defer rc.Close()
+
+	ch := conn.getChannel(0)
+	ch.setPublishErr(errors.New("channel error"))
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+	require.Nil(t, publishTestMessage(rc, "msg2"))
+	require.Empty(t, ch.getPublished())
+
+	ch.setPublishErr(nil)
+	require.Nil(t, publishTestMessage(rc, "msg3"))
+
+	// msg3 is not sent before the older messages
+	require.Eventually(t, func() bool {
+		return len(ch.getPublished()) == 3
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1", "msg2", "msg3"}, ch.getPublished())
+
+	sendConfirmation(ch, 1, true)
+	sendConfirmation(ch, 2, true)
+	sendConfirmation(ch, 3, true)
+	require.Eventually(t, func() bool {
+		return len(getWindowMessageIDs(rc)) == 0
+	}, waitTimeout, time.Millisecond*10)
+}
+
+func TestRabbitMqClient_PublishTimeout(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRabbitMqClientArgs()
+	args.MaxInFlightMessages = 1
+	args.PublishTimeoutInSec = 1
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+
+	err := publishTestMessage(rc, "msg2")
+	require.Equal(t, ErrPublishTimeout, err)
+	require.Equal(t, []string{"msg1"}, getWindowMessageIDs(rc))
+
+	sendConfirmation(conn.getChannel(0), 1, true)
+	require.Eventually(t, func() bool {
+		return len(getWindowMessageIDs(rc)) == 0
+	}, waitTimeout, time.Millisecond*10)
+}
+
+// the broker being down with the outbox disabled: no publish timeout is set, so publishing
+// should block instead of dropping messages
+func TestRabbitMqClient_NoPublishTimeoutShouldBlockUntilConfirmed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRabbitMqClientArgs()
+	args.MaxInFlightMessages = 1
+	args.PublishTimeoutInSec = 0
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+
+	publishDone := make(chan error)
+	go func() {
+		publishDone <- publishTestMessage(rc, "msg2")
+	}()
+
+	select {
+	case <-publishDone:
+		require.Fail(t, "publish should have blocked while the window is full")
+	case <-time.After(time.Millisecond * 200):
+	}
+
+	ch := conn.getChannel(0)
+	sendConfirmation(ch, 1, true)
+
+	select {
+	case err := <-publishDone:
+		require.Nil(t, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "publish should have been unblocked by the confirmation")
+	}
+	require.Equal(t, []string{"msg1", "msg2"}, ch.getPublished())
+
+	sendConfirmation(ch, 2, true)
+	require.Eventually(t, func() bool {
+		return len(getWindowMessageIDs(rc)) == 0
+	}, waitTimeout, time.Millisecond*10)
+}
+
+func TestRabbitMqClient_ReopenChannelShouldRepublishInOrder(t *testing.T) {
+	t.Parallel()
+
+	confirmed := &confirmedMessages{}
+	args := createMockRabbitMqClientArgs()
+	args.DeliveryTracker = &mocks.DeliveryTrackerStub{
+		ConfirmDeliveryCalled: confirmed.add,
+	}
+
+	rc, conn := createTestClient(t, args)
+	defer rc.Close()
+
+	require.Nil(t, publishTestMessage(rc, "msg1"))
+	require.Nil(t, publishTestMessage(rc, "msg2"))
+
+	conn.getChannel(0).closeCh <- amqp.ErrClosed
+
+	require.Eventually(t, func() bool {
+		return conn.numChannels() == 2 && len(conn.getChannel(1).getPublished()) == 2
+	}, waitTimeout, time.Millisecond*10)
+
+	newChannel := conn.getChannel(1)
+	require.Equal(t, []string{"msg1", "msg2"}, newChannel.getPublished())
+
+	// the delivery tags are specific to the new channel
+	sendConfirmation(newChannel, 1, true)
+	sendConfirmation(newChannel, 2, true)
+
+	require.Eventually(t, func() bool {
+		return len(confirmed.get()) == 2
+	}, waitTimeout, time.Millisecond*10)
+	require.Equal(t, []string{"msg1", "msg2"}, confirmed.get())
+}
+
+func TestRabbitMqClient_CloseShouldPersistUnconfirmedMessagesInOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should persist in order", func(t *testing.T) {
+		t.Parallel()
+
+		outbox := &outboxMock{}
+		args := createMockRabbitMqClientArgs()
+		args.Outbox = outbox
+
+		rc, conn := createTestClient(t, args)
+
+		require.Nil(t, publishTestMessage(rc, "msg1"))
+		require.Nil(t, publishTestMessage(rc, "msg2"))
+		require.Nil(t, publishTestMessage(rc, "msg3"))
+		sendConfirmation(conn.getChannel(0), 2, true)
+
+		rc.Close()
+
+		require.Equal(t, []string{"msg1", "msg3"}, outbox.getMessageIDs())
+	})
+
+	t.Run("append error should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		outbox := &outboxMock{
+			appendErr: errors.New("outbox disabled"),
+		}
+		args := createMockRabbitMqClientArgs()
+		args.Outbox = outbox
+
+		rc, _ := createTestClient(t, args)
+
+		require.Nil(t, publishTestMessage(rc, "msg1"))
+
+		rc.Close()
+
+		require.Equal(t, 0, outbox.Len())
+	})
+}
+
+func TestRabbitMqClient_PublisherCloseShouldPersistUnconfirmedMessagesBeforeReturning(t *testing.T) {
+	t.Parallel()
+
+	outbox := &outboxMock{}
+	args := createMockRabbitMqClientArgs()
+	args.Outbox = outbox
+
+	rc, _ := createTestClient(t, args)
+
+	rp, err := NewRabbitMqPublisher(ArgsRabbitMqPublisher{
+		Client: rc,
+		Config: config.RabbitMQConfig{
+			EventsExchange: config.RabbitMQExchangeConfig{
+				Name: "allevents",
+				Type: "fanout",
+			},
+		},
+		Marshaller:          &mock.MarshalizerMock{},
+		Outbox:              outbox,
+		EnabledStreams:      []string{common.PushLogsAndEvents},
+		EnvelopeHandler:     &envelopeHandlerMock{},
+		FencingTokenHandler: &mocks.LeaderElectorStub{},
+	})
+	require.Nil(t, err)
+
+	p, err := process.NewPublisher(rp)
+	require.Nil(t, err)
+	require.Nil(t, p.Run())
+
+	p.Broadcast(data.BlockEvents{Hash: "hash1"})
+	p.Broadcast(data.BlockEvents{Hash: "hash2"})
+
+	err = p.Close()
+	require.Nil(t, err)
+	require.Equal(t, 2, outbox.Len())
+}
@@ -2,6 +2,9 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
 
 	"github.com/go-redis/redis/v8"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -11,34 +14,135 @@ import (
 var log = logger.GetOrCreate("redis")
 
 // CreateSimpleClient will create a redis client for a redis setup with one instance
-func CreateSimpleClient(cfg config.RedisConfig) (RedLockClient, error) {
+func CreateSimpleClient(cfg config.RedisConfig) (RedisClient, error) {
 	opt, err := redis.ParseURL(cfg.Url)
 	if err != nil {
 		return nil, err
 	}
+
+	// the credentials from config take precedence over the ones from url
+	if len(cfg.Username) > 0 {
+		opt.Username = cfg.Username
+	}
+	if len(cfg.Password) > 0 {
+		opt.Password = cfg.Password
+	}
+	if cfg.TLS.Enabled {
+		opt.TLSConfig, err = createTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	client := redis.NewClient(opt)
 
 	log.Debug("created redis instance connection type", "connection url", cfg.Url)
 
-	rc := NewRedisClientWrapper(client)
-	ok := rc.IsConnected(context.Background())
-	if !ok {
-		return nil, ErrRedisConnectionFailed
-	}
-
-	return rc, nil
+	return checkConnection(NewRedisClientWrapper(client))
 }
 
 // CreateFailoverClient will create a redis client for a redis setup with sentinel
-func CreateFailoverClient(cfg config.RedisConfig) (RedLockClient, error) {
+func CreateFailoverClient(cfg config.RedisConfig) (RedisClient, error) {
+	sentinelAddrs := getSentinelAddresses(cfg)
+	if len(sentinelAddrs) == 0 {
+		return nil, ErrNoSentinelAddresses
+	}
+
+	tlsConfig, err := createTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
 	client := redis.NewFailoverClient(&redis.FailoverOptions{
-		MasterName:    cfg.MasterName,
-		SentinelAddrs: []string{cfg.SentinelUrl},
+		MasterName:       cfg.MasterName,
+		SentinelAddrs:    sentinelAddrs,
+		SentinelPassword: cfg.SentinelPassword,
+		Username:         cfg.Username,
+		Password:         cfg.Password,
+		TLSConfig:        tlsConfig,
+	})
+
+	log.Debug("created redis sentinel connection type", "sentinel addresses", sentinelAddrs, "master", cfg.MasterName)
+
+	return checkConnection(NewRedisClientWrapper(client))
+}
+
+// CreateClusterClient will create a redis client for a redis setup with cluster mode enabled
+func CreateClusterClient(cfg config.RedisConfig) (RedisClient, error) {
+	if len(cfg.ClusterAddresses) == 0 {
+		return nil, ErrNoClusterAddresses
+	}
+
+	tlsConfig, err := createTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs:     cfg.ClusterAddresses,
+		Username:  cfg.Username,
+		Password:  cfg.Password,
+		TLSConfig: tlsConfig,
 	})
-	rc := NewRedisClientWrapper(client)
 
-	log.Debug("created redis sentinel connection type", "connection url", cfg.SentinelUrl, "master", cfg.MasterName)
+	log.Debug("created redis cluster connection type", "cluster addresses", cfg.ClusterAddresses)
+
+	return checkConnection(NewRedisClientWrapper(client))
+}
+
+// getSentinelAddresses returns the sentinel addresses, keeping the single sentinel url
+// option for backwards compatibility
+func getSentinelAddresses(cfg config.RedisConfig) []string {
+	addresses := make([]string, 0, len(cfg.SentinelUrls)+1)
+	if len(cfg.SentinelUrl) > 0 {
+		addresses = append(addresses, cfg.SentinelUrl)
+	}
+	for _, url := range cfg.SentinelUrls {
+		if len(url) > 0 && url != cfg.SentinelUrl {
+			addresses = append(addresses, url)
+		}
+	}
+
+	return addresses
+}
+
+// createTLSConfig returns nil if TLS is not enabled
+func createTLSConfig(cfg config.RedisTLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if len(cfg.CACertFile) > 0 {
+		caCert, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, ErrInvalidCACertificate
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if len(cfg.CertFile) > 0 || len(cfg.KeyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
 
+func checkConnection(rc *redisClientWrapper) (RedisClient, error) {
 	ok := rc.IsConnected(context.Background())
 	if !ok {
 		return nil, ErrRedisConnectionFailed
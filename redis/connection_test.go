@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateClusterClient_NoAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, err := CreateClusterClient(config.RedisConfig{})
+	require.Nil(t, client)
+	require.Equal(t, ErrNoClusterAddresses, err)
+}
+
+func TestCreateFailoverClient_NoAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, err := CreateFailoverClient(config.RedisConfig{})
+	require.Nil(t, client)
+	require.Equal(t, ErrNoSentinelAddresses, err)
+}
+
+func TestGetSentinelAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single sentinel url", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := getSentinelAddresses(config.RedisConfig{
+			SentinelUrl: "localhost:26379",
+		})
+		require.Equal(t, []string{"localhost:26379"}, addresses)
+	})
+
+	t.Run("single sentinel url and multiple sentinel urls", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := getSentinelAddresses(config.RedisConfig{
+			SentinelUrl:  "localhost:26379",
+			SentinelUrls: []string{"localhost:26379", "localhost:26380", ""},
+		})
+		require.Equal(t, []string{"localhost:26379", "localhost:26380"}, addresses)
+	})
+}
+
+func TestCreateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.RedisTLSConfig{})
+		require.Nil(t, err)
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("missing CA certificate file should err", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.RedisTLSConfig{
+			Enabled:    true,
+			CACertFile: filepath.Join(t.TempDir(), "missing.pem"),
+		})
+		require.NotNil(t, err)
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("invalid CA certificate should err", func(t *testing.T) {
+		t.Parallel()
+
+		caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+		err := os.WriteFile(caCertFile, []byte("not a certificate"), 0644)
+		require.Nil(t, err)
+
+		tlsConfig, err := createTLSConfig(config.RedisTLSConfig{
+			Enabled:    true,
+			CACertFile: caCertFile,
+		})
+		require.Equal(t, ErrInvalidCACertificate, err)
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.RedisTLSConfig{
+			Enabled:            true,
+			ServerName:         "redis.local",
+			InsecureSkipVerify: true,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "redis.local", tlsConfig.ServerName)
+		require.True(t, tlsConfig.InsecureSkipVerify)
+	})
+}
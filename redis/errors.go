@@ -8,5 +8,29 @@ var ErrNilRedlockClient = errors.New("nil redlock client")
 // ErrRedisConnectionFailed signals that connection to redis failed
 var ErrRedisConnectionFailed = errors.New("error connecting to redis")
 
+// ErrNoClusterAddresses signals that no redis cluster address has been provided
+var ErrNoClusterAddresses = errors.New("no redis cluster addresses provided")
+
+// ErrNoSentinelAddresses signals that no redis sentinel address has been provided
+var ErrNoSentinelAddresses = errors.New("no redis sentinel addresses provided")
+
+// ErrInvalidCACertificate signals that the provided CA certificate file does not hold a valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
 // ErrZeroValueReceived signals that a zero value has been received
 var ErrZeroValueReceived = errors.New("zero value received")
+
+// ErrNilLeaseClient signals that a nil lease client has been provided
+var ErrNilLeaseClient = errors.New("nil lease client")
+
+// ErrEmptyLeaseKey signals that an empty lease key has been provided
+var ErrEmptyLeaseKey = errors.New("empty lease key")
+
+// ErrInvalidRenewInterval signals that the lease renew interval is not lower than the lease TTL
+var ErrInvalidRenewInterval = errors.New("lease renew interval should be lower than the lease TTL")
+
+// ErrNilSortedSetClient signals that a nil sorted set client has been provided
+var ErrNilSortedSetClient = errors.New("nil sorted set client")
+
+// ErrEmptyWatchedTxsKey signals that an empty watched transactions key has been provided
+var ErrEmptyWatchedTxsKey = errors.New("empty watched transactions key")
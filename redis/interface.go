@@ -7,16 +7,56 @@ import (
 
 // LockService defines the behaviour of a lock service component.
 // It makes sure that a duplicated entry is not processed multiple times.
+// An entry is reserved for a short period of time, and it is marked as
+// processed only after it is committed.
 type LockService interface {
-	IsEventProcessed(ctx context.Context, blockHash string) (bool, error)
+	ReserveEvent(ctx context.Context, key string) (bool, error)
+	CommitEvent(ctx context.Context, key string) error
 	HasConnection(ctx context.Context) bool
+	Close() error
 	IsInterfaceNil() bool
 }
 
 // RedLockClient defines the behaviour of a cache handler component
 type RedLockClient interface {
 	SetEntry(ctx context.Context, key string, value bool, ttl time.Duration) (bool, error)
+	UpdateEntry(ctx context.Context, key string, value bool, ttl time.Duration) error
 	Ping(ctx context.Context) (string, error)
 	IsConnected(ctx context.Context) bool
 	IsInterfaceNil() bool
 }
+
+// LeaseClient defines the behaviour of a redis client component used for leader election
+type LeaseClient interface {
+	AcquireLease(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	RenewLease(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, key string, value string) error
+	Increment(ctx context.Context, key string) (int64, error)
+	IsInterfaceNil() bool
+}
+
+// SortedSetClient defines the behaviour of a redis client component used for the sorted sets
+// shared between the notifier instances
+type SortedSetClient interface {
+	AddToSortedSet(ctx context.Context, key string, members map[string]float64) error
+	GetSortedSetMembers(ctx context.Context, key string, minScore float64) (map[string]float64, error)
+	RemoveFromSortedSet(ctx context.Context, key string, members []string) error
+	RemoveSortedSetMembersBelow(ctx context.Context, key string, maxScore float64) error
+	IsInterfaceNil() bool
+}
+
+// RedisClient defines the behaviour of a redis client which can be used for locking,
+// for leader election and for the shared sorted sets
+type RedisClient interface {
+	RedLockClient
+	LeaseClient
+	SortedSetClient
+}
+
+// LeaderElector defines the behaviour of a leader election component
+type LeaderElector interface {
+	IsLeader() bool
+	FencingToken() uint64
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,258 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+)
+
+const (
+	fencingTokenKeySuffix = "_fencing_token"
+
+	isLeaderMetricID           = "LeaderElection-is-leader"
+	fencingTokenMetricID       = "LeaderElection-fencing-token"
+	leadershipAcquiredMetricID = "LeaderElection-acquired"
+	leadershipLostMetricID     = "LeaderElection-lost"
+)
+
+// ArgsLeaderElector defines the arguments needed for leader elector creation
+type ArgsLeaderElector struct {
+	Client               LeaseClient
+	StatusMetricsHandler common.StatusMetricsHandler
+	InstanceID           string
+	LeaseKey             string
+	LeaseTTLInMs         uint32
+	RenewIntervalInMs    uint32
+}
+
+// leaderElector elects a leader between multiple notifier instances, based on a lease
+// kept in redis. Each leadership term gets a new fencing token, which is strictly
+// greater than the tokens of the previous terms.
+type leaderElector struct {
+	client          LeaseClient
+	metricsHandler  common.StatusMetricsHandler
+	instanceID      string
+	leaseKey        string
+	fencingTokenKey string
+	leaseTTL        time.Duration
+	renewInterval   time.Duration
+
+	mut            sync.RWMutex
+	isLeader       bool
+	leaseExpiresAt time.Time
+	fencingToken   uint64
+
+	cancelFunc func()
+	loopClosed chan struct{}
+}
+
+// NewLeaderElector creates a new leader elector instance and it starts the election loop.
+// If no instance id is provided, a random one is generated.
+func NewLeaderElector(args ArgsLeaderElector) (*leaderElector, error) {
+	err := checkLeaderElectorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID := args.InstanceID
+	if len(instanceID) == 0 {
+		instanceID = uuid.New().String()
+	}
+
+	le := &leaderElector{
+		client:          args.Client,
+		metricsHandler:  args.StatusMetricsHandler,
+		instanceID:      instanceID,
+		leaseKey:        args.LeaseKey,
+		fencingTokenKey: args.LeaseKey + fencingTokenKeySuffix,
+		leaseTTL:        time.Millisecond * time.Duration(args.LeaseTTLInMs),
+		renewInterval:   time.Millisecond * time.Duration(args.RenewIntervalInMs),
+		loopClosed:      make(chan struct{}),
+	}
+
+	log.Info("starting leader election", "instance id", instanceID, "lease key", args.LeaseKey)
+
+	var ctx context.Context
+	ctx, le.cancelFunc = context.WithCancel(context.Background())
+	go le.electionLoop(ctx)
+
+	return le, nil
+}
+
+func checkLeaderElectorArgs(args ArgsLeaderElector) error {
+	if check.IfNil(args.Client) {
+		return ErrNilLeaseClient
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if len(args.LeaseKey) == 0 {
+		return ErrEmptyLeaseKey
+	}
+	if args.LeaseTTLInMs == 0 {
+		return fmt.Errorf("%w for lease TTL in milliseconds", ErrZeroValueReceived)
+	}
+	if args.RenewIntervalInMs == 0 {
+		return fmt.Errorf("%w for renew interval in milliseconds", ErrZeroValueReceived)
+	}
+	if args.RenewIntervalInMs >= args.LeaseTTLInMs {
+		return ErrInvalidRenewInterval
+	}
+
+	return nil
+}
+
+func (le *leaderElector) electionLoop(ctx context.Context) {
+	defer close(le.loopClosed)
+
+	for {
+		le.runElectionStep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(le.renewInterval):
+		}
+	}
+}
+
+func (le *leaderElector) runElectionStep(ctx context.Context) {
+	le.mut.RLock()
+	isLeader := le.isLeader
+	le.mut.RUnlock()
+
+	if isLeader {
+		le.renewLease(ctx)
+		return
+	}
+
+	le.tryAcquireLease(ctx)
+}
+
+func (le *leaderElector) tryAcquireLease(ctx context.Context) {
+	startTime := time.Now()
+	acquired, err := le.client.AcquireLease(ctx, le.leaseKey, le.instanceID, le.leaseTTL)
+	if err != nil {
+		log.Debug("failed to acquire leader lease", "error", err.Error())
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	token, err := le.client.Increment(ctx, le.fencingTokenKey)
+	if err != nil {
+		log.Warn("failed to get fencing token, releasing leader lease", "error", err.Error())
+		le.releaseLease(ctx)
+		return
+	}
+
+	le.mut.Lock()
+	le.isLeader = true
+	le.leaseExpiresAt = startTime.Add(le.leaseTTL)
+	le.fencingToken = uint64(token)
+	le.mut.Unlock()
+
+	le.metricsHandler.SetGauge(isLeaderMetricID, 1)
+	le.metricsHandler.SetGauge(fencingTokenMetricID, uint64(token))
+	le.metricsHandler.AddRequest(leadershipAcquiredMetricID, time.Since(startTime))
+
+	log.Info("acquired leadership", "instance id", le.instanceID, "fencing token", token)
+}
+
+func (le *leaderElector) renewLease(ctx context.Context) {
+	startTime := time.Now()
+	renewed, err := le.client.RenewLease(ctx, le.leaseKey, le.instanceID, le.leaseTTL)
+	if err != nil {
+		log.Warn("failed to renew leader lease", "error", err.Error())
+
+		// the leadership is kept until the lease expires, since it might not have been lost
+		if le.isLeaseExpired() {
+			le.stepDown("lease expired")
+		}
+		return
+	}
+	if !renewed {
+		le.stepDown("lease held by another instance")
+		return
+	}
+
+	le.mut.Lock()
+	le.leaseExpiresAt = startTime.Add(le.leaseTTL)
+	le.mut.Unlock()
+}
+
+func (le *leaderElector) isLeaseExpired() bool {
+	le.mut.RLock()
+	defer le.mut.RUnlock()
+
+	return !time.Now().Before(le.leaseExpiresAt)
+}
+
+func (le *leaderElector) stepDown(reason string) {
+	le.mut.Lock()
+	wasLeader := le.isLeader
+	le.isLeader = false
+	fencingToken := le.fencingToken
+	le.mut.Unlock()
+
+	if !wasLeader {
+		return
+	}
+
+	le.metricsHandler.SetGauge(isLeaderMetricID, 0)
+	le.metricsHandler.AddRequest(leadershipLostMetricID, 0)
+
+	log.Info("lost leadership", "instance id", le.instanceID, "fencing token", fencingToken, "reason", reason)
+}
+
+func (le *leaderElector) releaseLease(ctx context.Context) {
+	err := le.client.ReleaseLease(ctx, le.leaseKey, le.instanceID)
+	if err != nil {
+		log.Warn("failed to release leader lease", "error", err.Error())
+	}
+}
+
+// IsLeader returns true if the current instance holds a valid leader lease
+func (le *leaderElector) IsLeader() bool {
+	le.mut.RLock()
+	defer le.mut.RUnlock()
+
+	return le.isLeader && time.Now().Before(le.leaseExpiresAt)
+}
+
+// FencingToken returns the fencing token of the current leadership term
+func (le *leaderElector) FencingToken() uint64 {
+	le.mut.RLock()
+	defer le.mut.RUnlock()
+
+	return le.fencingToken
+}
+
+// Close will stop the election loop and it will release the lease, if held,
+// so that another instance can take over without waiting for the lease to expire
+func (le *leaderElector) Close() error {
+	le.cancelFunc()
+	<-le.loopClosed
+
+	le.mut.RLock()
+	isLeader := le.isLeader
+	le.mut.RUnlock()
+
+	if isLeader {
+		le.releaseLease(context.Background())
+		le.stepDown("closing")
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (le *leaderElector) IsInterfaceNil() bool {
+	return le == nil
+}
@@ -0,0 +1,265 @@
+package redis_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testWaitTime  = time.Second
+	testCheckTime = time.Millisecond * 5
+)
+
+func createMockLeaderElectorArgs() redis.ArgsLeaderElector {
+	return redis.ArgsLeaderElector{
+		Client:               &mocks.LeaseClientStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		InstanceID:           "instance1",
+		LeaseKey:             "leader",
+		LeaseTTLInMs:         200,
+		RenewIntervalInMs:    20,
+	}
+}
+
+func TestNewLeaderElector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil lease client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.Client = nil
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.Equal(t, redis.ErrNilLeaseClient, err)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.StatusMetricsHandler = nil
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("empty lease key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.LeaseKey = ""
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.Equal(t, redis.ErrEmptyLeaseKey, err)
+	})
+
+	t.Run("zero lease ttl", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.LeaseTTLInMs = 0
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.True(t, errors.Is(err, redis.ErrZeroValueReceived))
+	})
+
+	t.Run("zero renew interval", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.RenewIntervalInMs = 0
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.True(t, errors.Is(err, redis.ErrZeroValueReceived))
+	})
+
+	t.Run("renew interval not lower than lease ttl", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.RenewIntervalInMs = args.LeaseTTLInMs
+
+		le, err := redis.NewLeaderElector(args)
+		require.True(t, check.IfNil(le))
+		require.Equal(t, redis.ErrInvalidRenewInterval, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		le, err := redis.NewLeaderElector(createMockLeaderElectorArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(le))
+		require.Nil(t, le.Close())
+	})
+}
+
+func TestLeaderElector_Election(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should acquire leadership and release it on close", func(t *testing.T) {
+		t.Parallel()
+
+		released := atomic.Bool{}
+		args := createMockLeaderElectorArgs()
+		args.Client = &mocks.LeaseClientStub{
+			AcquireLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				require.Equal(t, "leader", key)
+				require.Equal(t, "instance1", value)
+				require.Equal(t, 200*time.Millisecond, ttl)
+				return true, nil
+			},
+			IncrementCalled: func(key string) (int64, error) {
+				require.Equal(t, "leader_fencing_token", key)
+				return 5, nil
+			},
+			RenewLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return true, nil
+			},
+			ReleaseLeaseCalled: func(key string, value string) error {
+				require.Equal(t, "instance1", value)
+				released.Store(true)
+				return nil
+			},
+		}
+
+		le, err := redis.NewLeaderElector(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, le.IsLeader, testWaitTime, testCheckTime)
+		require.Equal(t, uint64(5), le.FencingToken())
+
+		// leadership is kept while the lease is renewed
+		time.Sleep(300 * time.Millisecond)
+		require.True(t, le.IsLeader())
+
+		err = le.Close()
+		require.Nil(t, err)
+		require.True(t, released.Load())
+		require.False(t, le.IsLeader())
+	})
+
+	t.Run("lease held by another instance, should be follower", func(t *testing.T) {
+		t.Parallel()
+
+		numAcquireCalls := atomic.Int32{}
+		args := createMockLeaderElectorArgs()
+		args.Client = &mocks.LeaseClientStub{
+			AcquireLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				numAcquireCalls.Add(1)
+				return false, nil
+			},
+			IncrementCalled: func(key string) (int64, error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil
+			},
+			ReleaseLeaseCalled: func(key string, value string) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		le, err := redis.NewLeaderElector(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			return numAcquireCalls.Load() > 2
+		}, testWaitTime, testCheckTime)
+		require.False(t, le.IsLeader())
+		require.Nil(t, le.Close())
+	})
+
+	t.Run("lease taken over, should step down", func(t *testing.T) {
+		t.Parallel()
+
+		numAcquireCalls := atomic.Int32{}
+		args := createMockLeaderElectorArgs()
+		args.Client = &mocks.LeaseClientStub{
+			AcquireLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return numAcquireCalls.Add(1) == 1, nil
+			},
+			RenewLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return false, nil
+			},
+		}
+
+		lostLeadership := atomic.Bool{}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddRequestCalled: func(path string, duration time.Duration) {
+				if path == "LeaderElection-lost" {
+					lostLeadership.Store(true)
+				}
+			},
+		}
+
+		le, err := redis.NewLeaderElector(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, lostLeadership.Load, testWaitTime, testCheckTime)
+		require.False(t, le.IsLeader())
+		require.Nil(t, le.Close())
+	})
+
+	t.Run("renew failure should keep leadership until the lease expires", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockLeaderElectorArgs()
+		args.Client = &mocks.LeaseClientStub{
+			AcquireLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return true, nil
+			},
+			RenewLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return false, errors.New("connection error")
+			},
+		}
+
+		le, err := redis.NewLeaderElector(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, le.IsLeader, testWaitTime, testCheckTime)
+		require.Eventually(t, func() bool {
+			return !le.IsLeader()
+		}, testWaitTime, testCheckTime)
+		require.Nil(t, le.Close())
+	})
+
+	t.Run("fencing token failure should release the lease", func(t *testing.T) {
+		t.Parallel()
+
+		released := atomic.Bool{}
+		args := createMockLeaderElectorArgs()
+		args.Client = &mocks.LeaseClientStub{
+			AcquireLeaseCalled: func(key string, value string, ttl time.Duration) (bool, error) {
+				return true, nil
+			},
+			IncrementCalled: func(key string) (int64, error) {
+				return 0, errors.New("increment error")
+			},
+			ReleaseLeaseCalled: func(key string, value string) error {
+				released.Store(true)
+				return nil
+			},
+		}
+
+		le, err := redis.NewLeaderElector(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, released.Load, testWaitTime, testCheckTime)
+		require.False(t, le.IsLeader())
+		require.Nil(t, le.Close())
+	})
+}
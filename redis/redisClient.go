@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -11,13 +12,29 @@ const (
 	pongValue = "PONG"
 )
 
+// renewLeaseScript extends the lease only if it is still held by the provided value
+var renewLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseLeaseScript removes the lease only if it is still held by the provided value
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 type redisClientWrapper struct {
-	redis *redis.Client
+	redis redis.UniversalClient
 }
 
 // NewRedisClientWrapper creates a wrapper over redis client instance. This is an abstraction
 // over different redis connections: single instance, sentinel, cluster.
-func NewRedisClientWrapper(redis *redis.Client) *redisClientWrapper {
+func NewRedisClientWrapper(redis redis.UniversalClient) *redisClientWrapper {
 	return &redisClientWrapper{
 		redis: redis,
 	}
@@ -28,6 +45,97 @@ func (rc *redisClientWrapper) SetEntry(ctx context.Context, key string, value bo
 	return rc.redis.SetNX(ctx, key, value, ttl).Result()
 }
 
+// UpdateEntry will set a key value entry in redis database, overwriting the existing one
+func (rc *redisClientWrapper) UpdateEntry(ctx context.Context, key string, value bool, ttl time.Duration) error {
+	return rc.redis.Set(ctx, key, value, ttl).Err()
+}
+
+// AcquireLease will set the lease key to the provided value, if the lease is not held by anyone
+func (rc *redisClientWrapper) AcquireLease(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	return rc.redis.SetNX(ctx, key, value, ttl).Result()
+}
+
+// RenewLease will extend the lease TTL, if the lease is still held by the provided value
+func (rc *redisClientWrapper) RenewLease(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	result, err := renewLeaseScript.Run(ctx, rc.redis, []string{key}, value, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
+// ReleaseLease will remove the lease, if it is still held by the provided value
+func (rc *redisClientWrapper) ReleaseLease(ctx context.Context, key string, value string) error {
+	return releaseLeaseScript.Run(ctx, rc.redis, []string{key}, value).Err()
+}
+
+// Increment will atomically increment the value of the provided key
+func (rc *redisClientWrapper) Increment(ctx context.Context, key string) (int64, error) {
+	return rc.redis.Incr(ctx, key).Result()
+}
+
+// AddToSortedSet will add the provided members, with their scores, to the sorted set
+func (rc *redisClientWrapper) AddToSortedSet(ctx context.Context, key string, members map[string]float64) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	entries := make([]*redis.Z, 0, len(members))
+	for member, score := range members {
+		entries = append(entries, &redis.Z{Score: score, Member: member})
+	}
+
+	return rc.redis.ZAdd(ctx, key, entries...).Err()
+}
+
+// GetSortedSetMembers will return the members of the sorted set, with their scores, which have
+// a score greater than or equal to the provided one
+func (rc *redisClientWrapper) GetSortedSetMembers(ctx context.Context, key string, minScore float64) (map[string]float64, error) {
+	entries, err := rc.redis.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min: formatScore(minScore),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		member, ok := entry.Member.(string)
+		if !ok {
+			continue
+		}
+		members[member] = entry.Score
+	}
+
+	return members, nil
+}
+
+// RemoveFromSortedSet will remove the provided members from the sorted set
+func (rc *redisClientWrapper) RemoveFromSortedSet(ctx context.Context, key string, members []string) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		values = append(values, member)
+	}
+
+	return rc.redis.ZRem(ctx, key, values...).Err()
+}
+
+// RemoveSortedSetMembersBelow will remove the members of the sorted set which have a score
+// lower than the provided one
+func (rc *redisClientWrapper) RemoveSortedSetMembersBelow(ctx context.Context, key string, maxScore float64) error {
+	return rc.redis.ZRemRangeByScore(ctx, key, "-inf", "("+formatScore(maxScore)).Err()
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
 // Ping will check if Redis instance is reponding
 func (rc *redisClientWrapper) Ping(ctx context.Context) (string, error) {
 	return rc.redis.Ping(ctx).Result()
@@ -8,14 +8,17 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 )
 
+// ArgsRedlockWrapper defines the arguments needed for redlock wrapper creation
 type ArgsRedlockWrapper struct {
-	Client       RedLockClient
-	TTLInMinutes uint32
+	Client              RedLockClient
+	TTLInMinutes        uint32
+	ReservationTTLInSec uint32
 }
 
 type redlockWrapper struct {
-	client RedLockClient
-	ttl    time.Duration
+	client         RedLockClient
+	ttl            time.Duration
+	reservationTTL time.Duration
 }
 
 // NewRedlockWrapper create a new redLock based on a cache instance
@@ -26,18 +29,29 @@ func NewRedlockWrapper(args ArgsRedlockWrapper) (*redlockWrapper, error) {
 	if args.TTLInMinutes == 0 {
 		return nil, fmt.Errorf("%w for TTL in minutes", ErrZeroValueReceived)
 	}
+	if args.ReservationTTLInSec == 0 {
+		return nil, fmt.Errorf("%w for reservation TTL in seconds", ErrZeroValueReceived)
+	}
 
 	ttl := time.Minute * time.Duration(args.TTLInMinutes)
+	reservationTTL := time.Second * time.Duration(args.ReservationTTLInSec)
 
 	return &redlockWrapper{
-		client: args.Client,
-		ttl:    ttl,
+		client:         args.Client,
+		ttl:            ttl,
+		reservationTTL: reservationTTL,
 	}, nil
 }
 
-// IsEventProcessed returns wether the item is already locked
-func (r *redlockWrapper) IsEventProcessed(ctx context.Context, blockHash string) (bool, error) {
-	return r.client.SetEntry(ctx, blockHash, true, r.ttl)
+// ReserveEvent returns true if the key was not already reserved or processed. The
+// reservation expires after the reservation TTL, unless it is committed
+func (r *redlockWrapper) ReserveEvent(ctx context.Context, key string) (bool, error) {
+	return r.client.SetEntry(ctx, key, true, r.reservationTTL)
+}
+
+// CommitEvent marks the key as processed, for the whole TTL
+func (r *redlockWrapper) CommitEvent(ctx context.Context, key string) error {
+	return r.client.UpdateEntry(ctx, key, true, r.ttl)
 }
 
 // HasConnection returns true if the redis client is connected
@@ -45,6 +59,11 @@ func (r *redlockWrapper) HasConnection(ctx context.Context) bool {
 	return r.client.IsConnected(ctx)
 }
 
+// Close returns nil
+func (r *redlockWrapper) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (r *redlockWrapper) IsInterfaceNil() bool {
 	return r == nil
@@ -15,8 +15,9 @@ import (
 
 func createMockRedlockWrapperArgs() redis.ArgsRedlockWrapper {
 	return redis.ArgsRedlockWrapper{
-		Client:       &mocks.RedisClientMock{},
-		TTLInMinutes: 30,
+		Client:              &mocks.RedisClientMock{},
+		TTLInMinutes:        30,
+		ReservationTTLInSec: 30,
 	}
 }
 
@@ -45,6 +46,17 @@ func TestNewRedlockWrapper(t *testing.T) {
 		assert.True(t, errors.Is(err, redis.ErrZeroValueReceived))
 	})
 
+	t.Run("invalid reservation ttl value", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRedlockWrapperArgs()
+		args.ReservationTTLInSec = 0
+
+		redlock, err := redis.NewRedlockWrapper(args)
+		assert.True(t, check.IfNil(redlock))
+		assert.True(t, errors.Is(err, redis.ErrZeroValueReceived))
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -54,7 +66,7 @@ func TestNewRedlockWrapper(t *testing.T) {
 	})
 }
 
-func TestRedlockWrapper_IsBlockProcessed(t *testing.T) {
+func TestRedlockWrapper_ReserveEvent(t *testing.T) {
 	t.Parallel()
 
 	t.Run("set should work", func(t *testing.T) {
@@ -63,6 +75,7 @@ func TestRedlockWrapper_IsBlockProcessed(t *testing.T) {
 		args := createMockRedlockWrapperArgs()
 		args.Client = &mocks.RedisClientStub{
 			SetEntryCalled: func(key string, value bool, ttl time.Duration) (bool, error) {
+				require.Equal(t, 30*time.Second, ttl)
 				return true, nil
 			},
 		}
@@ -70,7 +83,7 @@ func TestRedlockWrapper_IsBlockProcessed(t *testing.T) {
 		redlock, err := redis.NewRedlockWrapper(args)
 		require.Nil(t, err)
 
-		ok, err := redlock.IsEventProcessed(context.Background(), "randStr")
+		ok, err := redlock.ReserveEvent(context.Background(), "randStr")
 		require.Nil(t, err)
 		require.True(t, ok)
 	})
@@ -94,16 +107,61 @@ func TestRedlockWrapper_IsBlockProcessed(t *testing.T) {
 		redlock, err := redis.NewRedlockWrapper(args)
 		require.Nil(t, err)
 
-		ok, err := redlock.IsEventProcessed(context.Background(), "randStr")
+		ok, err := redlock.ReserveEvent(context.Background(), "randStr")
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		ok, err = redlock.IsEventProcessed(context.Background(), existingKey)
+		ok, err = redlock.ReserveEvent(context.Background(), existingKey)
 		require.Nil(t, err)
 		require.False(t, ok)
 	})
 }
 
+func TestRedlockWrapper_CommitEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should update entry with full ttl", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockRedlockWrapperArgs()
+		args.Client = &mocks.RedisClientStub{
+			UpdateEntryCalled: func(key string, value bool, ttl time.Duration) error {
+				wasCalled = true
+				require.Equal(t, "key", key)
+				require.True(t, value)
+				require.Equal(t, 30*time.Minute, ttl)
+				return nil
+			},
+		}
+
+		redlock, err := redis.NewRedlockWrapper(args)
+		require.Nil(t, err)
+
+		err = redlock.CommitEvent(context.Background(), "key")
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("client error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockRedlockWrapperArgs()
+		args.Client = &mocks.RedisClientStub{
+			UpdateEntryCalled: func(key string, value bool, ttl time.Duration) error {
+				return expectedErr
+			},
+		}
+
+		redlock, err := redis.NewRedlockWrapper(args)
+		require.Nil(t, err)
+
+		err = redlock.CommitEvent(context.Background(), "key")
+		require.Equal(t, expectedErr, err)
+	})
+}
+
 func TestRedlockWrapper_HasConnection(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsWatchedTxsRegistry defines the arguments needed for watched transactions registry creation
+type ArgsWatchedTxsRegistry struct {
+	Client SortedSetClient
+	Key    string
+}
+
+// watchedTxsRegistry keeps the watched transactions of all the notifier instances in a redis
+// sorted set, scored by their expiry time, so that the leader instance watches the transactions
+// registered on the followers as well
+type watchedTxsRegistry struct {
+	client SortedSetClient
+	key    string
+}
+
+// NewWatchedTxsRegistry creates a new watched transactions registry instance
+func NewWatchedTxsRegistry(args ArgsWatchedTxsRegistry) (*watchedTxsRegistry, error) {
+	if check.IfNil(args.Client) {
+		return nil, ErrNilSortedSetClient
+	}
+	if len(args.Key) == 0 {
+		return nil, ErrEmptyWatchedTxsKey
+	}
+
+	return &watchedTxsRegistry{
+		client: args.Client,
+		key:    args.Key,
+	}, nil
+}
+
+// AddTxs registers the provided transactions until the provided expiry time. The expired
+// transactions are removed from the registry
+func (wr *watchedTxsRegistry) AddTxs(txHashes []string, expiresAt time.Time) error {
+	ctx := context.Background()
+	err := wr.client.RemoveSortedSetMembersBelow(ctx, wr.key, getScore(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	members := make(map[string]float64, len(txHashes))
+	for _, txHash := range txHashes {
+		members[txHash] = getScore(expiresAt)
+	}
+
+	return wr.client.AddToSortedSet(ctx, wr.key, members)
+}
+
+// GetTxs returns the registered transactions which did not expire, with their expiry time
+func (wr *watchedTxsRegistry) GetTxs() (map[string]time.Time, error) {
+	members, err := wr.client.GetSortedSetMembers(context.Background(), wr.key, getScore(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make(map[string]time.Time, len(members))
+	for txHash, score := range members {
+		txs[txHash] = time.UnixMilli(int64(score))
+	}
+
+	return txs, nil
+}
+
+// RemoveTxs removes the provided transactions from the registry
+func (wr *watchedTxsRegistry) RemoveTxs(txHashes []string) error {
+	return wr.client.RemoveFromSortedSet(context.Background(), wr.key, txHashes)
+}
+
+func getScore(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wr *watchedTxsRegistry) IsInterfaceNil() bool {
+	return wr == nil
+}
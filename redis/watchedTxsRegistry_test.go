@@ -0,0 +1,150 @@
+package redis_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWatchedTxsRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sorted set client", func(t *testing.T) {
+		t.Parallel()
+
+		wr, err := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Key: "watched"})
+		require.True(t, check.IfNil(wr))
+		require.Equal(t, redis.ErrNilSortedSetClient, err)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		t.Parallel()
+
+		wr, err := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: &mocks.SortedSetClientStub{}})
+		require.True(t, check.IfNil(wr))
+		require.Equal(t, redis.ErrEmptyWatchedTxsKey, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wr, err := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{
+			Client: &mocks.SortedSetClientStub{},
+			Key:    "watched",
+		})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(wr))
+	})
+}
+
+func TestWatchedTxsRegistry_AddTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should remove the expired txs and add the new ones", func(t *testing.T) {
+		t.Parallel()
+
+		expiresAt := time.UnixMilli(time.Now().Add(time.Hour).UnixMilli())
+		var removedBelow float64
+		var addedMembers map[string]float64
+		client := &mocks.SortedSetClientStub{
+			RemoveSortedSetMembersBelowCalled: func(key string, maxScore float64) error {
+				require.Equal(t, "watched", key)
+				require.Nil(t, addedMembers)
+				removedBelow = maxScore
+				return nil
+			},
+			AddToSortedSetCalled: func(key string, members map[string]float64) error {
+				require.Equal(t, "watched", key)
+				addedMembers = members
+				return nil
+			},
+		}
+		wr, _ := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: client, Key: "watched"})
+
+		err := wr.AddTxs([]string{"tx1", "tx2"}, expiresAt)
+		require.Nil(t, err)
+		require.InDelta(t, float64(time.Now().UnixMilli()), removedBelow, float64(time.Minute.Milliseconds()))
+
+		expectedMembers := map[string]float64{
+			"tx1": float64(expiresAt.UnixMilli()),
+			"tx2": float64(expiresAt.UnixMilli()),
+		}
+		require.Equal(t, expectedMembers, addedMembers)
+	})
+
+	t.Run("client error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		client := &mocks.SortedSetClientStub{
+			AddToSortedSetCalled: func(key string, members map[string]float64) error {
+				return expectedErr
+			},
+		}
+		wr, _ := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: client, Key: "watched"})
+
+		err := wr.AddTxs([]string{"tx1"}, time.Now())
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestWatchedTxsRegistry_GetTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the not expired txs", func(t *testing.T) {
+		t.Parallel()
+
+		expiresAt := time.UnixMilli(time.Now().Add(time.Hour).UnixMilli())
+		client := &mocks.SortedSetClientStub{
+			GetSortedSetMembersCalled: func(key string, minScore float64) (map[string]float64, error) {
+				require.Equal(t, "watched", key)
+				require.InDelta(t, float64(time.Now().UnixMilli()), minScore, float64(time.Minute.Milliseconds()))
+				return map[string]float64{"tx1": float64(expiresAt.UnixMilli())}, nil
+			},
+		}
+		wr, _ := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: client, Key: "watched"})
+
+		txs, err := wr.GetTxs()
+		require.Nil(t, err)
+		require.Equal(t, map[string]time.Time{"tx1": expiresAt}, txs)
+	})
+
+	t.Run("client error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		client := &mocks.SortedSetClientStub{
+			GetSortedSetMembersCalled: func(key string, minScore float64) (map[string]float64, error) {
+				return nil, expectedErr
+			},
+		}
+		wr, _ := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: client, Key: "watched"})
+
+		txs, err := wr.GetTxs()
+		require.Nil(t, txs)
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestWatchedTxsRegistry_RemoveTxs(t *testing.T) {
+	t.Parallel()
+
+	var removedMembers []string
+	client := &mocks.SortedSetClientStub{
+		RemoveFromSortedSetCalled: func(key string, members []string) error {
+			require.Equal(t, "watched", key)
+			removedMembers = members
+			return nil
+		},
+	}
+	wr, _ := redis.NewWatchedTxsRegistry(redis.ArgsWatchedTxsRegistry{Client: client, Key: "watched"})
+
+	err := wr.RemoveTxs([]string{"tx1"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, removedMembers)
+}